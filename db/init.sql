@@ -13,8 +13,12 @@ CREATE DATABASE prediction_db;
 -- Crear base de datos para Leaderboard Service
 CREATE DATABASE leaderboard_db;
 
+-- Crear base de datos compartida para el log de eventos de dominio
+CREATE DATABASE events_db;
+
 -- Otorgar permisos al usuario kickoff_user en todas las bases de datos
 GRANT ALL PRIVILEGES ON DATABASE user_db TO kickoff_user;
 GRANT ALL PRIVILEGES ON DATABASE game_db TO kickoff_user;
 GRANT ALL PRIVILEGES ON DATABASE prediction_db TO kickoff_user;
 GRANT ALL PRIVILEGES ON DATABASE leaderboard_db TO kickoff_user;
+GRANT ALL PRIVILEGES ON DATABASE events_db TO kickoff_user;
@@ -8,6 +8,7 @@ kubectl create namespace kickoff --dry-run=client -o yaml | kubectl apply -f -
 echo [Step 2] Applying ConfigMaps...
 kubectl apply -f k8s/config/configmap.yaml
 kubectl apply -f k8s/config/postgres-config.yaml
+kubectl apply -f k8s/config/auth-secret.yaml
 
 echo [Step 3] Applying PersistentVolumeClaims...
 kubectl apply -f k8s/base/postgres-pvc.yaml
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
+	"kickoff.com/pkg/auth"
 	pb "kickoff.com/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -24,6 +26,10 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// Privileged methods check the caller's role: export the access token of a
+	// site_admin (POST /api/auth/login) as ACCESS_TOKEN
+	ctx = auth.WithToken(ctx, os.Getenv("ACCESS_TOKEN"))
+
 	fmt.Println("========================================")
 	fmt.Println("Testing Game Service gRPC API")
 	fmt.Println("========================================")
@@ -162,8 +168,21 @@ func main() {
 	newGameID := createGameResp.Game.Id
 	fmt.Println()
 
-	// Test 11: Update Game Score
-	fmt.Println("Test 11: UpdateGameScore")
+	// Test 11: Update Game Status to IN_PROGRESS (el marcador solo se carga en vivo)
+	fmt.Println("Test 11: UpdateGameStatus (IN_PROGRESS)")
+	updateStatusResp, err := client.UpdateGameStatus(ctx, &pb.UpdateGameStatusRequest{
+		GameId: newGameID,
+		Status: pb.GameStatus_GAME_STATUS_IN_PROGRESS,
+	})
+	if err != nil {
+		log.Fatalf("UpdateGameStatus failed: %v", err)
+	}
+	fmt.Printf("✅ %s\n", updateStatusResp.Message)
+	fmt.Printf("   Game status: %s\n", updateStatusResp.Game.Status)
+	fmt.Println()
+
+	// Test 12: Update Game Score
+	fmt.Println("Test 12: UpdateGameScore")
 	updateScoreResp, err := client.UpdateGameScore(ctx, &pb.UpdateGameScoreRequest{
 		GameId:    newGameID,
 		HomeScore: 24,
@@ -180,19 +199,6 @@ func main() {
 		updateScoreResp.Game.AwayTeamId)
 	fmt.Println()
 
-	// Test 12: Update Game Status to IN_PROGRESS
-	fmt.Println("Test 12: UpdateGameStatus (IN_PROGRESS)")
-	updateStatusResp, err := client.UpdateGameStatus(ctx, &pb.UpdateGameStatusRequest{
-		GameId: newGameID,
-		Status: pb.GameStatus_GAME_STATUS_IN_PROGRESS,
-	})
-	if err != nil {
-		log.Fatalf("UpdateGameStatus failed: %v", err)
-	}
-	fmt.Printf("✅ %s\n", updateStatusResp.Message)
-	fmt.Printf("   Game status: %s\n", updateStatusResp.Game.Status)
-	fmt.Println()
-
 	// Test 13: Update Game Status to COMPLETED
 	fmt.Println("Test 13: UpdateGameStatus (COMPLETED)")
 	finalStatusResp, err := client.UpdateGameStatus(ctx, &pb.UpdateGameStatusRequest{
@@ -206,6 +212,18 @@ func main() {
 	fmt.Printf("   Game status: %s\n", finalStatusResp.Game.Status)
 	fmt.Println()
 
+	// Test 14: Get Game Status History
+	fmt.Println("Test 14: GetGameStatusHistory")
+	historyResp, err := client.GetGameStatusHistory(ctx, &pb.GetGameStatusHistoryRequest{GameId: newGameID})
+	if err != nil {
+		log.Fatalf("GetGameStatusHistory failed: %v", err)
+	}
+	fmt.Printf("✅ Found %d status changes\n", len(historyResp.Changes))
+	for _, change := range historyResp.Changes {
+		fmt.Printf("   %s -> %s\n", change.FromStatus, change.ToStatus)
+	}
+	fmt.Println()
+
 	fmt.Println("========================================")
 	fmt.Println("✅ ALL TESTS PASSED!")
 	fmt.Println("========================================")
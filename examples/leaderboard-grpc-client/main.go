@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
+	"kickoff.com/pkg/auth"
 	pb "kickoff.com/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -23,6 +25,10 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	// Privileged methods check the caller's role: export the access token of a
+	// site_admin (POST /api/auth/login) as ACCESS_TOKEN
+	ctx = auth.WithToken(ctx, os.Getenv("ACCESS_TOKEN"))
+
 	fmt.Println("========================================")
 	fmt.Println("Testing Leaderboard Service gRPC API")
 	fmt.Println("========================================")
@@ -56,11 +62,10 @@ func main() {
 	}
 	fmt.Println()
 
-	// Test 3: Get Leaderboard with Pagination (limit 2, offset 0)
-	fmt.Println("Test 3: GetLeaderboard (limit 2, offset 0)")
+	// Test 3: Get Leaderboard with Pagination (2 por página)
+	fmt.Println("Test 3: GetLeaderboard (page_size 2)")
 	paginatedResp, err := client.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{
-		Limit:  2,
-		Offset: 0,
+		PageSize: 2,
 	})
 	if err != nil {
 		log.Fatalf("GetLeaderboard with pagination failed: %v", err)
@@ -69,6 +74,9 @@ func main() {
 	for _, user := range paginatedResp.Leaderboard {
 		fmt.Printf("   Rank #%d: User %s (%.1f%%)\n", user.Rank, user.UserId, user.Percentage)
 	}
+	if paginatedResp.NextPageToken != "" {
+		fmt.Printf("   Next page token: %s\n", paginatedResp.NextPageToken)
+	}
 	fmt.Println()
 
 	// Test 4: Get User Stats (assuming we have a user with ID from leaderboard)
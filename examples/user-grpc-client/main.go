@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
+	"kickoff.com/pkg/auth"
 	pb "kickoff.com/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -23,6 +25,10 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Privileged methods check the caller's role: export the access token of a
+	// site_admin (POST /api/auth/login) as ACCESS_TOKEN
+	ctx = auth.WithToken(ctx, os.Getenv("ACCESS_TOKEN"))
+
 	fmt.Println("========================================")
 	fmt.Println("Testing User Service gRPC API")
 	fmt.Println("========================================")
@@ -70,7 +76,6 @@ func main() {
 	// Test 4: Get All Users
 	fmt.Println("Test 4: GetAllUsers")
 	allUsersResp, err := client.GetAllUsers(ctx, &pb.GetAllUsersRequest{
-		Page:     1,
 		PageSize: 10,
 	})
 	if err != nil {
@@ -0,0 +1,138 @@
+// import-schedule carga el calendario de una temporada en el Game Service
+// desde un archivo CSV, JSON o iCalendar (.ics).
+//
+//	go run ./game/cmd/import-schedule -dry-run schedule-2025.csv
+//	go run ./game/cmd/import-schedule -addr localhost:9082 -season 2025 nfl.ics
+//
+// Con -dry-run solo muestra los cambios; sin él los aplica, salvo que el
+// archivo tenga problemas. Termina con código 1 si hay problemas.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "kickoff.com/proto"
+)
+
+func main() {
+	var (
+		addr    string
+		format  string
+		season  int
+		dryRun  bool
+		verbose bool
+	)
+	flag.StringVar(&addr, "addr", "localhost:9082", "Game Service gRPC address")
+	flag.StringVar(&format, "format", "", "csv, json or ics (default: from the file extension or content)")
+	flag.IntVar(&season, "season", 0, "season for rows without one (default: season of each kickoff)")
+	flag.BoolVar(&dryRun, "dry-run", false, "only report the changes")
+	flag.BoolVar(&verbose, "v", false, "also list unchanged games")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <schedule file>\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", path, err)
+	}
+
+	formats := map[string]pb.ScheduleFormat{
+		"csv":  pb.ScheduleFormat_SCHEDULE_FORMAT_CSV,
+		"json": pb.ScheduleFormat_SCHEDULE_FORMAT_JSON,
+		"ics":  pb.ScheduleFormat_SCHEDULE_FORMAT_ICS,
+		"ical": pb.ScheduleFormat_SCHEDULE_FORMAT_ICS,
+	}
+	pbFormat, ok := formats[strings.ToLower(format)]
+	if format != "" && !ok {
+		log.Fatalf("Unknown format %q (use csv, json or ics)", format)
+	}
+	if format == "" {
+		// Con una extensión desconocida el servicio detecta el formato por el contenido
+		pbFormat = formats[strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")]
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to game service: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	resp, err := pb.NewGameServiceClient(conn).ImportSchedule(ctx, &pb.ImportScheduleRequest{
+		Format:  pbFormat,
+		Content: content,
+		Season:  int32(season),
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	printReport(resp, verbose)
+	if len(resp.Issues) > 0 {
+		os.Exit(1)
+	}
+}
+
+// printReport muestra el diff del calendario: + crea, ~ actualiza, = sin cambios
+func printReport(resp *pb.ImportScheduleResponse, verbose bool) {
+	for _, change := range resp.Changes {
+		marker := map[string]string{"create": "+", "update": "~", "unchanged": "="}[change.Action]
+		if change.Action == "unchanged" && !verbose {
+			continue
+		}
+
+		fmt.Printf("%s %d W%-2d %3s @ %-3s %s", marker, change.Season, change.Week,
+			change.AwayTeamId, change.HomeTeamId, change.ScheduledAt.AsTime().UTC().Format(time.RFC3339))
+		if change.GameId != "" {
+			fmt.Printf("  %s", change.GameId)
+		}
+		fmt.Println()
+		for _, field := range change.Changes {
+			fmt.Printf("      %s: %s -> %s\n", field.Field, orNone(field.OldValue), orNone(field.NewValue))
+		}
+	}
+
+	if len(resp.Issues) > 0 {
+		fmt.Println()
+		fmt.Println("Issues:")
+		for _, issue := range resp.Issues {
+			if issue.Line > 0 {
+				fmt.Printf("  line %d: %s\n", issue.Line, issue.Message)
+			} else {
+				fmt.Printf("  %s\n", issue.Message)
+			}
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(resp.Message)
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
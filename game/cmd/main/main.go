@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"math"
 	"net"
 	"os"
 	"os/signal"
@@ -15,20 +16,48 @@ import (
 	"kickoff.com/game/internal/data"
 	"kickoff.com/game/internal/database"
 	"kickoff.com/game/internal/models"
+	"kickoff.com/game/internal/plays"
+	"kickoff.com/game/internal/schedule"
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	"kickoff.com/pkg/playoffs"
 	pb "kickoff.com/proto"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const serviceName = "game"
 
+// privilegedMethods son los métodos del Game Service que exigen un rol: la
+// carga del calendario es de los site_admin y la de los juegos en curso,
+// también de los score_keeper
+var privilegedMethods = auth.Methods{
+	pb.GameService_CreateGame_FullMethodName:             {auth.RoleSiteAdmin},
+	pb.GameService_RescheduleGame_FullMethodName:         {auth.RoleSiteAdmin},
+	pb.GameService_UpdateGameLines_FullMethodName:        {auth.RoleSiteAdmin},
+	pb.GameService_ImportSchedule_FullMethodName:         {auth.RoleSiteAdmin},
+	pb.GameService_GeneratePlayoffBracket_FullMethodName: {auth.RoleSiteAdmin},
+	pb.GameService_UpdateGameScore_FullMethodName:        {auth.RoleScoreKeeper},
+	pb.GameService_UpdateGameStatus_FullMethodName:       {auth.RoleScoreKeeper},
+	pb.GameService_RecordScoringPlay_FullMethodName:      {auth.RoleScoreKeeper},
+	pb.GameService_AmendScoringPlay_FullMethodName:       {auth.RoleScoreKeeper},
+	pb.GameService_UpdateGameClock_FullMethodName:        {auth.RoleScoreKeeper},
+}
+
 type GameService struct {
 	pb.UnimplementedGameServiceServer
+	reschedulePolicy pb.ReschedulePredictionPolicy // Política por defecto de RescheduleGame
 }
 
 func main() {
@@ -52,8 +81,38 @@ func main() {
 	// Cargar juegos de ejemplo
 	loadSampleGames()
 
+	// Publicar los eventos del outbox (GameScheduled, GameStatusChanged, ...)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transport, err := events.NewTransportFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize event transport: %v", err)
+	}
+	go events.NewRelay(database.DB, transport).Run(ctx)
+
+	// Política por defecto para las predicciones de un juego reprogramado
+	reschedulePolicy, err := reschedulePolicyFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to read reschedule policy: %v", err)
+	}
+
+	// Verificador de los access tokens propagados (mismo secreto que el Gateway)
+	tokens, err := auth.NewSignerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize token verifier: %v", err)
+	}
+
 	// Inicializar servicio
-	gameService := &GameService{}
+	gameService := &GameService{reschedulePolicy: reschedulePolicy}
+
+	// Conectar al User Service para rechazar los tokens de usuarios desactivados
+	userConn, err := grpc.NewClient("user-service:9081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to user service: %v", err)
+	}
+	defer userConn.Close()
+	log.Println("✅ Connected to User Service gRPC (user-service:9081)")
 
 	// Crear listener para gRPC
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -61,8 +120,8 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	// Crear servidor gRPC
-	grpcServer := grpc.NewServer()
+	// Crear servidor gRPC; el interceptor controla el rol de los métodos privilegiados
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor(tokens, privilegedMethods, auth.UserServiceActive(pb.NewUserServiceClient(userConn)))))
 	pb.RegisterGameServiceServer(grpcServer, gameService)
 
 	// Registrar health check
@@ -84,8 +143,9 @@ func main() {
 	// Wait for termination signal
 	<-sigChan
 	log.Println("Shutting down gracefully...")
-	database.Close()
+	cancel()
 	grpcServer.GracefulStop()
+	database.Close()
 	log.Println("Server stopped")
 }
 
@@ -148,15 +208,18 @@ func loadSampleGames() {
 			WinnerTeamID: "PHI",
 		},
 		{
-			ID:         "game_4",
-			Week:       1,
-			Season:     2024,
-			HomeTeamID: "GB",
-			AwayTeamID: "CHI",
-			GameTime:   time.Now(),
-			Status:     models.GameStatusLive,
-			HomeScore:  21,
-			AwayScore:  10,
+			ID:               "game_4",
+			Week:             1,
+			Season:           2024,
+			HomeTeamID:       "GB",
+			AwayTeamID:       "CHI",
+			GameTime:         time.Now(),
+			Status:           models.GameStatusLive,
+			HomeScore:        21,
+			AwayScore:        10,
+			Quarter:          3,
+			ClockSeconds:     8*60 + 12,
+			PossessionTeamID: "CHI",
 		},
 	}
 
@@ -261,10 +324,29 @@ func modelGameToProto(game models.Game) *pb.Game {
 		HomeTeamId:  game.HomeTeamID,
 		AwayTeamId:  game.AwayTeamID,
 		Week:        int32(game.Week),
+		Season:      int32(game.Season),
 		Status:      gameStatusToProto(game.Status),
 		HomeScore:   int32(game.HomeScore),
 		AwayScore:   int32(game.AwayScore),
 		ScheduledAt: timestamppb.New(game.GameTime),
+		Version:     game.Version,
+	}
+
+	if game.Spread != nil {
+		spread := *game.Spread
+		protoGame.Spread = &spread
+	}
+	if game.Total != nil {
+		total := *game.Total
+		protoGame.Total = &total
+	}
+	if game.LinesLockAt != nil {
+		protoGame.LinesLockAt = timestamppb.New(*game.LinesLockAt)
+	}
+	if game.Quarter > 0 {
+		protoGame.Quarter = int32(game.Quarter)
+		protoGame.Clock = plays.FormatClock(game.ClockSeconds)
+		protoGame.PossessionTeamId = game.PossessionTeamID
 	}
 
 	if !game.CreatedAt.IsZero() {
@@ -312,13 +394,56 @@ func gameStatusFromProto(status pb.GameStatus) models.GameStatus {
 	}
 }
 
+func modelStatusChangeToProto(change models.GameStatusChange) *pb.GameStatusChange {
+	return &pb.GameStatusChange{
+		Id:         change.ID,
+		GameId:     change.GameID,
+		FromStatus: gameStatusToProto(change.FromStatus),
+		ToStatus:   gameStatusToProto(change.ToStatus),
+		Override:   change.Override,
+		Reason:     change.Reason,
+		ChangedAt:  timestamppb.New(change.CreatedAt),
+	}
+}
+
+// formatStatuses lista los estados para los mensajes de error ("none" si no hay)
+func formatStatuses(statuses []models.GameStatus) string {
+	if len(statuses) == 0 {
+		return "none"
+	}
+	names := make([]string, len(statuses))
+	for i, s := range statuses {
+		names[i] = string(s)
+	}
+	return strings.Join(names, ", ")
+}
+
 // ========================================
 // gRPC Handlers - Team Operations
 // ========================================
 
+// teamOrders son los órdenes de los listados de equipos
+var teamOrders = pagination.Orders[models.Team]{
+	{Name: "id", Column: "id", Kind: pagination.String, Value: func(t models.Team) interface{} { return t.ID }},
+	{Name: "name", Column: "name", Kind: pagination.String, Value: func(t models.Team) interface{} { return t.Name }},
+}
+
 func (gs *GameService) GetAllTeams(ctx context.Context, req *pb.GetAllTeamsRequest) (*pb.GetAllTeamsResponse, error) {
-	var teams []models.Team
-	if err := database.DB.Find(&teams).Error; err != nil {
+	page, err := pagination.New(req.PageSize, req.PageToken, req.OrderBy, teamOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.Team{})
+	if req.Conference != pb.Conference_CONFERENCE_UNSPECIFIED {
+		query = query.Where("conference = ?", conferenceFromProto(req.Conference))
+	}
+	if req.Division != pb.Division_DIVISION_UNSPECIFIED {
+		query = query.Where("division = ?", divisionFromProto(req.Division))
+	}
+
+	teams, total, next, err := pagination.Find(query, page, func(t models.Team) string { return t.ID })
+	if err != nil {
 		log.Printf("Error fetching teams: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch teams: %v", err)
 	}
@@ -329,14 +454,15 @@ func (gs *GameService) GetAllTeams(ctx context.Context, req *pb.GetAllTeamsReque
 	}
 
 	return &pb.GetAllTeamsResponse{
-		Teams: pbTeams,
-		Total: int32(len(pbTeams)),
+		Teams:         pbTeams,
+		Total:         int32(total),
+		NextPageToken: next,
 	}, nil
 }
 
 func (gs *GameService) GetTeamByID(ctx context.Context, req *pb.GetTeamByIDRequest) (*pb.GetTeamByIDResponse, error) {
 	if req.TeamId == "" {
-		return nil, status.Error(codes.InvalidArgument, "team_id is required")
+		return nil, shared.InvalidField("team_id", "team_id is required")
 	}
 
 	teamID := strings.ToUpper(req.TeamId)
@@ -352,12 +478,17 @@ func (gs *GameService) GetTeamByID(ctx context.Context, req *pb.GetTeamByIDReque
 
 func (gs *GameService) GetTeamsByConference(ctx context.Context, req *pb.GetTeamsByConferenceRequest) (*pb.GetTeamsByConferenceResponse, error) {
 	if req.Conference == pb.Conference_CONFERENCE_UNSPECIFIED {
-		return nil, status.Error(codes.InvalidArgument, "conference is required")
+		return nil, shared.InvalidField("conference", "conference is required")
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", teamOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
 	}
 
-	targetConference := conferenceFromProto(req.Conference)
-	var teams []models.Team
-	if err := database.DB.Where("conference = ?", targetConference).Find(&teams).Error; err != nil {
+	query := database.DB.Model(&models.Team{}).Where("conference = ?", conferenceFromProto(req.Conference))
+	teams, total, next, err := pagination.Find(query, page, func(t models.Team) string { return t.ID })
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch teams: %v", err)
 	}
 
@@ -367,20 +498,26 @@ func (gs *GameService) GetTeamsByConference(ctx context.Context, req *pb.GetTeam
 	}
 
 	return &pb.GetTeamsByConferenceResponse{
-		Teams:      pbTeams,
-		Total:      int32(len(pbTeams)),
-		Conference: req.Conference,
+		Teams:         pbTeams,
+		Total:         int32(total),
+		Conference:    req.Conference,
+		NextPageToken: next,
 	}, nil
 }
 
 func (gs *GameService) GetTeamsByDivision(ctx context.Context, req *pb.GetTeamsByDivisionRequest) (*pb.GetTeamsByDivisionResponse, error) {
 	if req.Division == pb.Division_DIVISION_UNSPECIFIED {
-		return nil, status.Error(codes.InvalidArgument, "division is required")
+		return nil, shared.InvalidField("division", "division is required")
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", teamOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
 	}
 
-	targetDivision := divisionFromProto(req.Division)
-	var teams []models.Team
-	if err := database.DB.Where("division = ?", targetDivision).Find(&teams).Error; err != nil {
+	query := database.DB.Model(&models.Team{}).Where("division = ?", divisionFromProto(req.Division))
+	teams, total, next, err := pagination.Find(query, page, func(t models.Team) string { return t.ID })
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch teams: %v", err)
 	}
 
@@ -390,9 +527,10 @@ func (gs *GameService) GetTeamsByDivision(ctx context.Context, req *pb.GetTeamsB
 	}
 
 	return &pb.GetTeamsByDivisionResponse{
-		Teams:    pbTeams,
-		Total:    int32(len(pbTeams)),
-		Division: req.Division,
+		Teams:         pbTeams,
+		Total:         int32(total),
+		Division:      req.Division,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -400,9 +538,53 @@ func (gs *GameService) GetTeamsByDivision(ctx context.Context, req *pb.GetTeamsB
 // gRPC Handlers - Game Operations
 // ========================================
 
+// gameOrders son los órdenes de los listados de partidos
+var gameOrders = pagination.Orders[models.Game]{
+	{Name: "scheduled_at", Column: "game_time", Kind: pagination.Time, Value: func(g models.Game) interface{} { return g.GameTime }},
+	{Name: "week", Column: "week", Kind: pagination.Int, Value: func(g models.Game) interface{} { return g.Week }},
+}
+
 func (gs *GameService) GetAllGames(ctx context.Context, req *pb.GetAllGamesRequest) (*pb.GetAllGamesResponse, error) {
-	var games []models.Game
-	if err := database.DB.Find(&games).Error; err != nil {
+	if req.Season < 0 {
+		return nil, shared.InvalidField("season", "season cannot be negative")
+	}
+	if req.WeekFrom < 0 || req.WeekTo < 0 {
+		return nil, shared.InvalidField("week_from", "week_from and week_to cannot be negative")
+	}
+	if req.WeekTo > 0 && req.WeekFrom > req.WeekTo {
+		return nil, shared.InvalidField("week_to", "week_to must not be before week_from")
+	}
+	page, err := pagination.New(req.PageSize, req.PageToken, req.OrderBy, gameOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.Game{})
+	if req.Season > 0 {
+		query = query.Where("season = ?", req.Season)
+	}
+	if req.WeekFrom > 0 {
+		query = query.Where("week >= ?", req.WeekFrom)
+	}
+	if req.WeekTo > 0 {
+		query = query.Where("week <= ?", req.WeekTo)
+	}
+	if req.TeamId != "" {
+		teamID := strings.ToUpper(req.TeamId)
+		query = query.Where("(home_team_id = ? OR away_team_id = ?)", teamID, teamID)
+	}
+	if req.Status != pb.GameStatus_GAME_STATUS_UNSPECIFIED {
+		query = query.Where("status = ?", gameStatusFromProto(req.Status))
+	}
+	if req.ScheduledAfter != nil {
+		query = query.Where("game_time >= ?", req.ScheduledAfter.AsTime())
+	}
+	if req.ScheduledBefore != nil {
+		query = query.Where("game_time < ?", req.ScheduledBefore.AsTime())
+	}
+
+	games, total, next, err := pagination.Find(query, page, func(g models.Game) string { return g.ID })
+	if err != nil {
 		log.Printf("Error fetching games: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch games: %v", err)
 	}
@@ -413,14 +595,15 @@ func (gs *GameService) GetAllGames(ctx context.Context, req *pb.GetAllGamesReque
 	}
 
 	return &pb.GetAllGamesResponse{
-		Games: pbGames,
-		Total: int32(len(pbGames)),
+		Games:         pbGames,
+		Total:         int32(total),
+		NextPageToken: next,
 	}, nil
 }
 
 func (gs *GameService) GetGameByID(ctx context.Context, req *pb.GetGameByIDRequest) (*pb.GetGameByIDResponse, error) {
 	if req.GameId == "" {
-		return nil, status.Error(codes.InvalidArgument, "game_id is required")
+		return nil, shared.InvalidField("game_id", "game_id is required")
 	}
 
 	var game models.Game
@@ -434,12 +617,18 @@ func (gs *GameService) GetGameByID(ctx context.Context, req *pb.GetGameByIDReque
 }
 
 func (gs *GameService) GetGamesByWeek(ctx context.Context, req *pb.GetGamesByWeekRequest) (*pb.GetGamesByWeekResponse, error) {
-	if req.Week < 1 || req.Week > 18 {
-		return nil, status.Error(codes.InvalidArgument, "week must be between 1 and 18")
+	if req.Week < 1 || req.Week > playoffs.LastWeek {
+		return nil, shared.InvalidField("week", "week must be between 1 and %d", playoffs.LastWeek)
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", gameOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
 	}
 
-	var games []models.Game
-	if err := database.DB.Where("week = ?", req.Week).Find(&games).Error; err != nil {
+	query := database.DB.Model(&models.Game{}).Where("week = ?", req.Week)
+	games, total, next, err := pagination.Find(query, page, func(g models.Game) string { return g.ID })
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch games: %v", err)
 	}
 
@@ -449,15 +638,21 @@ func (gs *GameService) GetGamesByWeek(ctx context.Context, req *pb.GetGamesByWee
 	}
 
 	return &pb.GetGamesByWeekResponse{
-		Games: pbGames,
-		Total: int32(len(pbGames)),
-		Week:  req.Week,
+		Games:         pbGames,
+		Total:         int32(total),
+		Week:          req.Week,
+		NextPageToken: next,
 	}, nil
 }
 
 func (gs *GameService) GetGamesByTeam(ctx context.Context, req *pb.GetGamesByTeamRequest) (*pb.GetGamesByTeamResponse, error) {
 	if req.TeamId == "" {
-		return nil, status.Error(codes.InvalidArgument, "team_id is required")
+		return nil, shared.InvalidField("team_id", "team_id is required")
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", gameOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
 	}
 
 	teamID := strings.ToUpper(req.TeamId)
@@ -468,8 +663,9 @@ func (gs *GameService) GetGamesByTeam(ctx context.Context, req *pb.GetGamesByTea
 		return nil, status.Error(codes.NotFound, "Team not found")
 	}
 
-	var games []models.Game
-	if err := database.DB.Where("home_team_id = ? OR away_team_id = ?", teamID, teamID).Find(&games).Error; err != nil {
+	query := database.DB.Model(&models.Game{}).Where("(home_team_id = ? OR away_team_id = ?)", teamID, teamID)
+	games, total, next, err := pagination.Find(query, page, func(g models.Game) string { return g.ID })
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch games: %v", err)
 	}
 
@@ -479,20 +675,26 @@ func (gs *GameService) GetGamesByTeam(ctx context.Context, req *pb.GetGamesByTea
 	}
 
 	return &pb.GetGamesByTeamResponse{
-		Games:  pbGames,
-		Total:  int32(len(pbGames)),
-		TeamId: teamID,
+		Games:         pbGames,
+		Total:         int32(total),
+		TeamId:        teamID,
+		NextPageToken: next,
 	}, nil
 }
 
 func (gs *GameService) GetGamesByStatus(ctx context.Context, req *pb.GetGamesByStatusRequest) (*pb.GetGamesByStatusResponse, error) {
 	if req.Status == pb.GameStatus_GAME_STATUS_UNSPECIFIED {
-		return nil, status.Error(codes.InvalidArgument, "status is required")
+		return nil, shared.InvalidField("status", "status is required")
 	}
 
-	targetStatus := gameStatusFromProto(req.Status)
-	var games []models.Game
-	if err := database.DB.Where("status = ?", targetStatus).Find(&games).Error; err != nil {
+	page, err := pagination.New(req.PageSize, req.PageToken, "", gameOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.Game{}).Where("status = ?", gameStatusFromProto(req.Status))
+	games, total, next, err := pagination.Find(query, page, func(g models.Game) string { return g.ID })
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to fetch games: %v", err)
 	}
 
@@ -502,9 +704,10 @@ func (gs *GameService) GetGamesByStatus(ctx context.Context, req *pb.GetGamesByS
 	}
 
 	return &pb.GetGamesByStatusResponse{
-		Games:  pbGames,
-		Total:  int32(len(pbGames)),
-		Status: req.Status,
+		Games:         pbGames,
+		Total:         int32(total),
+		Status:        req.Status,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -517,8 +720,12 @@ func (gs *GameService) CreateGame(ctx context.Context, req *pb.CreateGameRequest
 		return nil, status.Error(codes.InvalidArgument, "home_team_id and away_team_id are required")
 	}
 
-	if req.Week < 1 || req.Week > 18 {
-		return nil, status.Error(codes.InvalidArgument, "week must be between 1 and 18")
+	// Semanas 1-18 de temporada regular y 19-22 de playoffs
+	if req.Week < 1 || req.Week > playoffs.LastWeek {
+		return nil, shared.InvalidField("week", "week must be between 1 and %d", playoffs.LastWeek)
+	}
+	if req.Season < 0 {
+		return nil, shared.InvalidField("season", "season must be positive")
 	}
 
 	homeTeamID := strings.ToUpper(req.HomeTeamId)
@@ -537,20 +744,22 @@ func (gs *GameService) CreateGame(ctx context.Context, req *pb.CreateGameRequest
 		return nil, status.Error(codes.InvalidArgument, "A team cannot play against itself")
 	}
 
-	// Generar ID único
-	var count int64
-	database.DB.Model(&models.Game{}).Count(&count)
-	gameID := fmt.Sprintf("game_%d", count+1)
+	gameID := idgen.New(idgen.PrefixGame)
 
 	scheduledAt := time.Now().Add(24 * time.Hour)
 	if req.ScheduledAt != nil {
 		scheduledAt = req.ScheduledAt.AsTime()
 	}
 
+	season := int(req.Season)
+	if season == 0 {
+		season = schedule.SeasonOf(scheduledAt)
+	}
+
 	game := models.Game{
 		ID:         gameID,
 		Week:       int(req.Week),
-		Season:     2024,
+		Season:     season,
 		HomeTeamID: homeTeamID,
 		AwayTeamID: awayTeamID,
 		GameTime:   scheduledAt,
@@ -559,7 +768,20 @@ func (gs *GameService) CreateGame(ctx context.Context, req *pb.CreateGameRequest
 		AwayScore:  0,
 	}
 
-	if err := database.DB.Create(&game).Error; err != nil {
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := createGame(tx, &game); err != nil {
+			return err
+		}
+		return events.Publish(tx, serviceName, events.TypeGameScheduled, game.ID, events.GameScheduled{
+			GameID:     game.ID,
+			Week:       game.Week,
+			Season:     game.Season,
+			HomeTeamID: game.HomeTeamID,
+			AwayTeamID: game.AwayTeamID,
+			GameTime:   game.GameTime,
+		})
+	})
+	if err != nil {
 		log.Printf("Error creating game: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to create game: %v", err)
 	}
@@ -570,22 +792,34 @@ func (gs *GameService) CreateGame(ctx context.Context, req *pb.CreateGameRequest
 	}, nil
 }
 
+// UpdateGameScore fija el marcador de un juego en vivo. El marcador sale del
+// registro de anotaciones, así que la diferencia se agrega al registro como
+// ajuste. Corregir un juego terminado requiere override y un motivo.
 func (gs *GameService) UpdateGameScore(ctx context.Context, req *pb.UpdateGameScoreRequest) (*pb.UpdateGameScoreResponse, error) {
 	if req.GameId == "" {
-		return nil, status.Error(codes.InvalidArgument, "game_id is required")
+		return nil, shared.InvalidField("game_id", "game_id is required")
 	}
-
-	var game models.Game
-	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Game not found")
+	if req.HomeScore < 0 || req.AwayScore < 0 {
+		return nil, shared.InvalidField("scores", "scores cannot be negative")
 	}
-
-	updates := map[string]interface{}{
-		"home_score": int(req.HomeScore),
-		"away_score": int(req.AwayScore),
+	reason := strings.TrimSpace(req.Reason)
+	if req.Override && reason == "" {
+		return nil, shared.InvalidField("reason", "reason is required to override a score")
+	}
+	if req.Override && !auth.CallerHasRole(ctx, auth.RoleSiteAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "only site admins can override a score")
 	}
 
-	if err := database.DB.Model(&game).Updates(updates).Error; err != nil {
+	var game models.Game
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		game, err = setScoreFromLog(tx, req.GameId, int(req.HomeScore), int(req.AwayScore), req.Override, reason)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		log.Printf("Error updating game score: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to update game score: %v", err)
 	}
@@ -599,44 +833,218 @@ func (gs *GameService) UpdateGameScore(ctx context.Context, req *pb.UpdateGameSc
 	}, nil
 }
 
+// UpdateGameStatus cambia el estado de un juego siguiendo las transiciones
+// permitidas (ver models.GameStatus.CanTransitionTo). Con override un site_admin
+// puede forzar cualquier otro cambio indicando el motivo. Cada cambio queda en
+// el historial de estados del juego.
 func (gs *GameService) UpdateGameStatus(ctx context.Context, req *pb.UpdateGameStatusRequest) (*pb.UpdateGameStatusResponse, error) {
 	if req.GameId == "" {
-		return nil, status.Error(codes.InvalidArgument, "game_id is required")
+		return nil, shared.InvalidField("game_id", "game_id is required")
 	}
 
 	if req.Status == pb.GameStatus_GAME_STATUS_UNSPECIFIED {
-		return nil, status.Error(codes.InvalidArgument, "status is required")
+		return nil, shared.InvalidField("status", "status is required")
 	}
 
-	var game models.Game
-	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Game not found")
+	reason := strings.TrimSpace(req.Reason)
+	if req.Override && reason == "" {
+		return nil, shared.InvalidField("reason", "reason is required to override a status transition")
+	}
+	if req.Override && !auth.CallerHasRole(ctx, auth.RoleSiteAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "only site admins can override a status transition")
 	}
 
 	newStatus := gameStatusFromProto(req.Status)
-	updates := map[string]interface{}{
-		"status": newStatus,
-	}
+	var game models.Game
+	var change models.GameStatusChange
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// El bloqueo evita que dos cambios validen la transición contra el mismo estado
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", req.GameId).First(&game).Error; err != nil {
+			return status.Error(codes.NotFound, "Game not found")
+		}
 
-	// Si el juego se completa, determinar el ganador
-	if newStatus == models.GameStatusCompleted {
-		if game.HomeScore > game.AwayScore {
-			updates["winner_team_id"] = game.HomeTeamID
-		} else if game.AwayScore > game.HomeScore {
-			updates["winner_team_id"] = game.AwayTeamID
+		previousStatus := game.Status
+		if newStatus == previousStatus {
+			return status.Errorf(codes.FailedPrecondition, "game %s is already %s", game.ID, newStatus)
+		}
+		if !req.Override && !previousStatus.CanTransitionTo(newStatus) {
+			return status.Errorf(codes.FailedPrecondition, "cannot change game status from %s to %s (allowed: %s)",
+				previousStatus, newStatus, formatStatuses(previousStatus.NextStatuses()))
 		}
-	}
 
-	if err := database.DB.Model(&game).Updates(updates).Error; err != nil {
+		updates := map[string]interface{}{
+			"status": newStatus,
+		}
+
+		// Si el juego se completa, determinar el ganador; si deja de estar
+		// completo (solo con override), ya no tiene ganador
+		winnerTeamID := game.WinnerTeamID
+		if newStatus == models.GameStatusCompleted {
+			if game.HomeScore > game.AwayScore {
+				winnerTeamID = game.HomeTeamID
+				updates["winner_team_id"] = winnerTeamID
+			} else if game.AwayScore > game.HomeScore {
+				winnerTeamID = game.AwayTeamID
+				updates["winner_team_id"] = winnerTeamID
+			}
+		} else if previousStatus == models.GameStatusCompleted {
+			winnerTeamID = ""
+			updates["winner_team_id"] = winnerTeamID
+		}
+
+		// En playoffs siempre hay un ganador
+		if newStatus == models.GameStatusCompleted && playoffs.RoundOfWeek(game.Week) != "" && game.HomeScore == game.AwayScore {
+			return status.Error(codes.FailedPrecondition, "playoff games cannot end in a tie")
+		}
+
+		if err := updateGame(tx, &game, updates); err != nil {
+			return err
+		}
+		change = models.GameStatusChange{
+			ID:         idgen.New(idgen.PrefixStatus),
+			GameID:     game.ID,
+			FromStatus: previousStatus,
+			ToStatus:   newStatus,
+			Override:   req.Override,
+			Reason:     reason,
+		}
+		if err := tx.Create(&change).Error; err != nil {
+			return err
+		}
+		if newStatus == models.GameStatusCompleted {
+			if err := advancePlayoffs(tx, game); err != nil {
+				return err
+			}
+		}
+		// El Prediction Service se suscribe a este evento para calificar las predicciones
+		return events.Publish(tx, serviceName, events.TypeGameStatusChanged, game.ID, events.GameStatusChanged{
+			GameID:         game.ID,
+			PreviousStatus: string(previousStatus),
+			Status:         string(newStatus),
+			HomeScore:      game.HomeScore,
+			AwayScore:      game.AwayScore,
+			WinnerTeamID:   winnerTeamID,
+			Override:       req.Override,
+			Reason:         reason,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		log.Printf("Error updating game status: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to update game status: %v", err)
 	}
 
+	if change.Override {
+		log.Printf("Game %s status overridden from %s to %s: %s", game.ID, change.FromStatus, change.ToStatus, change.Reason)
+	}
+
 	// Recargar juego actualizado
 	database.DB.Where("id = ?", req.GameId).First(&game)
 
 	return &pb.UpdateGameStatusResponse{
 		Game:    modelGameToProto(game),
 		Message: "Game status updated successfully",
+		Change:  modelStatusChangeToProto(change),
 	}, nil
 }
+
+// GetGameStatusHistory devuelve los cambios de estado de un juego, del más antiguo al más reciente
+func (gs *GameService) GetGameStatusHistory(ctx context.Context, req *pb.GetGameStatusHistoryRequest) (*pb.GetGameStatusHistoryResponse, error) {
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+
+	var game models.Game
+	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Game not found")
+	}
+
+	var changes []models.GameStatusChange
+	if err := database.DB.Where("game_id = ?", game.ID).Order("created_at, id").Find(&changes).Error; err != nil {
+		log.Printf("Error fetching status history: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch status history: %v", err)
+	}
+
+	resp := &pb.GetGameStatusHistoryResponse{Game: modelGameToProto(game)}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, modelStatusChangeToProto(change))
+	}
+	return resp, nil
+}
+
+func (gs *GameService) UpdateGameLines(ctx context.Context, req *pb.UpdateGameLinesRequest) (*pb.UpdateGameLinesResponse, error) {
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+	if req.Spread == nil && req.Total == nil && req.LinesLockAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "spread, total or lines_lock_at is required")
+	}
+
+	var game models.Game
+	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Game not found")
+	}
+
+	// Las líneas se congelan en lines_lock_at (o al inicio del juego)
+	if game.Status != models.GameStatusScheduled || game.LinesLocked(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "game lines are locked")
+	}
+
+	updates := map[string]interface{}{}
+	if req.Spread != nil {
+		if err := validateLine(*req.Spread, maxSpread); err != nil {
+			return nil, shared.InvalidField("spread", "invalid spread: %v", err)
+		}
+		updates["spread"] = *req.Spread
+	}
+	if req.Total != nil {
+		if err := validateLine(*req.Total, maxTotal); err != nil || *req.Total <= 0 {
+			return nil, shared.InvalidField("total", "invalid total: must be a positive multiple of 0.5 up to %d", maxTotal)
+		}
+		updates["total"] = *req.Total
+	}
+	if req.LinesLockAt != nil {
+		lockAt := req.LinesLockAt.AsTime()
+		if lockAt.After(game.GameTime) {
+			return nil, shared.InvalidField("lines_lock_at", "lines_lock_at cannot be after kickoff")
+		}
+		updates["lines_lock_at"] = lockAt
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		return updateGame(tx, &game, updates)
+	}); err != nil {
+		log.Printf("Error updating game lines: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update game lines: %v", err)
+	}
+
+	// Recargar juego actualizado
+	database.DB.Where("id = ?", req.GameId).First(&game)
+
+	log.Printf("Updated lines for game %s", game.ID)
+
+	return &pb.UpdateGameLinesResponse{
+		Game:    modelGameToProto(game),
+		Message: "Game lines updated successfully",
+	}, nil
+}
+
+// maxSpread y maxTotal son las líneas más altas que se aceptan para un juego
+const (
+	maxSpread = 50
+	maxTotal  = 150
+)
+
+// validateLine verifica que una línea vaya en medios puntos y no supere max
+func validateLine(line, max float64) error {
+	if math.Abs(line) > max {
+		return fmt.Errorf("must be between -%g and %g", max, max)
+	}
+	if line*2 != math.Trunc(line*2) {
+		return fmt.Errorf("must be a multiple of 0.5")
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	"kickoff.com/game/internal/schedule"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/playoffs"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Playoffs
+// ========================================
+
+// GeneratePlayoffBracket siembra los playoffs de la temporada con la tabla
+// final (siete equipos por conferencia) y crea los juegos del Wild Card. Las
+// rondas siguientes se crean solas a medida que terminan los juegos.
+func (gs *GameService) GeneratePlayoffBracket(ctx context.Context, req *pb.GeneratePlayoffBracketRequest) (*pb.GeneratePlayoffBracketResponse, error) {
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+	season := int(req.Season)
+
+	var bracket *pb.PlayoffBracket
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var generated int64
+		if err := tx.Model(&models.PlayoffSeed{}).Where("season = ?", season).Count(&generated).Error; err != nil {
+			return err
+		}
+		if generated > 0 {
+			return status.Errorf(codes.AlreadyExists, "the %d playoff bracket was already generated", season)
+		}
+
+		var regularSeason []models.Game
+		if err := tx.Where("season = ? AND week <= ?", season, schedule.RegularSeasonWeeks).Find(&regularSeason).Error; err != nil {
+			return err
+		}
+		if len(regularSeason) == 0 {
+			return status.Errorf(codes.FailedPrecondition, "season %d has no regular-season games", season)
+		}
+		pending := 0
+		var lastGame time.Time
+		for _, game := range regularSeason {
+			if game.Status != models.GameStatusCompleted && game.Status != models.GameStatusCanceled {
+				pending++
+			}
+			if game.GameTime.After(lastGame) {
+				lastGame = game.GameTime
+			}
+		}
+		if pending > 0 {
+			return status.Errorf(codes.FailedPrecondition, "%d regular-season games of %d are not final", pending, season)
+		}
+
+		table, err := computeStandings(tx, season, schedule.RegularSeasonWeeks)
+		if err != nil {
+			return err
+		}
+		var seeds []playoffs.Seed
+		for _, s := range table {
+			if s.ConferenceRank < 1 || s.ConferenceRank > playoffs.TeamsPerConference {
+				continue
+			}
+			seed := models.PlayoffSeed{Season: season, Conference: s.Conference, Seed: s.ConferenceRank, TeamID: s.TeamID}
+			if err := tx.Create(&seed).Error; err != nil {
+				return err
+			}
+			seeds = append(seeds, playoffs.Seed{Conference: string(s.Conference), Seed: s.ConferenceRank, TeamID: s.TeamID})
+		}
+		if err := playoffs.ValidateSeeds(seeds); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "cannot seed the playoffs: %v", err)
+		}
+
+		kickoff := lastGame.Add(7 * 24 * time.Hour)
+		if req.WildCardStart != nil {
+			kickoff = req.WildCardStart.AsTime()
+		}
+		byConference := playoffs.Conferences(seeds)
+		for _, conference := range []models.Conference{models.ConferenceAFC, models.ConferenceNFC} {
+			// El sembrado 1 descansa en el Wild Card
+			for _, matchup := range playoffs.Pair(byConference[string(conference)][1:]) {
+				if _, err := createPlayoffGame(tx, season, playoffs.RoundWildCard, matchup, kickoff); err != nil {
+					return err
+				}
+			}
+		}
+
+		bracket, err = loadPlayoffBracket(tx, season)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error generating playoff bracket: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate playoff bracket: %v", err)
+	}
+
+	log.Printf("Generated %d playoff bracket", season)
+
+	return &pb.GeneratePlayoffBracketResponse{
+		Bracket: bracket,
+		Message: "Playoff bracket generated successfully",
+	}, nil
+}
+
+// GetPlayoffBracket devuelve los sembrados y los juegos de playoffs de la temporada
+func (gs *GameService) GetPlayoffBracket(ctx context.Context, req *pb.GetPlayoffBracketRequest) (*pb.GetPlayoffBracketResponse, error) {
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+
+	bracket, err := loadPlayoffBracket(database.DB, int(req.Season))
+	if err != nil {
+		log.Printf("Error fetching playoff bracket: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch playoff bracket: %v", err)
+	}
+	if len(bracket.Seeds) == 0 {
+		return nil, status.Error(codes.NotFound, "Playoff bracket not found")
+	}
+
+	return &pb.GetPlayoffBracketResponse{Bracket: bracket}, nil
+}
+
+// ========================================
+// Helper Functions - Playoffs
+// ========================================
+
+// advancePlayoffs crea la ronda siguiente cuando termina el último juego de
+// una ronda: en cada conferencia se vuelve a sembrar con los ganadores (y el
+// sembrado 1 después del Wild Card), y el Super Bowl espera a los dos
+// campeones de conferencia. Si la ronda siguiente ya existe no hace nada.
+func advancePlayoffs(tx *gorm.DB, game models.Game) error {
+	round := playoffs.RoundOfWeek(game.Week)
+	next := round.Next()
+	if round == "" || next == "" {
+		return nil
+	}
+
+	seeds, err := playoffSeeds(tx, game.Season)
+	if err != nil || len(seeds) == 0 {
+		// Sin sembrados los juegos de playoffs se cargaron a mano
+		return err
+	}
+	seedOf := make(map[string]playoffs.Seed, len(seeds))
+	for _, seed := range seeds {
+		seedOf[seed.TeamID] = seed
+	}
+	conference := seedOf[game.HomeTeamID].Conference
+
+	var roundGames []models.Game
+	if err := tx.Where("season = ? AND week = ?", game.Season, round.Week()).Find(&roundGames).Error; err != nil {
+		return err
+	}
+
+	var winners []playoffs.Seed
+	var lastGame time.Time
+	for _, g := range roundGames {
+		if round != playoffs.RoundConference && seedOf[g.HomeTeamID].Conference != conference {
+			continue
+		}
+		if g.Status != models.GameStatusCompleted {
+			return nil
+		}
+		winner, ok := seedOf[g.WinnerTeamID]
+		if !ok {
+			return nil
+		}
+		winners = append(winners, winner)
+		if g.GameTime.After(lastGame) {
+			lastGame = g.GameTime
+		}
+	}
+	if round == playoffs.RoundWildCard {
+		winners = append(winners, playoffs.Conferences(seeds)[conference][0])
+	}
+
+	var nextGames []models.Game
+	if err := tx.Where("season = ? AND week = ?", game.Season, next.Week()).Find(&nextGames).Error; err != nil {
+		return err
+	}
+
+	var matchups []playoffs.Matchup
+	kickoff := lastGame.Add(7 * 24 * time.Hour)
+	if next == playoffs.RoundSuperBowl {
+		if len(winners) != 2 || winners[0].Conference == winners[1].Conference || len(nextGames) > 0 {
+			return nil
+		}
+		afc, nfc := winners[0], winners[1]
+		if afc.Conference != string(models.ConferenceAFC) {
+			afc, nfc = nfc, afc
+		}
+		matchups = []playoffs.Matchup{playoffs.SuperBowl(game.Season, afc, nfc)}
+		// Semana libre antes del Super Bowl
+		kickoff = lastGame.Add(14 * 24 * time.Hour)
+	} else {
+		for _, g := range nextGames {
+			if seedOf[g.HomeTeamID].Conference == conference {
+				return nil
+			}
+		}
+		if len(winners) < 2 || len(winners)%2 != 0 {
+			return nil
+		}
+		matchups = playoffs.Pair(winners)
+	}
+
+	for _, matchup := range matchups {
+		created, err := createPlayoffGame(tx, game.Season, next, matchup, kickoff)
+		if err != nil {
+			return err
+		}
+		log.Printf("Created %s game %s: %s vs %s", next, created.ID, created.HomeTeamID, created.AwayTeamID)
+	}
+	return nil
+}
+
+// createPlayoffGame crea un juego de playoffs y registra GameScheduled
+func createPlayoffGame(tx *gorm.DB, season int, round playoffs.Round, matchup playoffs.Matchup, kickoff time.Time) (models.Game, error) {
+	game := models.Game{
+		ID:         idgen.New(idgen.PrefixGame),
+		Week:       round.Week(),
+		Season:     season,
+		HomeTeamID: matchup.Home.TeamID,
+		AwayTeamID: matchup.Away.TeamID,
+		GameTime:   kickoff,
+		Status:     models.GameStatusScheduled,
+	}
+	if err := createGame(tx, &game); err != nil {
+		return game, err
+	}
+	return game, events.Publish(tx, serviceName, events.TypeGameScheduled, game.ID, events.GameScheduled{
+		GameID:     game.ID,
+		Week:       game.Week,
+		Season:     game.Season,
+		HomeTeamID: game.HomeTeamID,
+		AwayTeamID: game.AwayTeamID,
+		GameTime:   game.GameTime,
+	})
+}
+
+func playoffSeeds(db *gorm.DB, season int) ([]playoffs.Seed, error) {
+	var rows []models.PlayoffSeed
+	if err := db.Where("season = ?", season).Order("conference, seed").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	seeds := make([]playoffs.Seed, 0, len(rows))
+	for _, row := range rows {
+		seeds = append(seeds, playoffs.Seed{Conference: string(row.Conference), Seed: row.Seed, TeamID: row.TeamID})
+	}
+	return seeds, nil
+}
+
+// loadPlayoffBracket arma el bracket con los sembrados y los juegos de playoffs
+// de la temporada; sin sembrados devuelve un bracket vacío
+func loadPlayoffBracket(db *gorm.DB, season int) (*pb.PlayoffBracket, error) {
+	seeds, err := playoffSeeds(db, season)
+	if err != nil {
+		return nil, err
+	}
+
+	var games []models.Game
+	if err := db.Where("season = ? AND week >= ?", season, playoffs.FirstWeek).
+		Order("week, game_time, id").
+		Find(&games).Error; err != nil {
+		return nil, err
+	}
+
+	bracket := &pb.PlayoffBracket{Season: int32(season)}
+	seedOf := make(map[string]playoffs.Seed, len(seeds))
+	for _, seed := range seeds {
+		seedOf[seed.TeamID] = seed
+		bracket.Seeds = append(bracket.Seeds, &pb.PlayoffSeed{
+			Conference: conferenceToProto(models.Conference(seed.Conference)),
+			Seed:       int32(seed.Seed),
+			TeamId:     seed.TeamID,
+		})
+	}
+
+	for _, game := range games {
+		round := playoffs.RoundOfWeek(game.Week)
+		pbGame := &pb.PlayoffGame{
+			Round:    playoffRoundToProto(round),
+			HomeSeed: int32(seedOf[game.HomeTeamID].Seed),
+			AwaySeed: int32(seedOf[game.AwayTeamID].Seed),
+			Game:     modelGameToProto(game),
+		}
+		if round != playoffs.RoundSuperBowl {
+			pbGame.Conference = conferenceToProto(models.Conference(seedOf[game.HomeTeamID].Conference))
+		} else if game.Status == models.GameStatusCompleted {
+			bracket.ChampionTeamId = game.WinnerTeamID
+		}
+		bracket.Games = append(bracket.Games, pbGame)
+	}
+	return bracket, nil
+}
+
+func playoffRoundToProto(round playoffs.Round) pb.PlayoffRound {
+	switch round {
+	case playoffs.RoundWildCard:
+		return pb.PlayoffRound_PLAYOFF_ROUND_WILD_CARD
+	case playoffs.RoundDivisional:
+		return pb.PlayoffRound_PLAYOFF_ROUND_DIVISIONAL
+	case playoffs.RoundConference:
+		return pb.PlayoffRound_PLAYOFF_ROUND_CONFERENCE
+	case playoffs.RoundSuperBowl:
+		return pb.PlayoffRound_PLAYOFF_ROUND_SUPER_BOWL
+	default:
+		return pb.PlayoffRound_PLAYOFF_ROUND_UNSPECIFIED
+	}
+}
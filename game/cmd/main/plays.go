@@ -0,0 +1,474 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	"kickoff.com/game/internal/plays"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/playoffs"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Play-by-play
+// ========================================
+
+// RecordScoringPlay agrega una anotación al registro de un juego en vivo y
+// recalcula el marcador con el registro
+func (gs *GameService) RecordScoringPlay(ctx context.Context, req *pb.RecordScoringPlayRequest) (*pb.RecordScoringPlayResponse, error) {
+	if req.GameId == "" || req.TeamId == "" {
+		return nil, status.Error(codes.InvalidArgument, "game_id and team_id are required")
+	}
+	playType := scoringPlayTypeFromProto(req.Type)
+	points, ok := plays.Points(playType)
+	if !ok {
+		return nil, shared.InvalidField("type", "type must be a touchdown, field goal, safety, extra point or two-point conversion")
+	}
+	if req.Quarter < 1 {
+		return nil, shared.InvalidField("quarter", "quarter must be 1 or greater")
+	}
+	clockSeconds, err := plays.ParseClock(req.Clock)
+	if err != nil {
+		return nil, shared.InvalidField("clock", "invalid clock: %v", err)
+	}
+
+	var game models.Game
+	var play models.ScoringPlay
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		scoringLog, err := lockScoringLog(tx, req.GameId, &game)
+		if err != nil {
+			return err
+		}
+		if game.Status != models.GameStatusLive {
+			return status.Error(codes.FailedPrecondition, "scoring plays can only be recorded while the game is live")
+		}
+		teamID := strings.ToUpper(req.TeamId)
+		if teamID != game.HomeTeamID && teamID != game.AwayTeamID {
+			return status.Errorf(codes.InvalidArgument, "team %s is not playing game %s", teamID, game.ID)
+		}
+
+		play = models.ScoringPlay{
+			Action:       models.PlayActionRecord,
+			TeamID:       teamID,
+			Type:         playType,
+			Points:       points,
+			Quarter:      int(req.Quarter),
+			ClockSeconds: clockSeconds,
+			Description:  req.Description,
+		}
+		if _, err := appendScoringPlay(tx, &game, scoringLog, &play); err != nil {
+			return err
+		}
+
+		// La anotación también marca el momento del juego
+		game.Quarter, game.ClockSeconds = play.Quarter, play.ClockSeconds
+		return updateGame(tx, &game, map[string]interface{}{
+			"quarter":       game.Quarter,
+			"clock_seconds": game.ClockSeconds,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error recording scoring play: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to record scoring play: %v", err)
+	}
+
+	log.Printf("Recorded %s for %s in game %s (%d-%d)", play.Type, play.TeamID, game.ID, game.HomeScore, game.AwayScore)
+
+	return &pb.RecordScoringPlayResponse{
+		Play:    modelScoringPlayToProto(play, true),
+		Game:    modelGameToProto(game),
+		Message: "Scoring play recorded successfully",
+	}, nil
+}
+
+// AmendScoringPlay corrige o anula una anotación vigente. La anotación
+// original queda en el registro y la enmienda se agrega con su motivo.
+func (gs *GameService) AmendScoringPlay(ctx context.Context, req *pb.AmendScoringPlayRequest) (*pb.AmendScoringPlayResponse, error) {
+	if req.GameId == "" || req.PlayId == "" {
+		return nil, status.Error(codes.InvalidArgument, "game_id and play_id are required")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, shared.InvalidField("reason", "reason is required to amend a scoring play")
+	}
+
+	var game models.Game
+	var amendment models.ScoringPlay
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		scoringLog, err := lockScoringLog(tx, req.GameId, &game)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, play := range plays.Effective(scoringLog) {
+			if play.ID == req.PlayId {
+				amendment, found = play, true
+				break
+			}
+		}
+		if !found {
+			return status.Errorf(codes.NotFound, "scoring play %s is not an effective play of game %s", req.PlayId, game.ID)
+		}
+
+		amendment.AmendsPlayID = amendment.ID
+		amendment.CreatedAt = time.Time{}
+		amendment.Reason = strings.TrimSpace(req.Reason)
+		if req.Void {
+			amendment.Action = models.PlayActionVoid
+			amendment.Points = 0
+		} else {
+			amendment.Action = models.PlayActionCorrect
+			if err := applyCorrection(&amendment, req, game); err != nil {
+				return err
+			}
+		}
+
+		if _, err := appendScoringPlay(tx, &game, scoringLog, &amendment); err != nil {
+			return err
+		}
+		// Un juego de playoffs terminado no puede quedar empatado por una corrección
+		if game.Status == models.GameStatusCompleted && playoffs.RoundOfWeek(game.Week) != "" && game.HomeScore == game.AwayScore {
+			return status.Error(codes.FailedPrecondition, "playoff games cannot end in a tie")
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error amending scoring play: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to amend scoring play: %v", err)
+	}
+
+	log.Printf("Amended scoring play %s in game %s (%s): %s", req.PlayId, game.ID, amendment.Action, amendment.Reason)
+
+	return &pb.AmendScoringPlayResponse{
+		Play:    modelScoringPlayToProto(amendment, amendment.Action != models.PlayActionVoid),
+		Game:    modelGameToProto(game),
+		Message: "Scoring play amended successfully",
+	}, nil
+}
+
+// GetPlayByPlay devuelve el registro completo de anotaciones de un juego,
+// enmiendas incluidas, indicando cuáles cuentan para el marcador
+func (gs *GameService) GetPlayByPlay(ctx context.Context, req *pb.GetPlayByPlayRequest) (*pb.GetPlayByPlayResponse, error) {
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+
+	var game models.Game
+	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Game not found")
+	}
+
+	scoringLog, err := loadScoringLog(database.DB, game.ID)
+	if err != nil {
+		log.Printf("Error fetching play-by-play: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch play-by-play: %v", err)
+	}
+
+	effective := make(map[string]bool)
+	for _, play := range plays.Effective(scoringLog) {
+		effective[play.ID] = true
+	}
+	pbPlays := make([]*pb.ScoringPlay, 0, len(scoringLog))
+	for _, play := range scoringLog {
+		pbPlays = append(pbPlays, modelScoringPlayToProto(play, effective[play.ID]))
+	}
+
+	return &pb.GetPlayByPlayResponse{
+		Game:  modelGameToProto(game),
+		Plays: pbPlays,
+	}, nil
+}
+
+// UpdateGameClock actualiza el cuarto, el reloj y la posesión de un juego en vivo
+func (gs *GameService) UpdateGameClock(ctx context.Context, req *pb.UpdateGameClockRequest) (*pb.UpdateGameClockResponse, error) {
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+	if req.Quarter < 1 {
+		return nil, shared.InvalidField("quarter", "quarter must be 1 or greater")
+	}
+	clockSeconds, err := plays.ParseClock(req.Clock)
+	if err != nil {
+		return nil, shared.InvalidField("clock", "invalid clock: %v", err)
+	}
+
+	var game models.Game
+	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Game not found")
+	}
+	if game.Status != models.GameStatusLive {
+		return nil, status.Error(codes.FailedPrecondition, "the game clock can only be updated while the game is live")
+	}
+	possession := strings.ToUpper(req.PossessionTeamId)
+	if possession != "" && possession != game.HomeTeamID && possession != game.AwayTeamID {
+		return nil, status.Errorf(codes.InvalidArgument, "team %s is not playing game %s", possession, game.ID)
+	}
+
+	updates := map[string]interface{}{
+		"quarter":            int(req.Quarter),
+		"clock_seconds":      clockSeconds,
+		"possession_team_id": possession,
+	}
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		return updateGame(tx, &game, updates)
+	}); err != nil {
+		log.Printf("Error updating game clock: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update game clock: %v", err)
+	}
+
+	// Recargar juego actualizado
+	database.DB.Where("id = ?", req.GameId).First(&game)
+
+	return &pb.UpdateGameClockResponse{
+		Game:    modelGameToProto(game),
+		Message: "Game clock updated successfully",
+	}, nil
+}
+
+// ========================================
+// Helper Functions - Play-by-play
+// ========================================
+
+// lockScoringLog bloquea el juego hasta el final de la transacción y devuelve
+// su registro de anotaciones. Si el juego ya tenía marcador antes de usar el
+// registro, ese marcador se agrega como ajuste para que el registro lo explique.
+func lockScoringLog(tx *gorm.DB, gameID string, game *models.Game) ([]models.ScoringPlay, error) {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", gameID).First(game).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Game not found")
+	}
+
+	scoringLog, err := loadScoringLog(tx, game.ID)
+	if err != nil || len(scoringLog) > 0 {
+		return scoringLog, err
+	}
+	for _, side := range []struct {
+		teamID string
+		score  int
+	}{{game.HomeTeamID, game.HomeScore}, {game.AwayTeamID, game.AwayScore}} {
+		if side.score == 0 {
+			continue
+		}
+		baseline := models.ScoringPlay{
+			ID:           idgen.New(idgen.PrefixPlay),
+			GameID:       game.ID,
+			Sequence:     len(scoringLog) + 1,
+			Action:       models.PlayActionRecord,
+			TeamID:       side.teamID,
+			Type:         models.PlayAdjustment,
+			Points:       side.score,
+			Quarter:      game.Quarter,
+			ClockSeconds: game.ClockSeconds,
+			Description:  "Score before play-by-play",
+		}
+		if err := tx.Create(&baseline).Error; err != nil {
+			return nil, err
+		}
+		scoringLog = append(scoringLog, baseline)
+	}
+	return scoringLog, nil
+}
+
+// appendScoringPlay agrega la entrada al final del registro, recalcula el
+// marcador y, si cambió, lo guarda y registra ScoreUpdated. En un juego
+// terminado también se recalcula el ganador.
+func appendScoringPlay(tx *gorm.DB, game *models.Game, scoringLog []models.ScoringPlay, play *models.ScoringPlay) ([]models.ScoringPlay, error) {
+	play.ID = idgen.New(idgen.PrefixPlay)
+	play.GameID = game.ID
+	play.Sequence = len(scoringLog) + 1
+	if err := tx.Create(play).Error; err != nil {
+		return nil, err
+	}
+	scoringLog = append(scoringLog, *play)
+
+	home, away := plays.Score(scoringLog, game.HomeTeamID, game.AwayTeamID)
+	if home == game.HomeScore && away == game.AwayScore {
+		return scoringLog, nil
+	}
+	game.HomeScore, game.AwayScore = home, away
+	updates := map[string]interface{}{
+		"home_score": home,
+		"away_score": away,
+	}
+	if game.Status == models.GameStatusCompleted {
+		game.WinnerTeamID = ""
+		if home > away {
+			game.WinnerTeamID = game.HomeTeamID
+		} else if away > home {
+			game.WinnerTeamID = game.AwayTeamID
+		}
+		updates["winner_team_id"] = game.WinnerTeamID
+	}
+	if err := updateGame(tx, game, updates); err != nil {
+		return nil, err
+	}
+	return scoringLog, events.Publish(tx, serviceName, events.TypeScoreUpdated, game.ID, events.ScoreUpdated{
+		GameID:    game.ID,
+		HomeScore: home,
+		AwayScore: away,
+	})
+}
+
+// applyCorrection reemplaza en la enmienda los valores pedidos; los campos
+// vacíos conservan el valor de la anotación corregida
+func applyCorrection(amendment *models.ScoringPlay, req *pb.AmendScoringPlayRequest, game models.Game) error {
+	if req.TeamId != "" {
+		teamID := strings.ToUpper(req.TeamId)
+		if teamID != game.HomeTeamID && teamID != game.AwayTeamID {
+			return status.Errorf(codes.InvalidArgument, "team %s is not playing game %s", teamID, game.ID)
+		}
+		amendment.TeamID = teamID
+	}
+	if req.Type != pb.ScoringPlayType_SCORING_PLAY_TYPE_UNSPECIFIED {
+		points, ok := plays.Points(scoringPlayTypeFromProto(req.Type))
+		if !ok {
+			return shared.InvalidField("type", "type must be a touchdown, field goal, safety, extra point or two-point conversion")
+		}
+		amendment.Type = scoringPlayTypeFromProto(req.Type)
+		amendment.Points = points
+	}
+	if req.Quarter != 0 {
+		if req.Quarter < 1 {
+			return shared.InvalidField("quarter", "quarter must be 1 or greater")
+		}
+		amendment.Quarter = int(req.Quarter)
+	}
+	if req.Clock != "" {
+		clockSeconds, err := plays.ParseClock(req.Clock)
+		if err != nil {
+			return shared.InvalidField("clock", "invalid clock: %v", err)
+		}
+		amendment.ClockSeconds = clockSeconds
+	}
+	if req.Description != "" {
+		amendment.Description = req.Description
+	}
+	return nil
+}
+
+// setScoreFromLog lleva el marcador a los valores pedidos agregando un ajuste
+// por cada equipo cuyo puntaje cambia, así el registro sigue explicando el
+// marcador. Solo se acepta en vivo; un juego terminado se corrige con override.
+func setScoreFromLog(tx *gorm.DB, gameID string, homeScore, awayScore int, override bool, reason string) (models.Game, error) {
+	var game models.Game
+	scoringLog, err := lockScoringLog(tx, gameID, &game)
+	if err != nil {
+		return game, err
+	}
+	switch {
+	case game.Status == models.GameStatusLive:
+	case game.Status == models.GameStatusCompleted && override:
+	case game.Status == models.GameStatusCompleted:
+		return game, status.Errorf(codes.FailedPrecondition, "game %s is completed: correcting its score requires override with a reason", game.ID)
+	default:
+		return game, status.Errorf(codes.FailedPrecondition, "scores can only be updated while the game is live (game %s is %s)", game.ID, game.Status)
+	}
+
+	home, away := plays.Score(scoringLog, game.HomeTeamID, game.AwayTeamID)
+	for _, side := range []struct {
+		teamID string
+		delta  int
+	}{{game.HomeTeamID, homeScore - home}, {game.AwayTeamID, awayScore - away}} {
+		if side.delta == 0 {
+			continue
+		}
+		adjustment := models.ScoringPlay{
+			Action:       models.PlayActionRecord,
+			TeamID:       side.teamID,
+			Type:         models.PlayAdjustment,
+			Points:       side.delta,
+			Quarter:      game.Quarter,
+			ClockSeconds: game.ClockSeconds,
+			Description:  fmt.Sprintf("Score set to %d-%d", homeScore, awayScore),
+			Reason:       reason,
+		}
+		if scoringLog, err = appendScoringPlay(tx, &game, scoringLog, &adjustment); err != nil {
+			return game, err
+		}
+	}
+	// Un juego de playoffs terminado no puede quedar empatado por una corrección
+	if game.Status == models.GameStatusCompleted && playoffs.RoundOfWeek(game.Week) != "" && game.HomeScore == game.AwayScore {
+		return game, status.Error(codes.FailedPrecondition, "playoff games cannot end in a tie")
+	}
+	return game, nil
+}
+
+func loadScoringLog(db *gorm.DB, gameID string) ([]models.ScoringPlay, error) {
+	var scoringLog []models.ScoringPlay
+	err := db.Where("game_id = ?", gameID).Order("sequence").Find(&scoringLog).Error
+	return scoringLog, err
+}
+
+func modelScoringPlayToProto(play models.ScoringPlay, effective bool) *pb.ScoringPlay {
+	return &pb.ScoringPlay{
+		Id:           play.ID,
+		GameId:       play.GameID,
+		Sequence:     int32(play.Sequence),
+		Action:       scoringPlayActionToProto(play.Action),
+		AmendsPlayId: play.AmendsPlayID,
+		TeamId:       play.TeamID,
+		Type:         scoringPlayTypeToProto(play.Type),
+		Points:       int32(play.Points),
+		Quarter:      int32(play.Quarter),
+		Clock:        plays.FormatClock(play.ClockSeconds),
+		Description:  play.Description,
+		Reason:       play.Reason,
+		Effective:    effective,
+		CreatedAt:    timestamppb.New(play.CreatedAt),
+	}
+}
+
+var scoringPlayTypes = map[models.PlayType]pb.ScoringPlayType{
+	models.PlayTouchdown:  pb.ScoringPlayType_SCORING_PLAY_TYPE_TOUCHDOWN,
+	models.PlayFieldGoal:  pb.ScoringPlayType_SCORING_PLAY_TYPE_FIELD_GOAL,
+	models.PlaySafety:     pb.ScoringPlayType_SCORING_PLAY_TYPE_SAFETY,
+	models.PlayExtraPoint: pb.ScoringPlayType_SCORING_PLAY_TYPE_EXTRA_POINT,
+	models.PlayTwoPoint:   pb.ScoringPlayType_SCORING_PLAY_TYPE_TWO_POINT,
+	models.PlayAdjustment: pb.ScoringPlayType_SCORING_PLAY_TYPE_ADJUSTMENT,
+}
+
+func scoringPlayTypeToProto(playType models.PlayType) pb.ScoringPlayType {
+	return scoringPlayTypes[playType]
+}
+
+func scoringPlayTypeFromProto(playType pb.ScoringPlayType) models.PlayType {
+	for modelType, protoType := range scoringPlayTypes {
+		if protoType == playType {
+			return modelType
+		}
+	}
+	return ""
+}
+
+func scoringPlayActionToProto(action models.PlayAction) pb.ScoringPlayAction {
+	switch action {
+	case models.PlayActionRecord:
+		return pb.ScoringPlayAction_SCORING_PLAY_ACTION_RECORD
+	case models.PlayActionCorrect:
+		return pb.ScoringPlayAction_SCORING_PLAY_ACTION_CORRECT
+	case models.PlayActionVoid:
+		return pb.ScoringPlayAction_SCORING_PLAY_ACTION_VOID
+	default:
+		return pb.ScoringPlayAction_SCORING_PLAY_ACTION_UNSPECIFIED
+	}
+}
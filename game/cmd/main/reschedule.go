@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	"kickoff.com/game/internal/schedule"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/playoffs"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Rescheduling
+// ========================================
+
+// RescheduleGame mueve un juego programado o postergado a otro horario y,
+// opcionalmente, a otra semana. Un juego postergado vuelve a scheduled, así
+// que la ventana de predicciones se abre de nuevo hasta el nuevo inicio. El
+// Prediction Service conserva o anula las predicciones pendientes según la
+// política del request o, si no se indica, la del servicio.
+func (gs *GameService) RescheduleGame(ctx context.Context, req *pb.RescheduleGameRequest) (*pb.RescheduleGameResponse, error) {
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+	if req.ScheduledAt == nil {
+		return nil, shared.InvalidField("scheduled_at", "scheduled_at is required")
+	}
+	if !req.ScheduledAt.AsTime().After(time.Now()) {
+		return nil, shared.InvalidField("scheduled_at", "scheduled_at must be in the future")
+	}
+	if req.Week < 0 || req.Week > playoffs.LastWeek {
+		return nil, shared.InvalidField("week", "week must be between 1 and %d", playoffs.LastWeek)
+	}
+
+	policy := req.PredictionPolicy
+	if policy == pb.ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED {
+		policy = gs.reschedulePolicy
+	}
+	if _, ok := pb.ReschedulePredictionPolicy_name[int32(policy)]; !ok {
+		return nil, shared.InvalidField("prediction_policy", "invalid prediction_policy")
+	}
+	gameTime := req.ScheduledAt.AsTime()
+	reason := strings.TrimSpace(req.Reason)
+
+	var game models.Game
+	var change *models.GameStatusChange
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", req.GameId).First(&game).Error; err != nil {
+			return status.Error(codes.NotFound, "Game not found")
+		}
+		if game.Status != models.GameStatusScheduled && game.Status != models.GameStatusPostponed {
+			return status.Errorf(codes.FailedPrecondition, "only scheduled or postponed games can be rescheduled (game %s is %s)", game.ID, game.Status)
+		}
+
+		week := game.Week
+		if req.Week > 0 {
+			week = int(req.Week)
+		}
+		if err := checkRescheduleWeek(tx, game, week); err != nil {
+			return err
+		}
+
+		previous := game
+		updates := map[string]interface{}{
+			"game_time": gameTime,
+			"week":      week,
+		}
+		// El cierre de líneas conserva su distancia al inicio del juego
+		if game.LinesLockAt != nil {
+			updates["lines_lock_at"] = game.LinesLockAt.Add(gameTime.Sub(game.GameTime))
+		}
+		if game.Status == models.GameStatusPostponed {
+			updates["status"] = models.GameStatusScheduled
+		}
+		if err := updateGame(tx, &game, updates); err != nil {
+			return err
+		}
+		game.GameTime = gameTime
+		game.Week = week
+
+		if previous.Status == models.GameStatusPostponed {
+			game.Status = models.GameStatusScheduled
+			change = &models.GameStatusChange{
+				ID:         idgen.New(idgen.PrefixStatus),
+				GameID:     game.ID,
+				FromStatus: previous.Status,
+				ToStatus:   game.Status,
+				Reason:     reason,
+			}
+			if err := tx.Create(change).Error; err != nil {
+				return err
+			}
+			if err := events.Publish(tx, serviceName, events.TypeGameStatusChanged, game.ID, events.GameStatusChanged{
+				GameID:         game.ID,
+				PreviousStatus: string(previous.Status),
+				Status:         string(game.Status),
+				HomeScore:      game.HomeScore,
+				AwayScore:      game.AwayScore,
+				Reason:         reason,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// El Prediction Service se suscribe a este evento para aplicar la política
+		return events.Publish(tx, serviceName, events.TypeGameRescheduled, game.ID, events.GameRescheduled{
+			GameID:           game.ID,
+			Season:           game.Season,
+			PreviousWeek:     previous.Week,
+			Week:             game.Week,
+			PreviousGameTime: previous.GameTime,
+			GameTime:         game.GameTime,
+			PredictionPolicy: reschedulePolicyName(policy),
+			Reason:           reason,
+		})
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error rescheduling game: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reschedule game: %v", err)
+	}
+
+	log.Printf("Rescheduled game %s to week %d at %s (predictions: %s)",
+		game.ID, game.Week, game.GameTime.Format("2006-01-02 15:04"), reschedulePolicyName(policy))
+
+	// Recargar juego actualizado
+	database.DB.Where("id = ?", req.GameId).First(&game)
+
+	resp := &pb.RescheduleGameResponse{
+		Game:             modelGameToProto(game),
+		Message:          "Game rescheduled successfully",
+		PredictionPolicy: policy,
+	}
+	if change != nil {
+		resp.Change = modelStatusChangeToProto(*change)
+	}
+	return resp, nil
+}
+
+// ========================================
+// Helper Functions - Rescheduling
+// ========================================
+
+// checkRescheduleWeek verifica que el juego pueda pasar a la semana indicada:
+// los juegos de playoffs no cambian de ronda, un juego de temporada regular
+// no pasa a playoffs y ninguno de los equipos juega otra vez esa semana
+func checkRescheduleWeek(tx *gorm.DB, game models.Game, week int) error {
+	if week == game.Week {
+		return nil
+	}
+	if playoffs.RoundOfWeek(game.Week) != "" {
+		return status.Error(codes.FailedPrecondition, "playoff games cannot change week")
+	}
+	if week > schedule.RegularSeasonWeeks {
+		return status.Errorf(codes.InvalidArgument, "regular-season games must stay between weeks 1 and %d", schedule.RegularSeasonWeeks)
+	}
+
+	var conflict models.Game
+	err := tx.Where("id <> ? AND season = ? AND week = ? AND status <> ?", game.ID, game.Season, week, models.GameStatusCanceled).
+		Where("home_team_id IN ? OR away_team_id IN ?",
+			[]string{game.HomeTeamID, game.AwayTeamID}, []string{game.HomeTeamID, game.AwayTeamID}).
+		Limit(1).Find(&conflict).Error
+	if err != nil {
+		return err
+	}
+	if conflict.ID != "" {
+		return status.Errorf(codes.FailedPrecondition, "%s vs %s cannot move to week %d: game %s is already that week",
+			game.HomeTeamID, game.AwayTeamID, week, conflict.ID)
+	}
+	return nil
+}
+
+// reschedulePolicyFromEnv lee la política por defecto de RESCHEDULE_PREDICTION_POLICY
+// ("keep" o "void"); sin definir, las predicciones se conservan
+func reschedulePolicyFromEnv() (pb.ReschedulePredictionPolicy, error) {
+	switch value := strings.ToLower(os.Getenv("RESCHEDULE_PREDICTION_POLICY")); value {
+	case "", events.PredictionPolicyKeep:
+		return pb.ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_KEEP, nil
+	case events.PredictionPolicyVoid:
+		return pb.ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_VOID, nil
+	default:
+		return 0, fmt.Errorf("invalid RESCHEDULE_PREDICTION_POLICY %q: must be keep or void", value)
+	}
+}
+
+func reschedulePolicyName(policy pb.ReschedulePredictionPolicy) string {
+	if policy == pb.ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_VOID {
+		return events.PredictionPolicyVoid
+	}
+	return events.PredictionPolicyKeep
+}
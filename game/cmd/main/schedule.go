@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	_ "time/tzdata" // Zonas horarias de los DTSTART;TZID=... en la imagen alpine
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	"kickoff.com/game/internal/schedule"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Schedule Import
+// ========================================
+
+// ImportSchedule crea o actualiza los juegos de un calendario completo. Si el
+// archivo tiene algún problema no se aplica nada; con dry_run solo se
+// devuelve el reporte de cambios. Importar dos veces el mismo archivo no
+// cambia nada la segunda vez.
+func (gs *GameService) ImportSchedule(ctx context.Context, req *pb.ImportScheduleRequest) (*pb.ImportScheduleResponse, error) {
+	if len(req.Content) == 0 {
+		return nil, shared.InvalidField("content", "content is required")
+	}
+	if req.Season < 0 {
+		return nil, shared.InvalidField("season", "season must be positive")
+	}
+
+	format := scheduleFormatFromProto(req.Format)
+	if format == "" {
+		format = schedule.DetectFormat(req.Content)
+	}
+
+	entries, issues := schedule.Parse(format, req.Content, int(req.Season))
+	if len(entries) == 0 && len(issues) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the schedule has no games")
+	}
+
+	resp := &pb.ImportScheduleResponse{}
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var existing []models.Game
+		if len(entries) > 0 {
+			if err := tx.Where("season IN ?", entrySeasons(entries)).Find(&existing).Error; err != nil {
+				return err
+			}
+		}
+
+		changes, planIssues := schedule.Plan(entries, existing)
+		issues = append(issues, planIssues...)
+		for _, change := range changes {
+			resp.Changes = append(resp.Changes, scheduleChangeToProto(change))
+		}
+		if req.DryRun || len(issues) > 0 {
+			return nil
+		}
+
+		for i, change := range changes {
+			game, err := applyScheduleChange(tx, change)
+			if err != nil {
+				return err
+			}
+			resp.Changes[i].GameId = game.ID
+		}
+		resp.Applied = true
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error importing schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to import schedule: %v", err)
+	}
+
+	for _, change := range resp.Changes {
+		switch schedule.Action(change.Action) {
+		case schedule.ActionCreate:
+			resp.Created++
+		case schedule.ActionUpdate:
+			resp.Updated++
+		case schedule.ActionUnchanged:
+			resp.Unchanged++
+		}
+	}
+	for _, issue := range issues {
+		resp.Issues = append(resp.Issues, &pb.ScheduleIssue{Line: int32(issue.Line), Message: issue.Message})
+	}
+
+	switch {
+	case len(issues) > 0:
+		resp.Message = fmt.Sprintf("Schedule not imported: %d issues found", len(issues))
+	case req.DryRun:
+		resp.Message = fmt.Sprintf("Dry run: %d to create, %d to update, %d unchanged", resp.Created, resp.Updated, resp.Unchanged)
+	default:
+		resp.Message = fmt.Sprintf("Schedule imported: %d created, %d updated, %d unchanged", resp.Created, resp.Updated, resp.Unchanged)
+		log.Printf("Imported %s schedule: %d created, %d updated, %d unchanged", format, resp.Created, resp.Updated, resp.Unchanged)
+	}
+
+	return resp, nil
+}
+
+// ========================================
+// Helper Functions - Schedule Import
+// ========================================
+
+// applyScheduleChange guarda un cambio del plan y registra GameScheduled para
+// los juegos nuevos o reprogramados
+func applyScheduleChange(tx *gorm.DB, change schedule.Change) (models.Game, error) {
+	entry := change.Entry
+
+	var game models.Game
+	switch change.Action {
+	case schedule.ActionUnchanged:
+		return *change.Game, nil
+
+	case schedule.ActionCreate:
+		game = models.Game{
+			ID:         idgen.New(idgen.PrefixGame),
+			ExternalID: entry.ExternalID,
+			Week:       entry.Week,
+			Season:     entry.Season,
+			HomeTeamID: entry.HomeTeamID,
+			AwayTeamID: entry.AwayTeamID,
+			GameTime:   entry.GameTime,
+			Status:     models.GameStatusScheduled,
+		}
+		if err := createGame(tx, &game); err != nil {
+			return game, err
+		}
+
+	case schedule.ActionUpdate:
+		game = *change.Game
+		if entry.ExternalID != "" {
+			game.ExternalID = entry.ExternalID
+		}
+		game.Week = entry.Week
+		game.Season = entry.Season
+		game.HomeTeamID = entry.HomeTeamID
+		game.AwayTeamID = entry.AwayTeamID
+		game.GameTime = entry.GameTime
+		if err := updateGame(tx, &game, map[string]interface{}{
+			"external_id":  game.ExternalID,
+			"week":         game.Week,
+			"season":       game.Season,
+			"home_team_id": game.HomeTeamID,
+			"away_team_id": game.AwayTeamID,
+			"game_time":    game.GameTime,
+		}); err != nil {
+			return game, err
+		}
+	}
+
+	return game, events.Publish(tx, serviceName, events.TypeGameScheduled, game.ID, events.GameScheduled{
+		GameID:     game.ID,
+		Week:       game.Week,
+		Season:     game.Season,
+		HomeTeamID: game.HomeTeamID,
+		AwayTeamID: game.AwayTeamID,
+		GameTime:   game.GameTime,
+	})
+}
+
+func entrySeasons(entries []schedule.Entry) []int {
+	seen := make(map[int]bool)
+	var seasons []int
+	for _, entry := range entries {
+		if !seen[entry.Season] {
+			seen[entry.Season] = true
+			seasons = append(seasons, entry.Season)
+		}
+	}
+	return seasons
+}
+
+func scheduleChangeToProto(change schedule.Change) *pb.ScheduleChange {
+	pbChange := &pb.ScheduleChange{
+		Action:      string(change.Action),
+		ExternalId:  change.Entry.ExternalID,
+		Season:      int32(change.Entry.Season),
+		Week:        int32(change.Entry.Week),
+		HomeTeamId:  change.Entry.HomeTeamID,
+		AwayTeamId:  change.Entry.AwayTeamID,
+		ScheduledAt: timestamppb.New(change.Entry.GameTime),
+	}
+	if change.Game != nil {
+		pbChange.GameId = change.Game.ID
+	}
+	for _, field := range change.Fields {
+		pbChange.Changes = append(pbChange.Changes, &pb.ScheduleFieldChange{
+			Field:    field.Field,
+			OldValue: field.Old,
+			NewValue: field.New,
+		})
+	}
+	return pbChange
+}
+
+func scheduleFormatFromProto(format pb.ScheduleFormat) schedule.Format {
+	switch format {
+	case pb.ScheduleFormat_SCHEDULE_FORMAT_CSV:
+		return schedule.FormatCSV
+	case pb.ScheduleFormat_SCHEDULE_FORMAT_JSON:
+		return schedule.FormatJSON
+	case pb.ScheduleFormat_SCHEDULE_FORMAT_ICS:
+		return schedule.FormatICS
+	default:
+		return ""
+	}
+}
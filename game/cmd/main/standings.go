@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	"kickoff.com/game/internal/schedule"
+	"kickoff.com/game/internal/standings"
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Standings
+// ========================================
+
+// GetStandings calcula la tabla de la temporada con los juegos terminados
+// hasta la semana pedida. La tabla siempre se arma con toda la liga (el
+// sembrado depende de la conferencia completa) y después se filtra.
+func (gs *GameService) GetStandings(ctx context.Context, req *pb.GetStandingsRequest) (*pb.GetStandingsResponse, error) {
+	if req.Season < 0 {
+		return nil, shared.InvalidField("season", "season must be positive")
+	}
+	if req.Week < 0 || req.Week > schedule.RegularSeasonWeeks {
+		return nil, shared.InvalidField("week", "week must be between 1 and %d", schedule.RegularSeasonWeeks)
+	}
+
+	season := int(req.Season)
+	if season == 0 {
+		season = schedule.SeasonOf(time.Now())
+	}
+	week := int(req.Week)
+	if week == 0 {
+		week = schedule.RegularSeasonWeeks
+	}
+
+	table, err := computeStandings(database.DB, season, week)
+	if err != nil {
+		log.Printf("Error computing standings: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to compute standings: %v", err)
+	}
+
+	conference := conferenceFromProto(req.Conference)
+	division := divisionFromProto(req.Division)
+
+	var pbStandings []*pb.TeamStanding
+	for _, s := range table {
+		if req.Conference != pb.Conference_CONFERENCE_UNSPECIFIED && s.Conference != conference {
+			continue
+		}
+		if req.Division != pb.Division_DIVISION_UNSPECIFIED && s.Division != division {
+			continue
+		}
+		pbStandings = append(pbStandings, standingToProto(s))
+	}
+
+	return &pb.GetStandingsResponse{
+		Standings: pbStandings,
+		Season:    int32(season),
+		Week:      int32(week),
+	}, nil
+}
+
+// ========================================
+// Helper Functions - Standings
+// ========================================
+
+// computeStandings arma la tabla de la temporada con los juegos terminados de
+// las semanas 1 a week de la temporada regular
+func computeStandings(db *gorm.DB, season, week int) ([]standings.Standing, error) {
+	var teams []models.Team
+	if err := db.Find(&teams).Error; err != nil {
+		return nil, err
+	}
+
+	var games []models.Game
+	if err := db.Where("season = ? AND week <= ? AND status = ?", season, week, models.GameStatusCompleted).
+		Find(&games).Error; err != nil {
+		return nil, err
+	}
+	return standings.Compute(teams, games), nil
+}
+
+func standingToProto(s standings.Standing) *pb.TeamStanding {
+	return &pb.TeamStanding{
+		TeamId:               s.TeamID,
+		Conference:           conferenceToProto(s.Conference),
+		Division:             divisionToProto(s.Division),
+		Wins:                 int32(s.Overall.Wins),
+		Losses:               int32(s.Overall.Losses),
+		Ties:                 int32(s.Overall.Ties),
+		WinPercentage:        s.Overall.Pct(),
+		DivisionRecord:       recordToProto(s.DivisionRecord),
+		ConferenceRecord:     recordToProto(s.ConferenceRecord),
+		HomeRecord:           recordToProto(s.Home),
+		AwayRecord:           recordToProto(s.Away),
+		PointsFor:            int32(s.PointsFor),
+		PointsAgainst:        int32(s.PointsAgainst),
+		PointDifferential:    int32(s.PointsFor - s.PointsAgainst),
+		Streak:               s.Streak,
+		StrengthOfVictory:    s.StrengthOfVictory,
+		StrengthOfSchedule:   s.StrengthOfSchedule,
+		DivisionRank:         int32(s.DivisionRank),
+		ConferenceRank:       int32(s.ConferenceRank),
+		DivisionTiebreaker:   s.DivisionTiebreaker,
+		ConferenceTiebreaker: s.ConferenceTiebreaker,
+	}
+}
+
+func recordToProto(r standings.Record) *pb.Record {
+	return &pb.Record{
+		Wins:   int32(r.Wins),
+		Losses: int32(r.Losses),
+		Ties:   int32(r.Ties),
+	}
+}
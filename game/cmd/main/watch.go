@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/playoffs"
+	pb "kickoff.com/proto"
+)
+
+// watchPollInterval es cada cuánto un stream busca juegos con una versión nueva
+const watchPollInterval = 500 * time.Millisecond
+
+// ========================================
+// gRPC Handlers - Live updates
+// ========================================
+
+// WatchGames envía los cambios de marcador, estado y reloj de los juegos a
+// medida que ocurren. Con since_version = 0 empieza por el estado de todos los
+// juegos; con la última versión recibida retoma sin perder cambios.
+func (gs *GameService) WatchGames(req *pb.WatchGamesRequest, stream pb.GameService_WatchGamesServer) error {
+	if req.SinceVersion < 0 {
+		return shared.InvalidField("since_version", "since_version cannot be negative")
+	}
+	if req.Season < 0 {
+		return shared.InvalidField("season", "season must be positive")
+	}
+	if req.Week < 0 || req.Week > playoffs.LastWeek {
+		return shared.InvalidField("week", "week must be between 1 and %d", playoffs.LastWeek)
+	}
+
+	scope := func(db *gorm.DB) *gorm.DB {
+		if req.Season > 0 {
+			db = db.Where("season = ?", req.Season)
+		}
+		if req.Week > 0 {
+			db = db.Where("week = ?", req.Week)
+		}
+		return db
+	}
+	return watchGames(stream.Context(), req.SinceVersion, scope, stream.Send)
+}
+
+// WatchGame es WatchGames para un solo juego
+func (gs *GameService) WatchGame(req *pb.WatchGameRequest, stream pb.GameService_WatchGameServer) error {
+	if req.GameId == "" {
+		return shared.InvalidField("game_id", "game_id is required")
+	}
+	if req.SinceVersion < 0 {
+		return shared.InvalidField("since_version", "since_version cannot be negative")
+	}
+
+	var game models.Game
+	if err := database.DB.Where("id = ?", req.GameId).First(&game).Error; err != nil {
+		return status.Error(codes.NotFound, "Game not found")
+	}
+
+	scope := func(db *gorm.DB) *gorm.DB {
+		return db.Where("id = ?", game.ID)
+	}
+	return watchGames(stream.Context(), req.SinceVersion, scope, stream.Send)
+}
+
+// ========================================
+// Helper Functions - Live updates
+// ========================================
+
+// watchGames envía primero el estado inicial (los juegos con versión posterior
+// a since, o todos si since es 0) y después cada juego que cambia, hasta que
+// el cliente cierra el stream. Como las versiones se hacen visibles en orden,
+// alcanza con recordar la última enviada.
+func watchGames(ctx context.Context, since int64, scope func(*gorm.DB) *gorm.DB, send func(*pb.GameUpdate) error) error {
+	last := since
+	snapshot := true
+	for {
+		query := scope(database.DB.WithContext(ctx))
+		if !(snapshot && since == 0) {
+			query = query.Where("version > ?", last)
+		}
+
+		var games []models.Game
+		if err := query.Order("version, id").Find(&games).Error; err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("Error watching games: %v", err)
+			return status.Errorf(codes.Internal, "failed to watch games: %v", err)
+		}
+
+		for _, game := range games {
+			if err := send(&pb.GameUpdate{Game: modelGameToProto(game), Snapshot: snapshot}); err != nil {
+				return err
+			}
+			if game.Version > last {
+				last = game.Version
+			}
+		}
+		snapshot = false
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchPollInterval):
+		}
+	}
+}
+
+// nextGameVersion toma la siguiente versión del contador global. La fila queda
+// bloqueada hasta el final de la transacción, así una versión nunca se hace
+// visible antes que las anteriores.
+func nextGameVersion(tx *gorm.DB) (int64, error) {
+	counter := models.GameVersion{ID: 1}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&counter).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Model(&counter).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "version"}}}).
+		UpdateColumn("version", gorm.Expr("version + 1")).Error; err != nil {
+		return 0, err
+	}
+	return counter.Version, nil
+}
+
+// createGame guarda un juego nuevo con la siguiente versión
+func createGame(tx *gorm.DB, game *models.Game) error {
+	version, err := nextGameVersion(tx)
+	if err != nil {
+		return err
+	}
+	game.Version = version
+	return tx.Create(game).Error
+}
+
+// updateGame guarda los cambios de un juego con la siguiente versión. Todos
+// los cambios de un juego pasan por acá para que los streams los vean.
+func updateGame(tx *gorm.DB, game *models.Game, updates map[string]interface{}) error {
+	version, err := nextGameVersion(tx)
+	if err != nil {
+		return err
+	}
+	updates["version"] = version
+	if err := tx.Model(game).Updates(updates).Error; err != nil {
+		return err
+	}
+	game.Version = version
+	return nil
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"kickoff.com/game/internal/database"
+	"kickoff.com/game/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// useTestDB apunta database.DB a una base SQLite en memoria con las tablas del servicio
+func useTestDB(t *testing.T) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		sqlDB.Close()
+	})
+	if err := database.AutoMigrate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// seedGames crea juegos de la semana 1 de 2024 con createGame, así cada uno toma una versión
+func seedGames(t *testing.T, ids ...string) []*models.Game {
+	t.Helper()
+	var games []*models.Game
+	for _, id := range ids {
+		game := &models.Game{
+			ID:         id,
+			Week:       1,
+			Season:     2024,
+			HomeTeamID: "KC",
+			AwayTeamID: "BAL",
+			GameTime:   time.Date(2024, 9, 6, 0, 20, 0, 0, time.UTC),
+			Status:     models.GameStatusScheduled,
+		}
+		if err := database.DB.Transaction(func(tx *gorm.DB) error { return createGame(tx, game) }); err != nil {
+			t.Fatal(err)
+		}
+		games = append(games, game)
+	}
+	return games
+}
+
+// watch corre watchGames hasta recibir want actualizaciones y las devuelve
+// como "id@version" (con * si son del estado inicial). during se ejecuta
+// después de recibir after actualizaciones, para simular cambios en vivo.
+func watch(t *testing.T, since int64, scope func(*gorm.DB) *gorm.DB, want, after int, during func()) []string {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates := make(chan *pb.GameUpdate, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- watchGames(ctx, since, scope, func(update *pb.GameUpdate) error {
+			updates <- update
+			return nil
+		})
+	}()
+
+	var got []string
+	timeout := time.After(5 * time.Second)
+	for len(got) < want {
+		if len(got) == after && during != nil {
+			during()
+			during = nil
+		}
+		select {
+		case update := <-updates:
+			mark := ""
+			if update.Snapshot {
+				mark = "*"
+			}
+			got = append(got, fmt.Sprintf("%s@%d%s", update.Game.Id, update.Game.Version, mark))
+		case <-timeout:
+			t.Fatalf("received %v, want %d updates", got, want)
+		}
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("watchGames() error = %v", err)
+	}
+	return got
+}
+
+func allGames(db *gorm.DB) *gorm.DB { return db }
+
+func TestGameVersions(t *testing.T) {
+	useTestDB(t)
+	games := seedGames(t, "game_1", "game_2")
+	if games[0].Version != 1 || games[1].Version != 2 {
+		t.Fatalf("versions = %d, %d, want 1, 2", games[0].Version, games[1].Version)
+	}
+
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		return updateGame(tx, games[0], map[string]interface{}{"home_score": 7})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var saved models.Game
+	database.DB.First(&saved, "id = ?", "game_1")
+	if games[0].Version != 3 || saved.Version != 3 || saved.HomeScore != 7 {
+		t.Errorf("after update: version %d (saved %d), home score %d, want 3, 3, 7", games[0].Version, saved.Version, saved.HomeScore)
+	}
+
+	// Una transacción que se deshace no consume la versión
+	rollback := fmt.Errorf("rollback")
+	database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := updateGame(tx, games[1], map[string]interface{}{"away_score": 3}); err != nil {
+			return err
+		}
+		return rollback
+	})
+	version, err := nextGameVersion(database.DB)
+	if err != nil || version != 4 {
+		t.Errorf("nextGameVersion() = %d, %v, want 4", version, err)
+	}
+}
+
+func TestWatchGamesSnapshotAndLive(t *testing.T) {
+	useTestDB(t)
+	games := seedGames(t, "game_1", "game_2")
+
+	score := func() {
+		if err := database.DB.Transaction(func(tx *gorm.DB) error {
+			return updateGame(tx, games[1], map[string]interface{}{"away_score": 3})
+		}); err != nil {
+			t.Error(err)
+		}
+	}
+	got := watch(t, 0, allGames, 3, 2, score)
+	want := "[game_1@1* game_2@2* game_2@3]"
+	if fmt.Sprint(got) != want {
+		t.Errorf("updates = %v, want %s", got, want)
+	}
+}
+
+func TestWatchGamesResume(t *testing.T) {
+	useTestDB(t)
+	games := seedGames(t, "game_1", "game_2", "game_3")
+	if err := database.DB.Transaction(func(tx *gorm.DB) error {
+		return updateGame(tx, games[0], map[string]interface{}{"home_score": 3})
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Retomar desde la versión 2 trae solo lo que cambió después, en orden de versión
+	got := watch(t, 2, allGames, 2, -1, nil)
+	if want := "[game_3@3* game_1@4*]"; fmt.Sprint(got) != want {
+		t.Errorf("updates = %v, want %s", got, want)
+	}
+
+	// WatchGame filtra por juego
+	byID := func(db *gorm.DB) *gorm.DB { return db.Where("id = ?", "game_2") }
+	got = watch(t, 0, byID, 1, -1, nil)
+	if want := "[game_2@2*]"; fmt.Sprint(got) != want {
+		t.Errorf("updates = %v, want %s", got, want)
+	}
+}
+
+func TestWatchGamesValidation(t *testing.T) {
+	useTestDB(t)
+	gs := &GameService{}
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"versión negativa", gs.WatchGames(&pb.WatchGamesRequest{SinceVersion: -1}, nil), codes.InvalidArgument},
+		{"semana fuera de rango", gs.WatchGames(&pb.WatchGamesRequest{Week: 99}, nil), codes.InvalidArgument},
+		{"juego sin id", gs.WatchGame(&pb.WatchGameRequest{}, nil), codes.InvalidArgument},
+		{"juego inexistente", gs.WatchGame(&pb.WatchGameRequest{GameId: "game_9"}, nil), codes.NotFound},
+	}
+	for _, tt := range tests {
+		if got := status.Code(tt.err); got != tt.want {
+			t.Errorf("%s: code = %v (%v), want %v", tt.name, got, tt.err, tt.want)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"kickoff.com/game/internal/models"
+	"kickoff.com/pkg/events"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -64,6 +65,11 @@ func AutoMigrate() error {
 	return DB.AutoMigrate(
 		&models.Team{},
 		&models.Game{},
+		&models.PlayoffSeed{},
+		&models.ScoringPlay{},
+		&models.GameStatusChange{},
+		&models.GameVersion{},
+		&events.OutboxEvent{},
 	)
 }
 
@@ -15,21 +15,53 @@ const (
 	GameStatusCanceled  GameStatus = "canceled"
 )
 
+// gameStatusTransitions son los cambios de estado permitidos. Un juego
+// suspendido en vivo pasa a postponed y vuelve a scheduled al reprogramarse;
+// completed y canceled son finales y solo se dejan con un override.
+var gameStatusTransitions = map[GameStatus][]GameStatus{
+	GameStatusScheduled: {GameStatusLive, GameStatusPostponed, GameStatusCanceled},
+	GameStatusLive:      {GameStatusCompleted, GameStatusPostponed},
+	GameStatusPostponed: {GameStatusScheduled, GameStatusCanceled},
+}
+
+// CanTransitionTo indica si un juego puede pasar de s a next sin override
+func (s GameStatus) CanTransitionTo(next GameStatus) bool {
+	for _, allowed := range gameStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStatuses devuelve los estados a los que se puede pasar desde s
+func (s GameStatus) NextStatuses() []GameStatus {
+	return gameStatusTransitions[s]
+}
+
 // Game representa un juego NFL
 type Game struct {
-	ID           string         `gorm:"primaryKey;type:varchar(50)" json:"id"`
-	Week         int            `gorm:"not null" json:"week"`
-	Season       int            `gorm:"not null;default:2024" json:"season"`
-	HomeTeamID   string         `gorm:"not null;type:varchar(10);index" json:"homeTeamId"`
-	AwayTeamID   string         `gorm:"not null;type:varchar(10);index" json:"awayTeamId"`
-	GameTime     time.Time      `gorm:"not null" json:"gameTime"`
-	Status       GameStatus     `gorm:"type:varchar(20);default:'scheduled'" json:"status"`
-	HomeScore    int            `gorm:"default:0" json:"homeScore"`
-	AwayScore    int            `gorm:"default:0" json:"awayScore"`
-	WinnerTeamID string         `gorm:"type:varchar(10)" json:"winnerTeamId,omitempty"`
-	CreatedAt    time.Time      `gorm:"autoCreateTime" json:"createdAt"`
-	UpdatedAt    time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
-	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+	ID               string         `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	ExternalID       string         `gorm:"type:varchar(100);index" json:"externalId,omitempty"` // ID en el calendario importado
+	Week             int            `gorm:"not null" json:"week"`
+	Season           int            `gorm:"not null;default:2024" json:"season"`
+	HomeTeamID       string         `gorm:"not null;type:varchar(10);index" json:"homeTeamId"`
+	AwayTeamID       string         `gorm:"not null;type:varchar(10);index" json:"awayTeamId"`
+	GameTime         time.Time      `gorm:"not null" json:"gameTime"`
+	Status           GameStatus     `gorm:"type:varchar(20);default:'scheduled'" json:"status"`
+	HomeScore        int            `gorm:"default:0" json:"homeScore"`
+	AwayScore        int            `gorm:"default:0" json:"awayScore"`
+	WinnerTeamID     string         `gorm:"type:varchar(10)" json:"winnerTeamId,omitempty"`
+	Spread           *float64       `json:"spread,omitempty"`         // Línea del local: -3.5 = local favorito por 3.5
+	LinesLockAt      *time.Time     `json:"linesLockAt,omitempty"`    // Desde esta hora las líneas no cambian (nil = al inicio)
+	Total            *float64       `json:"total,omitempty"`          // Línea over/under de puntos combinados
+	Quarter          int            `gorm:"default:0" json:"quarter"` // 0 antes del inicio, 5 en adelante = tiempo extra
+	ClockSeconds     int            `gorm:"default:0" json:"clockSeconds"`
+	PossessionTeamID string         `gorm:"type:varchar(10)" json:"possessionTeamId,omitempty"`
+	Version          int64          `gorm:"not null;default:0;index" json:"version"` // Versión del último cambio (ver GameVersion)
+	CreatedAt        time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt        time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName especifica el nombre de la tabla
@@ -37,6 +69,15 @@ func (Game) TableName() string {
 	return "games"
 }
 
+// LinesLocked indica si las líneas del juego ya no se pueden modificar
+func (g Game) LinesLocked(now time.Time) bool {
+	lockAt := g.GameTime
+	if g.LinesLockAt != nil {
+		lockAt = *g.LinesLockAt
+	}
+	return !now.Before(lockAt)
+}
+
 // Conference representa la conferencia NFL
 type Conference string
 
@@ -76,3 +117,94 @@ type Team struct {
 func (Team) TableName() string {
 	return "teams"
 }
+
+// PlayoffSeed es un equipo clasificado a los playoffs de una temporada. Los
+// sembrados se fijan al generar el bracket y definen los cruces de cada ronda.
+type PlayoffSeed struct {
+	Season     int        `gorm:"primaryKey" json:"season"`
+	Conference Conference `gorm:"primaryKey;type:varchar(3)" json:"conference"`
+	Seed       int        `gorm:"primaryKey" json:"seed"` // 1-4 líderes de división, 5-7 wild cards
+	TeamID     string     `gorm:"not null;type:varchar(10)" json:"teamId"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (PlayoffSeed) TableName() string {
+	return "playoff_seeds"
+}
+
+// GameVersion es el contador global de versiones de los juegos. Cada cambio de
+// un juego toma el siguiente valor con la fila bloqueada hasta el commit, así
+// las versiones se hacen visibles en orden y un cliente puede retomar un
+// stream de cambios desde la última versión que recibió.
+type GameVersion struct {
+	ID      int   `gorm:"primaryKey"`
+	Version int64 `gorm:"not null;default:0"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GameVersion) TableName() string {
+	return "game_versions"
+}
+
+// PlayType es el tipo de una anotación del registro jugada a jugada
+type PlayType string
+
+const (
+	PlayTouchdown  PlayType = "touchdown"   // 6 puntos
+	PlayFieldGoal  PlayType = "field_goal"  // 3 puntos
+	PlaySafety     PlayType = "safety"      // 2 puntos
+	PlayExtraPoint PlayType = "extra_point" // 1 punto
+	PlayTwoPoint   PlayType = "two_point"   // 2 puntos
+	PlayAdjustment PlayType = "adjustment"  // Ajuste manual del marcador (UpdateGameScore)
+)
+
+// PlayAction indica si una entrada del registro es una anotación nueva o una
+// enmienda sobre una anterior
+type PlayAction string
+
+const (
+	PlayActionRecord  PlayAction = "record"
+	PlayActionCorrect PlayAction = "correct" // Reemplaza a AmendsPlayID
+	PlayActionVoid    PlayAction = "void"    // Anula a AmendsPlayID
+)
+
+// ScoringPlay es una entrada del registro de anotaciones de un juego. El
+// registro solo crece: las correcciones se agregan como enmiendas y el
+// marcador del juego se calcula con las anotaciones vigentes.
+type ScoringPlay struct {
+	ID           string     `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	GameID       string     `gorm:"not null;type:varchar(50);uniqueIndex:idx_scoring_plays_sequence" json:"gameId"`
+	Sequence     int        `gorm:"not null;uniqueIndex:idx_scoring_plays_sequence" json:"sequence"`
+	Action       PlayAction `gorm:"type:varchar(10);not null;default:'record'" json:"action"`
+	AmendsPlayID string     `gorm:"type:varchar(50)" json:"amendsPlayId,omitempty"`
+	TeamID       string     `gorm:"type:varchar(10)" json:"teamId"`
+	Type         PlayType   `gorm:"type:varchar(20)" json:"type"`
+	Points       int        `gorm:"default:0" json:"points"`
+	Quarter      int        `gorm:"default:0" json:"quarter"`      // 5 en adelante = tiempo extra
+	ClockSeconds int        `gorm:"default:0" json:"clockSeconds"` // Tiempo restante en el cuarto
+	Description  string     `gorm:"type:varchar(500)" json:"description,omitempty"`
+	Reason       string     `gorm:"type:varchar(500)" json:"reason,omitempty"` // Motivo de la enmienda
+	CreatedAt    time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ScoringPlay) TableName() string {
+	return "scoring_plays"
+}
+
+// GameStatusChange registra un cambio de estado de un juego
+type GameStatusChange struct {
+	ID         string     `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	GameID     string     `gorm:"not null;type:varchar(50);index" json:"gameId"`
+	FromStatus GameStatus `gorm:"type:varchar(20);not null" json:"fromStatus"`
+	ToStatus   GameStatus `gorm:"type:varchar(20);not null" json:"toStatus"`
+	Override   bool       `gorm:"default:false" json:"override"` // Fuera de las transiciones permitidas
+	Reason     string     `gorm:"type:varchar(500)" json:"reason,omitempty"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GameStatusChange) TableName() string {
+	return "game_status_changes"
+}
@@ -0,0 +1,38 @@
+package models
+
+import "testing"
+
+func TestGameStatusCanTransitionTo(t *testing.T) {
+	statuses := []GameStatus{GameStatusScheduled, GameStatusLive, GameStatusCompleted, GameStatusPostponed, GameStatusCanceled}
+	allowed := map[GameStatus][]GameStatus{
+		GameStatusScheduled: {GameStatusLive, GameStatusPostponed, GameStatusCanceled},
+		GameStatusLive:      {GameStatusCompleted, GameStatusPostponed},
+		GameStatusPostponed: {GameStatusScheduled, GameStatusCanceled},
+		GameStatusCompleted: nil, // Final: solo con override
+		GameStatusCanceled:  nil,
+	}
+
+	for _, from := range statuses {
+		want := make(map[GameStatus]bool)
+		for _, to := range allowed[from] {
+			want[to] = true
+		}
+		for _, to := range statuses {
+			if got := from.CanTransitionTo(to); got != want[to] {
+				t.Errorf("%s -> %s = %v, want %v", from, to, got, want[to])
+			}
+		}
+		if got := len(from.NextStatuses()); got != len(allowed[from]) {
+			t.Errorf("%s NextStatuses() = %v, want %v", from, from.NextStatuses(), allowed[from])
+		}
+	}
+}
+
+func TestGameStatusUnknown(t *testing.T) {
+	if GameStatus("halftime").CanTransitionTo(GameStatusLive) {
+		t.Error("an unknown status should not transition")
+	}
+	if GameStatusScheduled.CanTransitionTo(GameStatusScheduled) {
+		t.Error("a status should not transition to itself")
+	}
+}
@@ -0,0 +1,99 @@
+// Package plays lleva el registro jugada a jugada de las anotaciones de un
+// juego. El registro solo crece: una corrección agrega una enmienda que
+// reemplaza o anula una anotación anterior, y el marcador se calcula siempre
+// con las anotaciones vigentes.
+package plays
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"kickoff.com/game/internal/models"
+)
+
+const (
+	// Quarters son los cuartos de tiempo regular; del 5 en adelante es tiempo extra
+	Quarters = 4
+	// MaxClockSeconds es la duración de un cuarto (y de un tiempo extra de playoffs)
+	MaxClockSeconds = 15 * 60
+)
+
+// Points devuelve lo que vale una anotación del tipo. Los ajustes no tienen un
+// valor fijo y devuelven false.
+func Points(t models.PlayType) (int, bool) {
+	switch t {
+	case models.PlayTouchdown:
+		return 6, true
+	case models.PlayFieldGoal:
+		return 3, true
+	case models.PlaySafety, models.PlayTwoPoint:
+		return 2, true
+	case models.PlayExtraPoint:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// Effective devuelve las anotaciones vigentes en orden de secuencia: las que
+// no fueron reemplazadas ni anuladas por una enmienda posterior. Una
+// corrección vigente ocupa el lugar de la anotación que reemplaza.
+func Effective(log []models.ScoringPlay) []models.ScoringPlay {
+	amended := make(map[string]bool)
+	for _, play := range log {
+		if play.AmendsPlayID != "" {
+			amended[play.AmendsPlayID] = true
+		}
+	}
+
+	var effective []models.ScoringPlay
+	for _, play := range log {
+		if play.Action == models.PlayActionVoid || amended[play.ID] {
+			continue
+		}
+		effective = append(effective, play)
+	}
+	sort.SliceStable(effective, func(i, j int) bool { return effective[i].Sequence < effective[j].Sequence })
+	return effective
+}
+
+// Score suma los puntos vigentes de cada equipo
+func Score(log []models.ScoringPlay, homeTeamID, awayTeamID string) (home, away int) {
+	for _, play := range Effective(log) {
+		switch play.TeamID {
+		case homeTeamID:
+			home += play.Points
+		case awayTeamID:
+			away += play.Points
+		}
+	}
+	return home, away
+}
+
+// ParseClock convierte un reloj "MM:SS" en segundos restantes
+func ParseClock(clock string) (int, error) {
+	minutes, seconds, ok := strings.Cut(strings.TrimSpace(clock), ":")
+	if !ok {
+		return 0, fmt.Errorf("clock %q must be MM:SS", clock)
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m < 0 {
+		return 0, fmt.Errorf("clock %q must be MM:SS", clock)
+	}
+	s, err := strconv.Atoi(seconds)
+	if err != nil || s < 0 || s > 59 || len(seconds) != 2 {
+		return 0, fmt.Errorf("clock %q must be MM:SS", clock)
+	}
+	total := m*60 + s
+	if total > MaxClockSeconds {
+		return 0, fmt.Errorf("clock %q exceeds 15:00", clock)
+	}
+	return total, nil
+}
+
+// FormatClock convierte segundos restantes en un reloj "MM:SS"
+func FormatClock(seconds int) string {
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,116 @@
+package plays
+
+import (
+	"strings"
+	"testing"
+
+	"kickoff.com/game/internal/models"
+)
+
+func play(id string, sequence int, teamID string, t models.PlayType) models.ScoringPlay {
+	points, _ := Points(t)
+	return models.ScoringPlay{ID: id, Sequence: sequence, Action: models.PlayActionRecord, TeamID: teamID, Type: t, Points: points}
+}
+
+func amend(id string, sequence int, action models.PlayAction, amends string, base models.ScoringPlay) models.ScoringPlay {
+	base.ID, base.Sequence, base.Action, base.AmendsPlayID = id, sequence, action, amends
+	return base
+}
+
+func TestPoints(t *testing.T) {
+	tests := []struct {
+		playType models.PlayType
+		want     int
+		wantOK   bool
+	}{
+		{models.PlayTouchdown, 6, true},
+		{models.PlayFieldGoal, 3, true},
+		{models.PlaySafety, 2, true},
+		{models.PlayTwoPoint, 2, true},
+		{models.PlayExtraPoint, 1, true},
+		{models.PlayAdjustment, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := Points(tt.playType)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Points(%s) = %d, %v, want %d, %v", tt.playType, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestScoreWithAmendments(t *testing.T) {
+	td := play("play_1", 1, "KC", models.PlayTouchdown)
+	xp := play("play_2", 2, "KC", models.PlayExtraPoint)
+	fg := play("play_3", 3, "BUF", models.PlayFieldGoal)
+	adjustment := models.ScoringPlay{ID: "play_9", Sequence: 9, Action: models.PlayActionRecord, TeamID: "BUF", Type: models.PlayAdjustment, Points: -3}
+
+	// El touchdown fue de Buffalo
+	buffaloTD := amend("play_4", 4, models.PlayActionCorrect, "play_1", td)
+	buffaloTD.TeamID = "BUF"
+	// El extra point no fue válido
+	noXP := amend("play_5", 5, models.PlayActionVoid, "play_2", models.ScoringPlay{})
+	// Corregir la corrección: al final era un field goal de Buffalo
+	buffaloFG := amend("play_6", 6, models.PlayActionCorrect, "play_4", play("", 0, "BUF", models.PlayFieldGoal))
+
+	tests := []struct {
+		name          string
+		log           []models.ScoringPlay
+		wantHome      int
+		wantAway      int
+		wantEffective []string
+	}{
+		{"sin anotaciones", nil, 0, 0, nil},
+		{"anotaciones", []models.ScoringPlay{td, xp, fg}, 7, 3, []string{"play_1", "play_2", "play_3"}},
+		{"corrección de equipo", []models.ScoringPlay{td, xp, fg, buffaloTD}, 1, 9, []string{"play_2", "play_3", "play_4"}},
+		{"anulación", []models.ScoringPlay{td, xp, fg, buffaloTD, noXP}, 0, 9, []string{"play_3", "play_4"}},
+		{"corrección de una corrección", []models.ScoringPlay{td, xp, fg, buffaloTD, noXP, buffaloFG}, 0, 6, []string{"play_3", "play_6"}},
+		{"ajuste manual", []models.ScoringPlay{td, fg, adjustment}, 6, 0, []string{"play_1", "play_3", "play_9"}},
+	}
+	for _, tt := range tests {
+		home, away := Score(tt.log, "KC", "BUF")
+		if home != tt.wantHome || away != tt.wantAway {
+			t.Errorf("%s: Score() = %d-%d, want %d-%d", tt.name, home, away, tt.wantHome, tt.wantAway)
+		}
+		var effective []string
+		for _, p := range Effective(tt.log) {
+			effective = append(effective, p.ID)
+		}
+		if len(effective) != len(tt.wantEffective) {
+			t.Errorf("%s: Effective() = %v, want %v", tt.name, effective, tt.wantEffective)
+			continue
+		}
+		for i := range effective {
+			if effective[i] != tt.wantEffective[i] {
+				t.Errorf("%s: Effective() = %v, want %v", tt.name, effective, tt.wantEffective)
+				break
+			}
+		}
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		clock   string
+		want    int
+		wantErr bool
+	}{
+		{"15:00", 900, false},
+		{"2:05", 125, false},
+		{" 0:00 ", 0, false},
+		{"15:01", 0, true},
+		{"2:5", 0, true},
+		{"2:60", 0, true},
+		{"-1:00", 0, true},
+		{"125", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseClock(tt.clock)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseClock(%q) = %d, %v, want %d, wantErr %v", tt.clock, got, err, tt.want, tt.wantErr)
+		}
+		if err == nil && FormatClock(got) != strings.TrimSpace(tt.clock) {
+			t.Errorf("FormatClock(%d) = %q, want %q", got, FormatClock(got), strings.TrimSpace(tt.clock))
+		}
+	}
+}
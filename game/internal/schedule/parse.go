@@ -0,0 +1,450 @@
+package schedule
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format es el formato del archivo de calendario
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+	FormatICS  Format = "ics"
+)
+
+// DetectFormat reconoce el formato por el contenido: un VCALENDAR es
+// iCalendar, un array u objeto es JSON y cualquier otra cosa se lee como CSV
+func DetectFormat(content []byte) Format {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")))
+	switch {
+	case bytes.HasPrefix(bytes.ToUpper(trimmed), []byte("BEGIN:VCALENDAR")):
+		return FormatICS
+	case bytes.HasPrefix(trimmed, []byte("[")), bytes.HasPrefix(trimmed, []byte("{")):
+		return FormatJSON
+	default:
+		return FormatCSV
+	}
+}
+
+// Parse lee el calendario en el formato indicado. defaultSeason se usa para las
+// entradas sin temporada; si es 0 se toma la temporada de cada kickoff. Las
+// entradas con errores no se devuelven: cada una genera un Issue.
+func Parse(format Format, content []byte, defaultSeason int) ([]Entry, []Issue) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	switch format {
+	case FormatCSV:
+		return parseCSV(content, defaultSeason)
+	case FormatJSON:
+		return parseJSON(content, defaultSeason)
+	case FormatICS:
+		return parseICS(content, defaultSeason)
+	default:
+		return nil, []Issue{{0, fmt.Sprintf("unsupported format %q", format)}}
+	}
+}
+
+// ========================================
+// CSV y JSON
+// ========================================
+
+// Nombres aceptados para cada columna (CSV) o campo (JSON), normalizados en
+// minúsculas y sin separadores
+var fieldAliases = map[string]string{
+	"id":          "id",
+	"externalid":  "id",
+	"gameid":      "id",
+	"uid":         "id",
+	"season":      "season",
+	"week":        "week",
+	"home":        "home",
+	"hometeam":    "home",
+	"hometeamid":  "home",
+	"away":        "away",
+	"awayteam":    "away",
+	"awayteamid":  "away",
+	"gametime":    "time",
+	"kickoff":     "time",
+	"scheduledat": "time",
+	"datetime":    "time",
+	"start":       "time",
+}
+
+func normalizeField(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("_", "", "-", "", " ", "").Replace(name)
+	return fieldAliases[name]
+}
+
+// parseCSV lee un CSV con encabezado, por ejemplo:
+//
+//	week,away,home,kickoff
+//	1,BAL,KC,2024-09-06T00:20:00Z
+func parseCSV(content []byte, defaultSeason int) ([]Entry, []Issue) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, []Issue{{1, "missing CSV header"}}
+	}
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = normalizeField(name)
+	}
+
+	var entries []Entry
+	var issues []Issue
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			issues = append(issues, Issue{line, err.Error()})
+			continue
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		fields := make(map[string]string)
+		for i, value := range record {
+			if i < len(columns) && columns[i] != "" {
+				fields[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		entry, err := entryFromFields(line, fields, defaultSeason)
+		if err != nil {
+			issues = append(issues, Issue{line, err.Error()})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, issues
+}
+
+// parseJSON lee un array de juegos, o un objeto con el array en "games":
+//
+//	[{"week": 1, "awayTeamId": "BAL", "homeTeamId": "KC", "gameTime": "2024-09-06T00:20:00Z"}]
+func parseJSON(content []byte, defaultSeason int) ([]Entry, []Issue) {
+	var games []map[string]interface{}
+	if err := json.Unmarshal(content, &games); err != nil {
+		var wrapper struct {
+			Games []map[string]interface{} `json:"games"`
+		}
+		if err := json.Unmarshal(content, &wrapper); err != nil {
+			return nil, []Issue{{0, fmt.Sprintf("invalid JSON: %v", err)}}
+		}
+		games = wrapper.Games
+	}
+
+	var entries []Entry
+	var issues []Issue
+	for i, game := range games {
+		fields := make(map[string]string)
+		for key, value := range game {
+			if name := normalizeField(key); name != "" && value != nil {
+				fields[name] = strings.TrimSpace(fmt.Sprint(value))
+			}
+		}
+		entry, err := entryFromFields(i+1, fields, defaultSeason)
+		if err != nil {
+			issues = append(issues, Issue{i + 1, err.Error()})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, issues
+}
+
+// Formatos de kickoff aceptados; sin zona horaria se asume UTC
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+func parseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid kickoff time %q (use RFC 3339, e.g. 2024-09-06T00:20:00Z)", value)
+}
+
+func entryFromFields(line int, fields map[string]string, defaultSeason int) (Entry, error) {
+	entry := Entry{Line: line, ExternalID: fields["id"]}
+
+	if fields["time"] == "" {
+		return entry, fmt.Errorf("kickoff time is required")
+	}
+	gameTime, err := parseTime(fields["time"])
+	if err != nil {
+		return entry, err
+	}
+	entry.GameTime = gameTime
+
+	week, err := strconv.Atoi(fields["week"])
+	if err != nil {
+		return entry, fmt.Errorf("invalid week %q", fields["week"])
+	}
+	entry.Week = week
+
+	entry.Season = defaultSeason
+	if fields["season"] != "" {
+		if entry.Season, err = strconv.Atoi(fields["season"]); err != nil {
+			return entry, fmt.Errorf("invalid season %q", fields["season"])
+		}
+	}
+
+	return entry, completeEntry(&entry, fields["home"], fields["away"])
+}
+
+// completeEntry resuelve los equipos y valida los campos comunes a todos los formatos
+func completeEntry(entry *Entry, home, away string) error {
+	var ok bool
+	if entry.HomeTeamID, ok = ResolveTeam(home); !ok {
+		return fmt.Errorf("unknown home team %q", home)
+	}
+	if entry.AwayTeamID, ok = ResolveTeam(away); !ok {
+		return fmt.Errorf("unknown away team %q", away)
+	}
+	if entry.HomeTeamID == entry.AwayTeamID {
+		return fmt.Errorf("a team cannot play against itself (%s)", entry.HomeTeamID)
+	}
+	if entry.Week < 1 || entry.Week > RegularSeasonWeeks {
+		return fmt.Errorf("week must be between 1 and %d", RegularSeasonWeeks)
+	}
+	if entry.Season == 0 {
+		entry.Season = SeasonOf(entry.GameTime)
+	}
+	return nil
+}
+
+// ========================================
+// iCalendar
+// ========================================
+
+var (
+	weekPattern    = regexp.MustCompile(`(?i)\bweek\s*(\d{1,2})\b`)
+	matchupPattern = regexp.MustCompile(`(?i)^(.+?)\s+(@|at|vs\.?|v\.?)\s+(.+)$`)
+)
+
+type icsEvent struct {
+	line       int
+	uid        string
+	start      string
+	startTZ    string
+	summary    string
+	weekSource string // SUMMARY, DESCRIPTION y CATEGORIES, para buscar "Week N"
+}
+
+// parseICS lee los VEVENT de un feed iCalendar. Cada evento necesita DTSTART
+// con hora y un SUMMARY "Visitante @ Local" ("at" también vale; con "vs" el
+// primero es el local). La semana se toma de un "Week N" en el evento; si no
+// lo tiene se calcula contando semanas de martes a lunes desde el primer juego
+// de la temporada en el feed, así que el feed debe incluir la semana 1.
+func parseICS(content []byte, defaultSeason int) ([]Entry, []Issue) {
+	events, issues := readICSEvents(content)
+
+	var entries []Entry
+	var derived []int // entradas sin "Week N"
+	for _, event := range events {
+		entry := Entry{Line: event.line, ExternalID: event.uid, Season: defaultSeason}
+
+		gameTime, err := parseICSTime(event.start, event.startTZ)
+		if err != nil {
+			issues = append(issues, Issue{event.line, err.Error()})
+			continue
+		}
+		entry.GameTime = gameTime
+		if entry.Season == 0 {
+			entry.Season = SeasonOf(gameTime)
+		}
+
+		match := matchupPattern.FindStringSubmatch(strings.TrimSpace(weekPattern.ReplaceAllString(event.summary, "")))
+		if match == nil {
+			issues = append(issues, Issue{event.line, fmt.Sprintf("can't read the teams from SUMMARY %q", event.summary)})
+			continue
+		}
+		away, home := cleanTeamName(match[1]), cleanTeamName(match[3])
+		if strings.HasPrefix(strings.ToLower(match[2]), "v") {
+			home, away = away, home
+		}
+
+		m := weekPattern.FindStringSubmatch(event.weekSource)
+		if m != nil {
+			entry.Week, _ = strconv.Atoi(m[1])
+		} else {
+			entry.Week = 1 // Provisoria hasta conocer el inicio de la temporada
+		}
+
+		if err := completeEntry(&entry, home, away); err != nil {
+			issues = append(issues, Issue{event.line, err.Error()})
+			continue
+		}
+		if m == nil {
+			derived = append(derived, len(entries))
+		}
+		entries = append(entries, entry)
+	}
+
+	entries, weekIssues := deriveWeeks(entries, derived)
+	return entries, append(issues, weekIssues...)
+}
+
+// deriveWeeks asigna la semana a las entradas sin "Week N" según su distancia
+// al martes anterior al primer juego de su temporada
+func deriveWeeks(entries []Entry, indexes []int) ([]Entry, []Issue) {
+	first := make(map[int]time.Time)
+	for _, entry := range entries {
+		if t, ok := first[entry.Season]; !ok || entry.GameTime.Before(t) {
+			first[entry.Season] = entry.GameTime
+		}
+	}
+
+	var issues []Issue
+	dropped := make(map[int]bool)
+	for _, i := range indexes {
+		entry := &entries[i]
+		start := first[entry.Season].UTC()
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		start = start.AddDate(0, 0, -((int(start.Weekday()) - int(time.Tuesday) + 7) % 7))
+
+		entry.Week = int(entry.GameTime.Sub(start).Hours()/24)/7 + 1
+		if entry.Week > RegularSeasonWeeks {
+			issues = append(issues, Issue{entry.Line, fmt.Sprintf("kickoff falls in week %d, after the regular season", entry.Week)})
+			dropped[i] = true
+		}
+	}
+	if len(dropped) == 0 {
+		return entries, issues
+	}
+
+	var valid []Entry
+	for i, entry := range entries {
+		if !dropped[i] {
+			valid = append(valid, entry)
+		}
+	}
+	return valid, issues
+}
+
+func cleanTeamName(name string) string {
+	name = strings.TrimSpace(name)
+	// "NFL: Ravens" o "Ravens (0-0)"
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "("); i >= 0 {
+		name = name[:i]
+	}
+	return strings.Trim(strings.TrimSpace(name), "-–")
+}
+
+// readICSEvents separa los VEVENT desdoblando las líneas continuadas (RFC 5545)
+func readICSEvents(content []byte) ([]icsEvent, []Issue) {
+	type icsLine struct {
+		number int
+		text   string
+	}
+	var lines []icsLine
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1].text += text[1:]
+			continue
+		}
+		lines = append(lines, icsLine{n, text})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, []Issue{{0, fmt.Sprintf("invalid iCalendar feed: %v", err)}}
+	}
+
+	var events []icsEvent
+	var current *icsEvent
+	for _, l := range lines {
+		name, value, ok := strings.Cut(l.text, ":")
+		if !ok {
+			continue
+		}
+		params := strings.Split(name, ";")
+		prop := strings.ToUpper(params[0])
+
+		switch {
+		case prop == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &icsEvent{line: l.number}
+		case current == nil:
+		case prop == "END" && strings.EqualFold(value, "VEVENT"):
+			events = append(events, *current)
+			current = nil
+		case prop == "UID":
+			current.uid = value
+		case prop == "DTSTART":
+			current.start = value
+			for _, param := range params[1:] {
+				if key, tz, ok := strings.Cut(param, "="); ok && strings.EqualFold(key, "TZID") {
+					current.startTZ = strings.Trim(tz, `"`)
+				}
+			}
+		case prop == "SUMMARY":
+			current.summary = unescapeICS(value)
+			current.weekSource += " " + current.summary
+		case prop == "DESCRIPTION", prop == "CATEGORIES":
+			current.weekSource += " " + unescapeICS(value)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].line < events[j].line
+	})
+	return events, nil
+}
+
+func parseICSTime(value, tzid string) (time.Time, error) {
+	if len(value) == len("20060102") {
+		return time.Time{}, fmt.Errorf("DTSTART %q has no kickoff time", value)
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DTSTART %q", value)
+		}
+		return t.UTC(), nil
+	}
+
+	loc := time.UTC
+	if tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DTSTART %q", value)
+	}
+	return t.UTC(), nil
+}
+
+func unescapeICS(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,189 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		content string
+		want    Format
+	}{
+		{"week,away,home,kickoff\n", FormatCSV},
+		{"  [{\"week\": 1}]", FormatJSON},
+		{"{\"games\": []}", FormatJSON},
+		{"\xef\xbb\xbfBEGIN:VCALENDAR\r\n", FormatICS},
+		{"begin:vcalendar\n", FormatICS},
+		{"", FormatCSV},
+	}
+	for _, tt := range tests {
+		if got := DetectFormat([]byte(tt.content)); got != tt.want {
+			t.Errorf("DetectFormat(%q) = %s, want %s", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	kickoff := time.Date(2024, 9, 6, 0, 20, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		content       string
+		defaultSeason int
+		want          []Entry
+		wantIssue     string
+	}{
+		{
+			name:    "columnas y equipos por alias",
+			content: "Week,Away Team,home_team_id,Kickoff,Game ID\n1,Ravens,kc,2024-09-06T00:20:00Z,g1\n",
+			want:    []Entry{{Line: 2, ExternalID: "g1", Season: 2024, Week: 1, HomeTeamID: "KC", AwayTeamID: "BAL", GameTime: kickoff}},
+		},
+		{
+			name:          "temporada por defecto y hora sin zona",
+			content:       "week,away,home,kickoff\n1,BAL,KC,2024-09-06 00:20\n",
+			defaultSeason: 2030,
+			want:          []Entry{{Line: 2, Season: 2030, Week: 1, HomeTeamID: "KC", AwayTeamID: "BAL", GameTime: kickoff}},
+		},
+		{
+			name:    "enero es de la temporada anterior",
+			content: "week,away,home,kickoff\n18,OAK,SD,2025-01-05T21:25:00Z\n",
+			want:    []Entry{{Line: 2, Season: 2024, Week: 18, HomeTeamID: "LAC", AwayTeamID: "LV", GameTime: time.Date(2025, 1, 5, 21, 25, 0, 0, time.UTC)}},
+		},
+		{"sin encabezado", "", 0, nil, "line 1: missing CSV header"},
+		{"sin kickoff", "week,away,home\n1,BAL,KC\n", 0, nil, "line 2: kickoff time is required"},
+		{"hora inválida", "week,away,home,kickoff\n1,BAL,KC,mañana\n", 0, nil, `line 2: invalid kickoff time "mañana"`},
+		{"semana inválida", "week,away,home,kickoff\nuno,BAL,KC,2024-09-06T00:20:00Z\n", 0, nil, `line 2: invalid week "uno"`},
+		{"semana de playoffs", "week,away,home,kickoff\n19,BAL,KC,2024-09-06T00:20:00Z\n", 0, nil, "line 2: week must be between 1 and 18"},
+		{"equipo desconocido", "week,away,home,kickoff\n1,BAL,Springfield,2024-09-06T00:20:00Z\n", 0, nil, `line 2: unknown home team "Springfield"`},
+		{"contra sí mismo", "week,away,home,kickoff\n1,Chiefs,KC,2024-09-06T00:20:00Z\n", 0, nil, "line 2: a team cannot play against itself (KC)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, issues := Parse(FormatCSV, []byte(tt.content), tt.defaultSeason)
+			checkParse(t, entries, issues, tt.want, tt.wantIssue)
+		})
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	kickoff := time.Date(2024, 9, 6, 0, 20, 0, 0, time.UTC)
+	want := []Entry{{Line: 1, Season: 2024, Week: 1, HomeTeamID: "KC", AwayTeamID: "BAL", GameTime: kickoff}}
+	tests := []struct {
+		name      string
+		content   string
+		want      []Entry
+		wantIssue string
+	}{
+		{"array", `[{"week": 1, "awayTeamId": "BAL", "homeTeamId": "KC", "gameTime": "2024-09-06T00:20:00Z"}]`, want, ""},
+		{"objeto con games", `{"games": [{"week": 1, "away": "Baltimore Ravens", "home": "Chiefs", "scheduled_at": "2024-09-06T00:20:00Z"}]}`, want, ""},
+		{"JSON inválido", `[{"week": 1`, nil, "invalid JSON"},
+		{"entrada con error", `[{"week": 0, "away": "BAL", "home": "KC", "kickoff": "2024-09-06T00:20:00Z"}]`, nil, "line 1: week must be between 1 and 18"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, issues := Parse(FormatJSON, []byte(tt.content), 0)
+			checkParse(t, entries, issues, tt.want, tt.wantIssue)
+		})
+	}
+}
+
+func TestParseICS(t *testing.T) {
+	event := func(uid, start, summary string, extra ...string) string {
+		lines := []string{"BEGIN:VEVENT", "UID:" + uid, start, "SUMMARY:" + summary}
+		lines = append(lines, extra...)
+		return strings.Join(append(lines, "END:VEVENT"), "\r\n")
+	}
+	feed := func(events ...string) string {
+		return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" + strings.Join(events, "\r\n") + "\r\nEND:VCALENDAR\r\n"
+	}
+
+	tests := []struct {
+		name      string
+		content   string
+		want      []Entry
+		wantIssue string
+	}{
+		{
+			name:    "UTC con Week N",
+			content: feed(event("a", "DTSTART:20240906T002000Z", `Week 1: Ravens @ Chiefs`)),
+			want:    []Entry{{Line: 3, ExternalID: "a", Season: 2024, Week: 1, HomeTeamID: "KC", AwayTeamID: "BAL", GameTime: time.Date(2024, 9, 6, 0, 20, 0, 0, time.UTC)}},
+		},
+		{
+			name:    "TZID, vs y semana en la descripción",
+			content: feed(event("b", "DTSTART;TZID=America/New_York:20240908T130000", `Steelers vs Falcons`, `DESCRIPTION:NFL Week 1\, 2024`)),
+			want:    []Entry{{Line: 3, ExternalID: "b", Season: 2024, Week: 1, HomeTeamID: "PIT", AwayTeamID: "ATL", GameTime: time.Date(2024, 9, 8, 17, 0, 0, 0, time.UTC)}},
+		},
+		{
+			name: "semana calculada desde el primer juego",
+			content: feed(
+				event("c1", "DTSTART:20240906T002000Z", "BAL at KC"),
+				event("c2", "DTSTART:20240916T003000Z", "ATL at PHI"),
+			),
+			want: []Entry{
+				{Line: 3, ExternalID: "c1", Season: 2024, Week: 1, HomeTeamID: "KC", AwayTeamID: "BAL", GameTime: time.Date(2024, 9, 6, 0, 20, 0, 0, time.UTC)},
+				{Line: 8, ExternalID: "c2", Season: 2024, Week: 2, HomeTeamID: "PHI", AwayTeamID: "ATL", GameTime: time.Date(2024, 9, 16, 0, 30, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "línea continuada",
+			content: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:d\r\nDTSTART:20240906T002000Z\r\nSUMMARY:Week 1: Baltimore Ravens @ Kansas\r\n  City Chiefs\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			want:    []Entry{{Line: 2, ExternalID: "d", Season: 2024, Week: 1, HomeTeamID: "KC", AwayTeamID: "BAL", GameTime: time.Date(2024, 9, 6, 0, 20, 0, 0, time.UTC)}},
+		},
+		{"sin hora", feed(event("e", "DTSTART;VALUE=DATE:20240906", "Week 1: BAL @ KC")), nil, `line 3: DTSTART "20240906" has no kickoff time`},
+		{"zona desconocida", feed(event("f", "DTSTART;TZID=Mars/Olympus:20240906T002000", "Week 1: BAL @ KC")), nil, `line 3: unknown time zone "Mars/Olympus"`},
+		{"SUMMARY sin equipos", feed(event("g", "DTSTART:20240906T002000Z", "Kickoff party")), nil, `line 3: can't read the teams from SUMMARY "Kickoff party"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, issues := Parse(FormatICS, []byte(tt.content), 0)
+			checkParse(t, entries, issues, tt.want, tt.wantIssue)
+		})
+	}
+}
+
+func TestResolveTeam(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   string
+		wantOK bool
+	}{
+		{"KC", "KC", true},
+		{" kc ", "KC", true},
+		{"Kansas City Chiefs", "KC", true},
+		{"chiefs", "KC", true},
+		{"JAC", "JAX", true},
+		{"OAK", "LV", true},
+		{"Springfield", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := ResolveTeam(tt.value); got != tt.want || ok != tt.wantOK {
+			t.Errorf("ResolveTeam(%q) = (%q, %v), want (%q, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// checkParse compara las entradas y, si se espera un problema, que el primero
+// empiece con wantIssue
+func checkParse(t *testing.T, entries []Entry, issues []Issue, want []Entry, wantIssue string) {
+	t.Helper()
+	if wantIssue == "" && len(issues) > 0 {
+		t.Fatalf("unexpected issues: %v", issues)
+	}
+	if wantIssue != "" && (len(issues) == 0 || !strings.HasPrefix(issues[0].Error(), wantIssue)) {
+		t.Fatalf("issues = %v, want one starting with %q", issues, wantIssue)
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	for i := range want {
+		if !entries[i].GameTime.Equal(want[i].GameTime) {
+			t.Errorf("entry %d time = %v, want %v", i, entries[i].GameTime, want[i].GameTime)
+		}
+		got := entries[i]
+		got.GameTime = want[i].GameTime
+		if got != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Package schedule importa el calendario de una temporada desde CSV, JSON o
+// iCalendar y calcula los cambios contra los juegos ya guardados.
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"kickoff.com/game/internal/data"
+	"kickoff.com/game/internal/models"
+)
+
+// RegularSeasonWeeks es la cantidad de semanas de la temporada regular
+const RegularSeasonWeeks = 18
+
+// Entry es un juego leído del archivo de calendario
+type Entry struct {
+	Line       int    // Línea (CSV/ICS) o posición (JSON) de origen
+	ExternalID string // ID del juego en la fuente (UID del .ics, columna id)
+	Season     int
+	Week       int
+	HomeTeamID string
+	AwayTeamID string
+	GameTime   time.Time
+}
+
+// Issue es un problema que impide importar el calendario
+type Issue struct {
+	Line    int // 0 = no corresponde a una entrada
+	Message string
+}
+
+func (i Issue) Error() string {
+	if i.Line == 0 {
+		return i.Message
+	}
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+)
+
+// FieldChange es la diferencia de un campo entre el juego guardado y el archivo
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Change es lo que la importación hace con una entrada
+type Change struct {
+	Action Action
+	Entry  Entry
+	Game   *models.Game // Juego existente (update / unchanged)
+	Fields []FieldChange
+}
+
+// SeasonOf devuelve la temporada a la que pertenece un kickoff: los juegos de
+// enero y febrero (playoffs) son de la temporada del año anterior
+func SeasonOf(t time.Time) int {
+	if t.Month() < time.March {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+// Plan compara las entradas con los juegos existentes de las mismas
+// temporadas. Una entrada coincide con un juego por su ID externo o, si no
+// tiene, por temporada + semana + local + visitante. Además de los cambios
+// devuelve los problemas encontrados: duplicados en el archivo, juegos que ya
+// empezaron, equipos que juegan dos veces en una semana y equipos sin bye.
+func Plan(entries []Entry, existing []models.Game) ([]Change, []Issue) {
+	var issues []Issue
+
+	byExternal := make(map[string]*models.Game)
+	byKey := make(map[string]*models.Game)
+	for i := range existing {
+		game := &existing[i]
+		if game.ExternalID != "" {
+			byExternal[game.ExternalID] = game
+		}
+		key := gameKey(game.Season, game.Week, game.HomeTeamID, game.AwayTeamID)
+		if _, ok := byKey[key]; !ok {
+			byKey[key] = game
+		}
+	}
+
+	seenKey := make(map[string]int)
+	seenExternal := make(map[string]int)
+	matchedBy := make(map[string]int) // game ID -> línea que lo reemplaza
+
+	var changes []Change
+	for _, entry := range entries {
+		key := gameKey(entry.Season, entry.Week, entry.HomeTeamID, entry.AwayTeamID)
+		if line, ok := seenKey[key]; ok {
+			issues = append(issues, Issue{entry.Line, fmt.Sprintf("duplicate of line %d (%s @ %s, week %d)", line, entry.AwayTeamID, entry.HomeTeamID, entry.Week)})
+			continue
+		}
+		seenKey[key] = entry.Line
+		if entry.ExternalID != "" {
+			if line, ok := seenExternal[entry.ExternalID]; ok {
+				issues = append(issues, Issue{entry.Line, fmt.Sprintf("id %q is also used on line %d", entry.ExternalID, line)})
+				continue
+			}
+			seenExternal[entry.ExternalID] = entry.Line
+		}
+
+		game := byExternal[entry.ExternalID]
+		if entry.ExternalID == "" || game == nil {
+			if candidate := byKey[key]; candidate != nil && (candidate.ExternalID == "" || candidate.ExternalID == entry.ExternalID) {
+				game = candidate
+			}
+		}
+		if game == nil {
+			changes = append(changes, Change{Action: ActionCreate, Entry: entry})
+			continue
+		}
+		if line, ok := matchedBy[game.ID]; ok {
+			issues = append(issues, Issue{entry.Line, fmt.Sprintf("matches game %s, already matched by line %d", game.ID, line)})
+			continue
+		}
+		matchedBy[game.ID] = entry.Line
+
+		fields := diff(*game, entry)
+		if len(fields) == 0 {
+			changes = append(changes, Change{Action: ActionUnchanged, Entry: entry, Game: game})
+			continue
+		}
+		if game.Status != models.GameStatusScheduled {
+			issues = append(issues, Issue{entry.Line, fmt.Sprintf("game %s is %s and can't be changed by an import", game.ID, game.Status)})
+			continue
+		}
+		changes = append(changes, Change{Action: ActionUpdate, Entry: entry, Game: game, Fields: fields})
+	}
+
+	issues = append(issues, checkWeeks(changes, existing, matchedBy)...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Line < issues[j].Line
+	})
+	return changes, issues
+}
+
+// checkWeeks verifica el calendario resultante de cada temporada: un equipo
+// juega a lo sumo una vez por semana y tiene al menos una semana de descanso
+func checkWeeks(changes []Change, existing []models.Game, replaced map[string]int) []Issue {
+	type slot struct {
+		season int
+		team   string
+		week   int
+	}
+	refs := make(map[slot][]string)
+	add := func(season, week int, ref string, teams ...string) {
+		for _, team := range teams {
+			s := slot{season, team, week}
+			refs[s] = append(refs[s], ref)
+		}
+	}
+
+	for _, game := range existing {
+		if _, ok := replaced[game.ID]; ok || game.Status == models.GameStatusCanceled {
+			continue
+		}
+		add(game.Season, game.Week, "game "+game.ID, game.HomeTeamID, game.AwayTeamID)
+	}
+	for _, change := range changes {
+		entry := change.Entry
+		add(entry.Season, entry.Week, "line "+strconv.Itoa(entry.Line), entry.HomeTeamID, entry.AwayTeamID)
+	}
+
+	// Solo se reportan los conflictos en los que participa el archivo
+	var issues []Issue
+	weeksPlayed := make(map[slot]int) // week = 0: total de semanas por equipo
+	imported := make(map[slot]bool)
+	for s, list := range refs {
+		season := slot{s.season, s.team, 0}
+		if s.week >= 1 && s.week <= RegularSeasonWeeks {
+			weeksPlayed[season]++
+		}
+		line := firstLine(list)
+		if line > 0 {
+			imported[season] = true
+		}
+		if len(list) > 1 && line > 0 {
+			sort.Strings(list)
+			issues = append(issues, Issue{line, fmt.Sprintf("%s plays more than once in week %d of %d (%s)", s.team, s.week, s.season, strings.Join(list, ", "))})
+		}
+	}
+	for s, weeks := range weeksPlayed {
+		if weeks >= RegularSeasonWeeks && imported[s] {
+			issues = append(issues, Issue{0, fmt.Sprintf("%s has no bye week in %d", s.team, s.season)})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Message < issues[j].Message
+	})
+	return issues
+}
+
+// firstLine devuelve la primera línea del archivo entre las referencias de un conflicto
+func firstLine(refs []string) int {
+	line := 0
+	for _, ref := range refs {
+		if n, err := strconv.Atoi(strings.TrimPrefix(ref, "line ")); err == nil && (line == 0 || n < line) {
+			line = n
+		}
+	}
+	return line
+}
+
+func diff(game models.Game, entry Entry) []FieldChange {
+	var fields []FieldChange
+	if game.Season != entry.Season {
+		fields = append(fields, FieldChange{"season", strconv.Itoa(game.Season), strconv.Itoa(entry.Season)})
+	}
+	if game.Week != entry.Week {
+		fields = append(fields, FieldChange{"week", strconv.Itoa(game.Week), strconv.Itoa(entry.Week)})
+	}
+	if game.HomeTeamID != entry.HomeTeamID {
+		fields = append(fields, FieldChange{"homeTeamId", game.HomeTeamID, entry.HomeTeamID})
+	}
+	if game.AwayTeamID != entry.AwayTeamID {
+		fields = append(fields, FieldChange{"awayTeamId", game.AwayTeamID, entry.AwayTeamID})
+	}
+	if !game.GameTime.Equal(entry.GameTime) {
+		fields = append(fields, FieldChange{"gameTime", game.GameTime.UTC().Format(time.RFC3339), entry.GameTime.UTC().Format(time.RFC3339)})
+	}
+	if entry.ExternalID != "" && game.ExternalID != entry.ExternalID {
+		fields = append(fields, FieldChange{"externalId", game.ExternalID, entry.ExternalID})
+	}
+	return fields
+}
+
+func gameKey(season, week int, home, away string) string {
+	return fmt.Sprintf("%d/%d/%s/%s", season, week, home, away)
+}
+
+// ========================================
+// Equipos
+// ========================================
+
+// teamAliases son abreviaturas alternativas usadas por otras fuentes
+var teamAliases = map[string]string{
+	"JAC": "JAX",
+	"WSH": "WAS",
+	"LA":  "LAR",
+	"OAK": "LV",
+	"SD":  "LAC",
+	"STL": "LAR",
+}
+
+var teamsByName = func() map[string]string {
+	names := make(map[string]string, len(data.NFLTeams)*3)
+	for _, team := range data.NFLTeams {
+		names[strings.ToLower(team.ID)] = team.ID
+		names[strings.ToLower(team.Name)] = team.ID
+		// Apodo: "Kansas City Chiefs" -> "chiefs"
+		words := strings.Fields(team.Name)
+		names[strings.ToLower(words[len(words)-1])] = team.ID
+	}
+	for alias, id := range teamAliases {
+		names[strings.ToLower(alias)] = id
+	}
+	return names
+}()
+
+// ResolveTeam devuelve el ID de un equipo de data.NFLTeams a partir de su ID,
+// su nombre completo o su apodo
+func ResolveTeam(value string) (string, bool) {
+	id, ok := teamsByName[strings.ToLower(strings.TrimSpace(value))]
+	return id, ok
+}
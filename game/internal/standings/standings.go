@@ -0,0 +1,295 @@
+// Package standings calcula la tabla de posiciones por división y conferencia
+// a partir de los juegos terminados, con los desempates oficiales de la NFL.
+package standings
+
+import (
+	"fmt"
+	"sort"
+
+	"kickoff.com/game/internal/models"
+)
+
+// Record es un récord de ganados, perdidos y empatados
+type Record struct {
+	Wins   int
+	Losses int
+	Ties   int
+}
+
+func (r Record) Games() int {
+	return r.Wins + r.Losses + r.Ties
+}
+
+// Pct es el porcentaje de victorias; un empate cuenta como media victoria
+func (r Record) Pct() float64 {
+	if r.Games() == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(r.Games())
+}
+
+func (r Record) String() string {
+	return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Ties)
+}
+
+func (r *Record) add(pointsFor, pointsAgainst int) {
+	switch {
+	case pointsFor > pointsAgainst:
+		r.Wins++
+	case pointsFor < pointsAgainst:
+		r.Losses++
+	default:
+		r.Ties++
+	}
+}
+
+// Standing es la posición de un equipo en la tabla
+type Standing struct {
+	TeamID             string
+	Conference         models.Conference
+	Division           models.Division
+	Overall            Record
+	DivisionRecord     Record
+	ConferenceRecord   Record
+	Home               Record
+	Away               Record
+	PointsFor          int
+	PointsAgainst      int
+	Streak             string // "W3", "L1", "T1"; vacío sin juegos
+	StrengthOfVictory  float64
+	StrengthOfSchedule float64
+	DivisionRank       int // 1 = líder de la división
+	ConferenceRank     int // Sembrado: 1-4 líderes de división, 5 en adelante el resto
+	// Criterio que lo separó de los equipos con su mismo porcentaje, si hubo empate
+	DivisionTiebreaker   string
+	ConferenceTiebreaker string
+}
+
+// result es un juego terminado desde el punto de vista de un equipo
+type result struct {
+	opponent      string
+	pointsFor     int
+	pointsAgainst int
+	home          bool
+}
+
+type table struct {
+	teams     map[string]models.Team
+	results   map[string][]result
+	standings map[string]*Standing
+}
+
+// Compute arma la tabla con los juegos recibidos, que deben ser los juegos
+// terminados de la temporada regular hasta la semana que se quiere ver. Los
+// equipos vienen ordenados por conferencia, división y posición.
+func Compute(teams []models.Team, games []models.Game) []Standing {
+	t := &table{
+		teams:     make(map[string]models.Team, len(teams)),
+		results:   make(map[string][]result, len(teams)),
+		standings: make(map[string]*Standing, len(teams)),
+	}
+	for _, team := range teams {
+		t.teams[team.ID] = team
+		t.standings[team.ID] = &Standing{
+			TeamID:     team.ID,
+			Conference: team.Conference,
+			Division:   team.Division,
+		}
+	}
+
+	sorted := append([]models.Game(nil), games...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Week != sorted[j].Week {
+			return sorted[i].Week < sorted[j].Week
+		}
+		return sorted[i].GameTime.Before(sorted[j].GameTime)
+	})
+	for _, game := range sorted {
+		if _, ok := t.teams[game.HomeTeamID]; !ok {
+			continue
+		}
+		if _, ok := t.teams[game.AwayTeamID]; !ok {
+			continue
+		}
+		t.addResult(game.HomeTeamID, result{game.AwayTeamID, game.HomeScore, game.AwayScore, true})
+		t.addResult(game.AwayTeamID, result{game.HomeTeamID, game.AwayScore, game.HomeScore, false})
+	}
+
+	for id, s := range t.standings {
+		s.Streak = streak(t.results[id])
+		s.StrengthOfVictory, s.StrengthOfSchedule = t.strength(id)
+	}
+
+	t.rankDivisions()
+	t.seedConferences()
+
+	out := make([]Standing, 0, len(t.standings))
+	for _, s := range t.standings {
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Conference != out[j].Conference {
+			return out[i].Conference < out[j].Conference
+		}
+		if out[i].Division != out[j].Division {
+			return out[i].Division < out[j].Division
+		}
+		return out[i].DivisionRank < out[j].DivisionRank
+	})
+	return out
+}
+
+func (t *table) addResult(teamID string, r result) {
+	t.results[teamID] = append(t.results[teamID], r)
+
+	s := t.standings[teamID]
+	team, opponent := t.teams[teamID], t.teams[r.opponent]
+	s.Overall.add(r.pointsFor, r.pointsAgainst)
+	if r.home {
+		s.Home.add(r.pointsFor, r.pointsAgainst)
+	} else {
+		s.Away.add(r.pointsFor, r.pointsAgainst)
+	}
+	if team.Division == opponent.Division {
+		s.DivisionRecord.add(r.pointsFor, r.pointsAgainst)
+	}
+	if team.Conference == opponent.Conference {
+		s.ConferenceRecord.add(r.pointsFor, r.pointsAgainst)
+	}
+	s.PointsFor += r.pointsFor
+	s.PointsAgainst += r.pointsAgainst
+}
+
+// strength devuelve el porcentaje combinado de los rivales vencidos (SOV) y de
+// todos los rivales (SOS), contando a cada rival una vez por juego
+func (t *table) strength(teamID string) (float64, float64) {
+	var beaten, all Record
+	for _, r := range t.results[teamID] {
+		opponent := t.standings[r.opponent].Overall
+		all.Wins += opponent.Wins
+		all.Losses += opponent.Losses
+		all.Ties += opponent.Ties
+		if r.pointsFor > r.pointsAgainst {
+			beaten.Wins += opponent.Wins
+			beaten.Losses += opponent.Losses
+			beaten.Ties += opponent.Ties
+		}
+	}
+	return beaten.Pct(), all.Pct()
+}
+
+func streak(results []result) string {
+	if len(results) == 0 {
+		return ""
+	}
+	kind := func(r result) string {
+		switch {
+		case r.pointsFor > r.pointsAgainst:
+			return "W"
+		case r.pointsFor < r.pointsAgainst:
+			return "L"
+		default:
+			return "T"
+		}
+	}
+	last := kind(results[len(results)-1])
+	count := 0
+	for i := len(results) - 1; i >= 0 && kind(results[i]) == last; i-- {
+		count++
+	}
+	return fmt.Sprintf("%s%d", last, count)
+}
+
+// ========================================
+// Posiciones y sembrado
+// ========================================
+
+func (t *table) rankDivisions() {
+	byDivision := make(map[models.Division][]string)
+	for id, team := range t.teams {
+		byDivision[team.Division] = append(byDivision[team.Division], id)
+	}
+	for _, teams := range byDivision {
+		ordered, reasons := t.order(teams, false)
+		for i, id := range ordered {
+			t.standings[id].DivisionRank = i + 1
+			t.standings[id].DivisionTiebreaker = reasons[id]
+		}
+	}
+}
+
+// seedConferences siembra cada conferencia: primero los líderes de división
+// ordenados entre sí y después el resto, ambos con los desempates de wild card
+func (t *table) seedConferences() {
+	winners := make(map[models.Conference][]string)
+	others := make(map[models.Conference][]string)
+	for id, team := range t.teams {
+		if t.standings[id].DivisionRank == 1 {
+			winners[team.Conference] = append(winners[team.Conference], id)
+		} else {
+			others[team.Conference] = append(others[team.Conference], id)
+		}
+	}
+
+	for conference := range t.conferences() {
+		seed := 0
+		for _, group := range [][]string{winners[conference], others[conference]} {
+			ordered, reasons := t.order(group, true)
+			for _, id := range ordered {
+				seed++
+				t.standings[id].ConferenceRank = seed
+				t.standings[id].ConferenceTiebreaker = reasons[id]
+			}
+		}
+	}
+}
+
+func (t *table) conferences() map[models.Conference]bool {
+	conferences := make(map[models.Conference]bool)
+	for _, team := range t.teams {
+		conferences[team.Conference] = true
+	}
+	return conferences
+}
+
+// order ordena los equipos por porcentaje y resuelve cada grupo empatado
+// eligiendo de a un equipo con la cadena de desempates correspondiente
+func (t *table) order(teams []string, wildCard bool) ([]string, map[string]string) {
+	sorted := append([]string(nil), teams...)
+	sort.Slice(sorted, func(i, j int) bool {
+		pi, pj := t.standings[sorted[i]].Overall.Pct(), t.standings[sorted[j]].Overall.Pct()
+		if !equal(pi, pj) {
+			return pi > pj
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	var ordered []string
+	reasons := make(map[string]string)
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && equal(t.standings[sorted[end]].Overall.Pct(), t.standings[sorted[start]].Overall.Pct()) {
+			end++
+		}
+
+		remaining := append([]string(nil), sorted[start:end]...)
+		for len(remaining) > 0 {
+			best, reason := t.selectBest(remaining, wildCard)
+			ordered = append(ordered, best)
+			reasons[best] = reason
+			remaining = without(remaining, best)
+		}
+		start = end
+	}
+	return ordered, reasons
+}
+
+func without(teams []string, team string) []string {
+	out := make([]string, 0, len(teams)-1)
+	for _, id := range teams {
+		if id != team {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,158 @@
+package standings
+
+import (
+	"testing"
+
+	"kickoff.com/game/internal/models"
+)
+
+var testTeams = []models.Team{
+	{ID: "KC", Conference: models.ConferenceAFC, Division: models.DivisionAFCWest},
+	{ID: "LV", Conference: models.ConferenceAFC, Division: models.DivisionAFCWest},
+	{ID: "DEN", Conference: models.ConferenceAFC, Division: models.DivisionAFCWest},
+	{ID: "LAC", Conference: models.ConferenceAFC, Division: models.DivisionAFCWest},
+	{ID: "NYJ", Conference: models.ConferenceAFC, Division: models.DivisionAFCEast},
+	{ID: "BUF", Conference: models.ConferenceAFC, Division: models.DivisionAFCEast},
+}
+
+func final(week int, home, away string, homeScore, awayScore int) models.Game {
+	return models.Game{Week: week, HomeTeamID: home, AwayTeamID: away, HomeScore: homeScore, AwayScore: awayScore, Status: models.GameStatusCompleted}
+}
+
+func byTeam(standings []Standing) map[string]Standing {
+	out := make(map[string]Standing, len(standings))
+	for _, s := range standings {
+		out[s.TeamID] = s
+	}
+	return out
+}
+
+func TestComputeDivisionTiebreakers(t *testing.T) {
+	tests := []struct {
+		name       string
+		games      []models.Game
+		wantFirst  string
+		wantSecond string
+		wantReason string
+	}{
+		{
+			name:       "sin empate",
+			games:      []models.Game{final(1, "KC", "LV", 24, 17)},
+			wantFirst:  "KC",
+			wantReason: "",
+		},
+		{
+			name: "head-to-head",
+			games: []models.Game{
+				final(1, "KC", "LV", 24, 17),
+				final(2, "NYJ", "KC", 20, 10),
+				final(2, "LV", "NYJ", 27, 3),
+			},
+			wantFirst:  "KC",
+			wantSecond: "LV",
+			wantReason: "head-to-head",
+		},
+		{
+			name: "récord divisional tras dividir la serie",
+			games: []models.Game{
+				final(1, "KC", "LV", 24, 17),
+				final(2, "LV", "KC", 21, 14),
+				final(3, "KC", "DEN", 30, 10),
+				final(3, "NYJ", "LV", 10, 20),
+				final(4, "NYJ", "KC", 20, 17),
+				final(4, "BUF", "LV", 13, 10),
+			},
+			wantFirst:  "KC",
+			wantSecond: "LV",
+			wantReason: "division record",
+		},
+		{
+			name: "sorteo por ID en un triple empate circular",
+			games: []models.Game{
+				final(1, "KC", "LV", 24, 17),
+				final(2, "LV", "DEN", 24, 17),
+				final(3, "DEN", "KC", 24, 17),
+			},
+			wantFirst:  "DEN",
+			wantSecond: "KC",
+			wantReason: coinToss,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standings := byTeam(Compute(testTeams, tt.games))
+			first := standings[tt.wantFirst]
+			if first.DivisionRank != 1 {
+				t.Fatalf("%s division rank = %d, want 1", tt.wantFirst, first.DivisionRank)
+			}
+			if second := standings[tt.wantSecond]; tt.wantSecond != "" && second.DivisionRank != 2 {
+				t.Errorf("%s division rank = %d, want 2", tt.wantSecond, second.DivisionRank)
+			}
+			if first.DivisionTiebreaker != tt.wantReason {
+				t.Errorf("%s tiebreaker = %q, want %q", tt.wantFirst, first.DivisionTiebreaker, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestComputeSeedsDivisionWinnersFirst(t *testing.T) {
+	games := []models.Game{
+		final(1, "KC", "LV", 24, 17),
+		final(1, "BUF", "NYJ", 10, 13),
+		final(2, "LV", "DEN", 31, 0),
+		final(2, "LAC", "BUF", 35, 3),
+		final(3, "LV", "LAC", 20, 10),
+	}
+	standings := byTeam(Compute(testTeams, games))
+
+	// KC y NYJ (1-0) lideran sus divisiones y van 1 y 2; LV (2-1) es el
+	// mejor del resto
+	for _, id := range []string{"KC", "NYJ"} {
+		if s := standings[id]; s.DivisionRank != 1 || s.ConferenceRank > 2 {
+			t.Errorf("%s = division %d, seed %d, want division leader seeded 1-2", id, s.DivisionRank, s.ConferenceRank)
+		}
+	}
+	if s := standings["LV"]; s.ConferenceRank != 3 {
+		t.Errorf("LV seed = %d, want 3", s.ConferenceRank)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		record  Record
+		wantPct float64
+		wantStr string
+	}{
+		{Record{}, 0, "0-0-0"},
+		{Record{Wins: 3, Losses: 1}, 0.75, "3-1-0"},
+		{Record{Wins: 1, Losses: 1, Ties: 2}, 0.5, "1-1-2"},
+		{Record{Ties: 1}, 0.5, "0-0-1"},
+	}
+	for _, tt := range tests {
+		if got := tt.record.Pct(); !equal(got, tt.wantPct) {
+			t.Errorf("%s Pct() = %v, want %v", tt.wantStr, got, tt.wantPct)
+		}
+		if got := tt.record.String(); got != tt.wantStr {
+			t.Errorf("String() = %q, want %q", got, tt.wantStr)
+		}
+	}
+}
+
+func TestStreak(t *testing.T) {
+	win, loss, tie := result{pointsFor: 2}, result{pointsAgainst: 2}, result{}
+	tests := []struct {
+		results []result
+		want    string
+	}{
+		{nil, ""},
+		{[]result{win}, "W1"},
+		{[]result{loss, win, win, win}, "W3"},
+		{[]result{win, tie}, "T1"},
+		{[]result{win, loss, loss}, "L2"},
+	}
+	for _, tt := range tests {
+		if got := streak(tt.results); got != tt.want {
+			t.Errorf("streak(%v) = %q, want %q", tt.results, got, tt.want)
+		}
+	}
+}
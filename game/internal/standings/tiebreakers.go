@@ -0,0 +1,316 @@
+package standings
+
+import (
+	"math"
+	"sort"
+
+	"kickoff.com/game/internal/models"
+)
+
+// step es un criterio de desempate: devuelve un valor por equipo (mayor es
+// mejor) o nil si no se puede aplicar al grupo
+type step struct {
+	name  string
+	value func(t *table, group []string) map[string]float64
+}
+
+// Cadenas de desempate de la NFL. El criterio de touchdowns netos no se aplica
+// porque solo guardamos el marcador, y el sorteo final se reemplaza por el
+// orden alfabético del ID para que la tabla sea determinística.
+var (
+	divisionSteps = []step{
+		{"head-to-head", headToHead},
+		{"division record", divisionPct},
+		{"common games", commonGames(0)},
+		{"conference record", conferencePct},
+		{"strength of victory", strengthOfVictory},
+		{"strength of schedule", strengthOfSchedule},
+		{"conference points rank", conferencePointsRank},
+		{"league points rank", leaguePointsRank},
+		{"net points in common games", netCommonPoints},
+		{"net points", netPoints},
+	}
+	wildCardTwoSteps = []step{
+		{"head-to-head", headToHead},
+		{"conference record", conferencePct},
+		{"common games", commonGames(4)},
+		{"strength of victory", strengthOfVictory},
+		{"strength of schedule", strengthOfSchedule},
+		{"conference points rank", conferencePointsRank},
+		{"league points rank", leaguePointsRank},
+		{"net points in conference games", netConferencePoints},
+		{"net points", netPoints},
+	}
+	wildCardMultiSteps = append([]step{{"head-to-head sweep", headToHeadSweep}}, wildCardTwoSteps[1:]...)
+)
+
+const coinToss = "coin toss"
+
+// selectBest elige al mejor de un grupo empatado. Cuando un criterio separa a
+// parte del grupo, el proceso vuelve a empezar con los que quedaron arriba
+// (con la cadena de dos equipos si quedan dos). En wild card primero queda
+// solo el mejor de cada división.
+func (t *table) selectBest(group []string, wildCard bool) (string, string) {
+	if len(group) == 1 {
+		return group[0], ""
+	}
+
+	candidates := group
+	if wildCard {
+		candidates = t.bestPerDivision(group)
+		if len(candidates) == 1 {
+			return candidates[0], "division tiebreaker"
+		}
+	}
+
+	steps := divisionSteps
+	if wildCard {
+		steps = wildCardTwoSteps
+		if len(candidates) > 2 {
+			steps = wildCardMultiSteps
+		}
+	}
+
+	for _, s := range steps {
+		values := s.value(t, candidates)
+		if values == nil {
+			continue
+		}
+		best := top(candidates, values)
+		if len(best) == len(candidates) {
+			continue
+		}
+		if len(best) == 1 {
+			return best[0], s.name
+		}
+		team, reason := t.selectBest(best, wildCard)
+		if reason == "" || reason == coinToss {
+			reason = s.name
+		}
+		return team, reason
+	}
+
+	sorted := append([]string(nil), candidates...)
+	sort.Strings(sorted)
+	return sorted[0], coinToss
+}
+
+// bestPerDivision deja en el grupo solo al mejor ubicado de cada división
+func (t *table) bestPerDivision(group []string) []string {
+	best := make(map[models.Division]string)
+	for _, id := range group {
+		division := t.teams[id].Division
+		if current, ok := best[division]; !ok || t.standings[id].DivisionRank < t.standings[current].DivisionRank {
+			best[division] = id
+		}
+	}
+	var out []string
+	for _, id := range group {
+		if best[t.teams[id].Division] == id {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func top(group []string, values map[string]float64) []string {
+	max := math.Inf(-1)
+	for _, id := range group {
+		max = math.Max(max, values[id])
+	}
+	var best []string
+	for _, id := range group {
+		if equal(values[id], max) {
+			best = append(best, id)
+		}
+	}
+	return best
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// ========================================
+// Criterios
+// ========================================
+
+// recordAgainst devuelve el récord y los puntos netos de un equipo en los
+// juegos contra los rivales que cumplen include
+func (t *table) recordAgainst(teamID string, include func(opponent string) bool) (Record, int) {
+	var record Record
+	net := 0
+	for _, r := range t.results[teamID] {
+		if include(r.opponent) {
+			record.add(r.pointsFor, r.pointsAgainst)
+			net += r.pointsFor - r.pointsAgainst
+		}
+	}
+	return record, net
+}
+
+func inGroup(group []string) func(string) bool {
+	set := make(map[string]bool, len(group))
+	for _, id := range group {
+		set[id] = true
+	}
+	return func(id string) bool { return set[id] }
+}
+
+// headToHead compara el porcentaje en los juegos entre los equipos del grupo;
+// no aplica si alguno todavía no jugó contra los demás
+func headToHead(t *table, group []string) map[string]float64 {
+	values := make(map[string]float64, len(group))
+	for _, id := range group {
+		record, _ := t.recordAgainst(id, func(opponent string) bool { return opponent != id && inGroup(group)(opponent) })
+		if record.Games() == 0 {
+			return nil
+		}
+		values[id] = record.Pct()
+	}
+	return values
+}
+
+// headToHeadSweep solo aplica si un equipo le ganó a todos los demás del grupo
+// (queda primero) o si uno perdió contra todos (queda último)
+func headToHeadSweep(t *table, group []string) map[string]float64 {
+	beat := func(a, b string) (won, lost bool) {
+		record, _ := t.recordAgainst(a, func(opponent string) bool { return opponent == b })
+		return record.Games() > 0 && record.Wins == record.Games(), record.Games() > 0 && record.Losses == record.Games()
+	}
+
+	for _, id := range group {
+		sweptAll, lostAll := true, true
+		for _, other := range group {
+			if other == id {
+				continue
+			}
+			won, lost := beat(id, other)
+			sweptAll = sweptAll && won
+			lostAll = lostAll && lost
+		}
+		if sweptAll || lostAll {
+			values := make(map[string]float64, len(group))
+			for _, other := range group {
+				values[other] = 0.5
+			}
+			if sweptAll {
+				values[id] = 1
+			} else {
+				values[id] = 0
+			}
+			return values
+		}
+	}
+	return nil
+}
+
+func divisionPct(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 { return t.standings[id].DivisionRecord.Pct() })
+}
+
+func conferencePct(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 { return t.standings[id].ConferenceRecord.Pct() })
+}
+
+func strengthOfVictory(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 { return t.standings[id].StrengthOfVictory })
+}
+
+func strengthOfSchedule(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 { return t.standings[id].StrengthOfSchedule })
+}
+
+func netPoints(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 {
+		return float64(t.standings[id].PointsFor - t.standings[id].PointsAgainst)
+	})
+}
+
+func netConferencePoints(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 {
+		conference := t.teams[id].Conference
+		_, net := t.recordAgainst(id, func(opponent string) bool { return t.teams[opponent].Conference == conference })
+		return float64(net)
+	})
+}
+
+// commonOpponents devuelve los rivales que enfrentaron todos los equipos del grupo
+func (t *table) commonOpponents(group []string) func(string) bool {
+	counts := make(map[string]int)
+	for _, id := range group {
+		seen := make(map[string]bool)
+		for _, r := range t.results[id] {
+			if !seen[r.opponent] {
+				seen[r.opponent] = true
+				counts[r.opponent]++
+			}
+		}
+	}
+	return func(opponent string) bool { return counts[opponent] == len(group) }
+}
+
+// commonGames compara el porcentaje contra rivales comunes; en wild card
+// exige un mínimo de juegos comunes por equipo
+func commonGames(minimum int) func(t *table, group []string) map[string]float64 {
+	return func(t *table, group []string) map[string]float64 {
+		common := t.commonOpponents(group)
+		values := make(map[string]float64, len(group))
+		for _, id := range group {
+			record, _ := t.recordAgainst(id, common)
+			if record.Games() == 0 || record.Games() < minimum {
+				return nil
+			}
+			values[id] = record.Pct()
+		}
+		return values
+	}
+}
+
+func netCommonPoints(t *table, group []string) map[string]float64 {
+	common := t.commonOpponents(group)
+	return perTeam(group, func(id string) float64 {
+		_, net := t.recordAgainst(id, common)
+		return float64(net)
+	})
+}
+
+// conferencePointsRank suma el puesto en puntos anotados y en puntos
+// recibidos entre los equipos de la conferencia (menor es mejor)
+func conferencePointsRank(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 {
+		conference := t.teams[id].Conference
+		return -float64(t.pointsRank(id, func(other models.Team) bool { return other.Conference == conference }))
+	})
+}
+
+func leaguePointsRank(t *table, group []string) map[string]float64 {
+	return perTeam(group, func(id string) float64 {
+		return -float64(t.pointsRank(id, func(models.Team) bool { return true }))
+	})
+}
+
+func (t *table) pointsRank(teamID string, include func(models.Team) bool) int {
+	s := t.standings[teamID]
+	scored, allowed := 1, 1
+	for id, team := range t.teams {
+		if !include(team) {
+			continue
+		}
+		if t.standings[id].PointsFor > s.PointsFor {
+			scored++
+		}
+		if t.standings[id].PointsAgainst < s.PointsAgainst {
+			allowed++
+		}
+	}
+	return scored + allowed
+}
+
+func perTeam(group []string, value func(id string) float64) map[string]float64 {
+	values := make(map[string]float64, len(group))
+	for _, id := range group {
+		values[id] = value(id)
+	}
+	return values
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"kickoff.com/pkg/auth"
+	pb "kickoff.com/proto"
+)
+
+type contextKey string
+
+const (
+	// callerKey guarda en el contexto del request los claims del usuario autenticado
+	callerKey contextKey = "caller"
+	// tokenKey guarda el access token verificado, que se reenvía a los servicios
+	tokenKey contextKey = "accessToken"
+)
+
+// ========================================
+// Auth Middleware
+// ========================================
+
+// authMiddleware resuelve el usuario que llama a partir del header
+// Authorization y lo guarda en el contexto del request, junto con el token
+// para propagarlo a los servicios (ver rpcContext). Sin header el request
+// sigue como anónimo; un token inválido o vencido se rechaza con 401.
+func (g *Gateway) authMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next(w, r)
+			return
+		}
+
+		token, ok := auth.BearerToken(header)
+		if !ok {
+			unauthorized(w, "Authorization header must be 'Bearer <token>'")
+			return
+		}
+
+		claims, err := g.tokens.Verify(token, time.Now())
+		if err != nil {
+			unauthorized(w, err.Error())
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), callerKey, claims)
+		next(w, r.WithContext(context.WithValue(ctx, tokenKey, token)))
+	}
+}
+
+// callerID devuelve el usuario autenticado del request, o "" si es anónimo
+func callerID(r *http.Request) string {
+	claims, _ := r.Context().Value(callerKey).(auth.Claims)
+	return claims.Subject
+}
+
+// rpcContext crea el contexto de un llamado gRPC del request: con timeout y,
+// si el usuario se autenticó, con su access token en la metadata para que el
+// servicio controle su rol
+func rpcContext(r *http.Request, timeout time.Duration) (context.Context, context.CancelFunc) {
+	token, _ := r.Context().Value(tokenKey).(string)
+	return context.WithTimeout(auth.WithToken(context.Background(), token), timeout)
+}
+
+func unauthorized(w http.ResponseWriter, message string) {
+	writeError(w, codes.Unauthenticated, message)
+}
+
+// ========================================
+// HTTP Handlers - Authentication
+// ========================================
+
+// Register, login, refresh, logout y validate son rutas generadas (ver
+// transcoding.go); /api/auth/me queda a mano porque sale del token.
+
+// meHandler devuelve el usuario dueño del access token
+func (g *Gateway) meHandler(w http.ResponseWriter, r *http.Request) {
+	userID := callerID(r)
+	if userID == "" {
+		unauthorized(w, "Authentication required")
+		return
+	}
+
+	ctx, cancel := rpcContext(r, 5*time.Second)
+	defer cancel()
+
+	resp, err := g.userClient.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: userID})
+	if err != nil {
+		writeRPCError(w, err, "user service")
+		return
+	}
+
+	writeProto(w, http.StatusOK, resp)
+}
@@ -7,13 +7,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
-	"time"
 
+	"kickoff.com/pkg/auth"
+	shared "kickoff.com/pkg/models"
 	pb "kickoff.com/proto"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 const serviceName = "gateway"
@@ -23,6 +26,9 @@ type Gateway struct {
 	gameClient        pb.GameServiceClient
 	predictionClient  pb.PredictionServiceClient
 	leaderboardClient pb.LeaderboardServiceClient
+	tokens            *auth.Signer
+	rest              *runtime.ServeMux
+	openAPI           []byte
 }
 
 func main() {
@@ -33,25 +39,38 @@ func main() {
 	log.Printf("Starting Gateway service on port %d", port)
 	log.Printf("Using Kubernetes DNS for service discovery")
 
-	gateway := &Gateway{}
+	// Verificador de access tokens (mismo secreto que el User Service)
+	tokens, err := auth.NewSignerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize token verifier: %v", err)
+	}
+
+	gateway := &Gateway{tokens: tokens}
 
 	// Inicializar conexiones gRPC a los servicios
 	if err := gateway.initGRPCClients(); err != nil {
 		log.Fatalf("Failed to initialize gRPC clients: %v", err)
 	}
+	if err := gateway.initRESTMux(context.Background()); err != nil {
+		log.Fatalf("Failed to register REST routes: %v", err)
+	}
+	openAPI, err := buildOpenAPI()
+	if err != nil {
+		log.Fatalf("Failed to build OpenAPI document: %v", err)
+	}
+	gateway.openAPI = openAPI
 
 	// Endpoints del Gateway
 	http.HandleFunc("/", gateway.frontendHandler)
 	http.HandleFunc("/health", gateway.corsMiddleware(gateway.healthHandler))
-	http.HandleFunc("/api/users", gateway.corsMiddleware(gateway.usersHandler))
-	http.HandleFunc("/api/teams", gateway.corsMiddleware(gateway.teamsHandler))
-	// Support both listing and single-game lookup: /api/games and /api/games/{id}
-	http.HandleFunc("/api/games", gateway.corsMiddleware(gateway.gamesHandler))
-	http.HandleFunc("/api/games/", gateway.corsMiddleware(gateway.gamesHandler))
-	http.HandleFunc("/api/predictions", gateway.corsMiddleware(gateway.predictionsHandler))
-	http.HandleFunc("/api/predictions/user/", gateway.corsMiddleware(gateway.userPredictionsHandler))
-	http.HandleFunc("/api/leaderboard", gateway.corsMiddleware(gateway.leaderboardHandler))
-	http.HandleFunc("/api/user-stats/", gateway.corsMiddleware(gateway.userStatsHandler))
+	http.HandleFunc("GET /api/auth/me", gateway.corsMiddleware(gateway.authMiddleware(gateway.meHandler)))
+	http.HandleFunc("GET /api/stream", gateway.corsMiddleware(gateway.authMiddleware(gateway.streamHandler)))
+	http.HandleFunc("GET /api/stream/public", gateway.corsMiddleware(gateway.publicStreamHandler))
+	http.HandleFunc("GET /api/games/{id}/stream", gateway.corsMiddleware(gateway.gameStreamHandler))
+	http.HandleFunc("GET /api/openapi.json", gateway.corsMiddleware(gateway.openAPIHandler))
+	http.HandleFunc("GET /api/docs", gateway.docsHandler)
+	// El resto de /api/ son las rutas generadas desde las anotaciones google.api.http
+	http.HandleFunc("/api/", gateway.corsMiddleware(gateway.restHandler))
 
 	log.Printf("Gateway service listening on :%d", port)
 	log.Println("✅ All gRPC clients initialized")
@@ -110,7 +129,7 @@ func (g *Gateway) corsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Set CORS headers
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		w.Header().Set("Access-Control-Max-Age", "86400")
 
@@ -205,9 +224,9 @@ func (g *Gateway) frontendHandler(w http.ResponseWriter, r *http.Request) {
       font-size: 0.85em;
       font-weight: bold;
     }
-    .status-1 { background: #cfe2ff; color: #084298; }
-    .status-2 { background: #f8d7da; color: #842029; }
-    .status-3 { background: #d1e7dd; color: #0f5132; }
+    .status-GAME_STATUS_SCHEDULED { background: #cfe2ff; color: #084298; }
+    .status-GAME_STATUS_IN_PROGRESS { background: #f8d7da; color: #842029; }
+    .status-GAME_STATUS_COMPLETED { background: #d1e7dd; color: #0f5132; }
 
     .loading { text-align: center; color: #999; font-style: italic; }
     .error { background: #f8d7da; color: #721c24; padding: 12px; border-radius: 6px; margin-bottom: 12px; }
@@ -299,6 +318,21 @@ func (g *Gateway) frontendHandler(w http.ResponseWriter, r *http.Request) {
       }
     }
 
+    // apiCallAll recorre todas las páginas de un listado siguiendo
+    // next_page_token y devuelve las filas de field (null si falla un pedido)
+    async function apiCallAll(endpoint, field) {
+      const sep = endpoint.includes('?') ? '&' : '?';
+      let rows = [];
+      let token = '';
+      do {
+        const page = await apiCall(endpoint + sep + 'page_size=200' + (token ? '&page_token=' + encodeURIComponent(token) : ''));
+        if (!page) return null;
+        rows = rows.concat(page[field] || []);
+        token = page.next_page_token;
+      } while (token);
+      return rows;
+    }
+
     async function checkHealth() {
       try {
         const res = await fetch(API_BASE + '/health');
@@ -334,30 +368,42 @@ func (g *Gateway) frontendHandler(w http.ResponseWriter, r *http.Request) {
       ).join('');
     }
 
+    // Juegos por id: la carga inicial y el stream en vivo actualizan el mismo mapa
+    const games = new Map();
+    let renderPending = false;
+
     async function loadGames() {
-      const data = await apiCall('/api/games');
+      const rows = await apiCallAll('/api/games', 'games');
+      if (rows) {
+        rows.forEach(g => games.set(g.id, g));
+      }
+      renderGames();
+    }
+
+    function renderGames() {
       const el = document.getElementById('gamesContainer');
-      if (!data || !data.games || data.games.length === 0) {
+      if (games.size === 0) {
         el.innerHTML = '<div class="empty">No hay juegos disponibles</div>';
         return;
       }
-      el.innerHTML = data.games.map(g => {
-        const statusText = g.status === 1 ? 'Programado' : g.status === 2 ? 'En Vivo' : 'Finalizado';
+      el.innerHTML = Array.from(games.values()).map(g => {
+        const statusText = g.status === 'GAME_STATUS_SCHEDULED' ? 'Programado' : g.status === 'GAME_STATUS_IN_PROGRESS' ? 'En Vivo' : 'Finalizado';
+        const clock = g.quarter ? ' • Q' + g.quarter + ' ' + g.clock : '';
         return '<div class="game-card"><h3>' + g.home_team_id + ' vs ' + g.away_team_id + '</h3>' +
           '<p><strong>Semana ' + g.week + '</strong></p>' +
-          '<p>Score: <strong>' + (g.home_score || 0) + '-' + (g.away_score || 0) + '</strong></p>' +
+          '<p>Score: <strong>' + (g.home_score || 0) + '-' + (g.away_score || 0) + '</strong>' + clock + '</p>' +
           '<p><span class="game-status status-' + g.status + '">' + statusText + '</span></p></div>';
       }).join('');
     }
 
     async function loadUsers() {
-      const data = await apiCall('/api/users');
+      const users = await apiCallAll('/api/users', 'users');
       const el = document.getElementById('usersContainer');
-      if (!data || !data.users || data.users.length === 0) {
+      if (!users || users.length === 0) {
         el.innerHTML = '<div class="empty">No hay usuarios registrados</div>';
         return;
       }
-      el.innerHTML = data.users.slice(0, 10).map(u =>
+      el.innerHTML = users.map(u =>
         '<div class="user-card"><h3>' + (u.full_name || u.username) + '</h3>' +
         '<p>@' + u.username + '</p>' +
         '<p>' + u.email + '</p></div>'
@@ -379,13 +425,13 @@ func (g *Gateway) frontendHandler(w http.ResponseWriter, r *http.Request) {
     }
 
     async function loadPredictions() {
-      const data = await apiCall('/api/predictions');
+      const predictions = await apiCallAll('/api/predictions', 'predictions');
       const el = document.getElementById('predictionsContainer');
-      if (!data || !data.predictions || data.predictions.length === 0) {
+      if (!predictions || predictions.length === 0) {
         el.innerHTML = '<div class="empty">No hay predicciones</div>';
         return;
       }
-      el.innerHTML = data.predictions.slice(0, 10).map(p =>
+      el.innerHTML = predictions.map(p =>
         '<div class="pred-card"><h3>Juego ' + p.game_id + '</h3>' +
         '<p>Usuario: <strong>' + p.user_id + '</strong></p>' +
         '<p>Predicción: <strong>' + p.predicted_winner_id + '</strong></p>' +
@@ -421,13 +467,58 @@ func (g *Gateway) frontendHandler(w http.ResponseWriter, r *http.Request) {
       ]);
     }
 
+    // Una ráfaga de eventos (por ejemplo, al calificar un juego) dispara una sola recarga
+    let refreshTimer = null;
+    function scheduleRefresh() {
+      clearTimeout(refreshTimer);
+      refreshTimer = setTimeout(() => Promise.all([loadLeaderboard(), loadPredictions(), loadStats()]), 1000);
+    }
+
+    function scheduleRenderGames() {
+      if (renderPending) return;
+      renderPending = true;
+      requestAnimationFrame(() => {
+        renderPending = false;
+        renderGames();
+      });
+    }
+
+    // Actualizaciones en vivo por Server-Sent Events. EventSource se reconecta
+    // solo y envía Last-Event-ID, así el gateway retoma sin perder cambios.
+    function connectStream() {
+      const statusEl = document.getElementById('healthStatus');
+      const source = new EventSource(API_BASE + '/api/stream/public');
+      source.onopen = () => {
+        statusEl.classList.remove('status-err');
+        statusEl.classList.add('status-ok');
+        statusEl.textContent = '✅ En vivo';
+      };
+      source.onerror = () => {
+        statusEl.classList.remove('status-ok');
+        statusEl.classList.add('status-err');
+        statusEl.textContent = '⏳ Reconectando...';
+      };
+      source.addEventListener('game', e => {
+        const data = JSON.parse(e.data);
+        games.set(data.game.id, data.game);
+        scheduleRenderGames();
+      });
+      source.addEventListener('rank', e => {
+        if (!JSON.parse(e.data).snapshot) scheduleRefresh();
+      });
+      source.addEventListener('graded', scheduleRefresh);
+    }
+
+    async function start() {
+      await init();
+      connectStream();
+    }
+
     if (document.readyState === 'loading') {
-      document.addEventListener('DOMContentLoaded', init);
+      document.addEventListener('DOMContentLoaded', start);
     } else {
-      init();
+      start();
     }
-
-    setInterval(init, 30000);
   </script>
 </body>
 </html>`
@@ -437,283 +528,30 @@ func (g *Gateway) frontendHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(html))
 }
 
-func (g *Gateway) usersHandler(w http.ResponseWriter, r *http.Request) {
-	switch r.Method {
-	case "GET":
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
-		resp, err := g.userClient.GetAllUsers(ctx, &pb.GetAllUsersRequest{
-			Page:     1,
-			PageSize: 100,
-		})
-		if err != nil {
-			log.Printf("Error calling user service: %v", err)
-			http.Error(w, "Error calling user service", http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"users": resp.Users,
-			"total": resp.Total,
-		})
-
-	case "POST":
-		var reqBody struct {
-			Username string `json:"username"`
-			Email    string `json:"email"`
-			FullName string `json:"fullName"`
-		}
-
-		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
-			return
-		}
-
-		reqBody.Username = strings.TrimSpace(reqBody.Username)
-		reqBody.Email = strings.TrimSpace(reqBody.Email)
-		reqBody.FullName = strings.TrimSpace(reqBody.FullName)
-
-		if reqBody.Username == "" || reqBody.Email == "" {
-			http.Error(w, "username and email are required", http.StatusBadRequest)
-			return
-		}
-
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
-		resp, err := g.userClient.CreateUser(ctx, &pb.CreateUserRequest{
-			Username: reqBody.Username,
-			Email:    reqBody.Email,
-			FullName: reqBody.FullName,
-		})
-		if err != nil {
-			log.Printf("Error creating user: %v", err)
-			http.Error(w, "Error creating user", http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"user":    resp.User,
-			"message": resp.Message,
-		})
-
-	default:
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-	}
-}
-
-func (g *Gateway) predictionsHandler(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if r.Method == "GET" {
-		// Llamar al Prediction Service via gRPC
-		resp, err := g.predictionClient.GetAllPredictions(ctx, &pb.GetAllPredictionsRequest{})
-		if err != nil {
-			log.Printf("Error calling prediction service: %v", err)
-			http.Error(w, "Error calling prediction service", http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"predictions": resp.Predictions,
-			"total":       resp.Total,
-		})
-
-	} else if r.Method == "POST" {
-		// Leer el body del request
-		var reqBody struct {
-			UserID          string `json:"userId"`
-			GameID          string `json:"gameId"`
-			PredictedWinner string `json:"predictedWinner"`
-		}
-
-		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-			http.Error(w, "Invalid request body", http.StatusBadRequest)
-			return
-		}
-
-		// Crear predicción via gRPC
-		resp, err := g.predictionClient.CreatePrediction(ctx, &pb.CreatePredictionRequest{
-			UserId:            reqBody.UserID,
-			GameId:            reqBody.GameID,
-			PredictedWinnerId: reqBody.PredictedWinner,
-		})
-		if err != nil {
-			log.Printf("Error creating prediction: %v", err)
-			http.Error(w, "Error creating prediction", http.StatusInternalServerError)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusCreated)
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"prediction": resp.Prediction,
-			"message":    resp.Message,
-		})
-
-	} else {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-	}
-}
-
-func (g *Gateway) userPredictionsHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "GET" {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Extraer userID de la URL
-	userID := strings.TrimPrefix(r.URL.Path, "/api/predictions/user/")
-	if userID == "" {
-		http.Error(w, "User ID is required", http.StatusBadRequest)
-		return
-	}
-
-	// Llamar al Prediction Service via gRPC
-	resp, err := g.predictionClient.GetUserPredictions(ctx, &pb.GetUserPredictionsRequest{
-		UserId: userID,
-	})
-	if err != nil {
-		log.Printf("Error getting user predictions: %v", err)
-		http.Error(w, "Error getting user predictions", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"predictions": resp.Predictions,
-		"userId":      resp.UserId,
-		"total":       resp.Total,
-	})
-}
-
-func (g *Gateway) leaderboardHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "GET" {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Llamar al Leaderboard Service via gRPC
-	resp, err := g.leaderboardClient.GetLeaderboard(ctx, &pb.GetLeaderboardRequest{})
-	if err != nil {
-		log.Printf("Error getting leaderboard: %v", err)
-		http.Error(w, "Error getting leaderboard", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"leaderboard":   resp.Leaderboard,
-		"totalUsers":    resp.TotalUsers,
-		"gamesFinished": resp.GamesFinished,
-	})
-}
-
-func (g *Gateway) userStatsHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != "GET" {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Extraer userID de la URL
-	userID := strings.TrimPrefix(r.URL.Path, "/api/user-stats/")
-	if userID == "" {
-		http.Error(w, "User ID is required", http.StatusBadRequest)
-		return
-	}
+// ========================================
+// Helper Functions - Gateway
+// ========================================
 
-	// Llamar al Leaderboard Service via gRPC
-	resp, err := g.leaderboardClient.GetUserStats(ctx, &pb.GetUserStatsRequest{
-		UserId: userID,
-	})
-	if err != nil {
-		log.Printf("Error getting user stats: %v", err)
-		http.Error(w, "Error getting user stats", http.StatusInternalServerError)
-		return
+// writeRPCError responde el error de una RPC con el ErrorResponse y el status
+// HTTP de su código gRPC; los errores internos se registran sin exponerlos
+func writeRPCError(w http.ResponseWriter, err error, service string) {
+	resp := shared.NewErrorResponse(status.Convert(err))
+	if resp.Code >= http.StatusInternalServerError {
+		log.Printf("Error calling %s: %v", service, err)
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"userStats":   resp.UserStats,
-		"predictions": resp.Predictions,
-	})
+	writeErrorResponse(w, resp)
 }
 
-func (g *Gateway) teamsHandler(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Llamar al Game Service via gRPC
-	resp, err := g.gameClient.GetAllTeams(ctx, &pb.GetAllTeamsRequest{})
-	if err != nil {
-		log.Printf("Error getting teams: %v", err)
-		http.Error(w, "Error getting teams", http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"teams": resp.Teams,
-		"total": resp.Total,
-	})
+// writeError responde un error del propio gateway con el mismo formato
+func writeError(w http.ResponseWriter, code codes.Code, message string) {
+	writeErrorResponse(w, shared.NewErrorResponse(status.New(code, message)))
 }
 
-func (g *Gateway) gamesHandler(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	// Determine if the request is for a single game (path: /api/games/{id})
-	// or for the list (/api/games or /api/games/)
-	raw := strings.TrimPrefix(r.URL.Path, "/api/games")
-	id := strings.Trim(raw, "/")
-
-	if id != "" {
-		// Single game lookup
-		if r.Method != "GET" {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
-
-		resp, err := g.gameClient.GetGameByID(ctx, &pb.GetGameByIDRequest{GameId: id})
-		if err != nil {
-			log.Printf("Error getting game by id '%s': %v", id, err)
-			http.Error(w, "Game not found or error calling game service", http.StatusNotFound)
-			return
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(map[string]interface{}{
-			"game": resp.Game,
-		})
-		return
+func writeErrorResponse(w http.ResponseWriter, resp shared.ErrorResponse) {
+	if resp.Code == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", "Bearer")
 	}
-
-	// No ID provided: return all games
-	resp, err := g.gameClient.GetAllGames(ctx, &pb.GetAllGamesRequest{})
-	if err != nil {
-		log.Printf("Error getting games: %v", err)
-		http.Error(w, "Error getting games", http.StatusInternalServerError)
-		return
-	}
-
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"games": resp.Games,
-		"total": resp.Total,
-	})
+	w.WriteHeader(resp.Code)
+	json.NewEncoder(w).Encode(resp)
 }
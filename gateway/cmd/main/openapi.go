@@ -0,0 +1,438 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	pb "kickoff.com/proto"
+)
+
+// apiFiles son los protos cuyas anotaciones google.api.http forman la API REST
+var apiFiles = []protoreflect.FileDescriptor{
+	pb.File_proto_user_service_proto,
+	pb.File_proto_game_service_proto,
+	pb.File_proto_prediction_service_proto,
+	pb.File_proto_leaderboard_service_proto,
+}
+
+// pathParam encuentra las variables de una ruta ({user_id} o {name=**})
+var pathParam = regexp.MustCompile(`\{([a-z_.]+)(=[^}]*)?\}`)
+
+// openAPIDoc es un documento (o un fragmento) OpenAPI armado como JSON genérico
+type openAPIDoc = map[string]interface{}
+
+// openAPIBuilder recorre los servicios y junta las rutas y los schemas que
+// referencian, con los mismos nombres de campo y enums que usa restMarshaler
+type openAPIBuilder struct {
+	paths   map[string]openAPIDoc
+	schemas map[string]interface{}
+}
+
+// ========================================
+// OpenAPI
+// ========================================
+
+// buildOpenAPI arma la especificación OpenAPI 3 de la API REST a partir de los
+// descriptores de los protos: una operación por cada binding google.api.http
+// (incluidos los additional_bindings) más las rutas que el gateway atiende a
+// mano. Se arma una sola vez al iniciar; no hay un archivo generado que
+// mantener al día.
+func buildOpenAPI() ([]byte, error) {
+	b := &openAPIBuilder{
+		paths:   map[string]openAPIDoc{},
+		schemas: map[string]interface{}{},
+	}
+
+	for _, file := range apiFiles {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				b.addMethod(methods.Get(j))
+			}
+		}
+	}
+	b.addGatewayRoutes()
+
+	b.schemas["ErrorResponse"] = openAPIDoc{
+		"type":        "object",
+		"description": "Error de la API (shared.ErrorResponse)",
+		"properties": openAPIDoc{
+			"error":   openAPIDoc{"type": "string", "description": "Código gRPC por nombre", "example": "INVALID_ARGUMENT"},
+			"message": openAPIDoc{"type": "string"},
+			"code":    openAPIDoc{"type": "integer", "format": "int32", "description": "Status HTTP de la respuesta"},
+			"details": openAPIDoc{
+				"type":        "array",
+				"description": "Campos inválidos del request",
+				"items": openAPIDoc{
+					"type": "object",
+					"properties": openAPIDoc{
+						"field":       openAPIDoc{"type": "string"},
+						"description": openAPIDoc{"type": "string"},
+					},
+				},
+			},
+		},
+		"required": []interface{}{"error", "message", "code"},
+	}
+
+	doc := openAPIDoc{
+		"openapi": "3.0.3",
+		"info": openAPIDoc{
+			"title":       "Kickoff API",
+			"version":     "1.0.0",
+			"description": "API REST del gateway de Kickoff. Cada ruta transcodifica una RPC de los servicios; los campos usan los nombres del proto, los enums van por nombre y los int64 como string.",
+		},
+		"servers": []interface{}{openAPIDoc{"url": "/"}},
+		// El token es opcional en general; los servicios lo exigen donde corresponde
+		"security": []interface{}{openAPIDoc{}, openAPIDoc{"bearerAuth": []interface{}{}}},
+		"paths":    b.paths,
+		"components": openAPIDoc{
+			"schemas": b.schemas,
+			"responses": openAPIDoc{
+				"Error": openAPIDoc{
+					"description": "Error (400 argumento inválido, 401 sin token, 403 sin permiso, 404 no encontrado, 409 conflicto, 429 límite, 5xx error interno o servicio no disponible)",
+					"content":     openAPIDoc{"application/json": openAPIDoc{"schema": schemaRef("ErrorResponse")}},
+				},
+			},
+			"securitySchemes": openAPIDoc{
+				"bearerAuth": openAPIDoc{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// addMethod agrega las operaciones de una RPC anotada; las que no tienen
+// google.api.http (los streams) no son parte de la API generada
+func (b *openAPIBuilder) addMethod(method protoreflect.MethodDescriptor) {
+	rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return
+	}
+
+	operationID := fmt.Sprintf("%s_%s", method.Parent().Name(), method.Name())
+	b.addBinding(method, rule, operationID)
+	for i, binding := range rule.GetAdditionalBindings() {
+		b.addBinding(method, binding, fmt.Sprintf("%s_%d", operationID, i+1))
+	}
+}
+
+func (b *openAPIBuilder) addBinding(method protoreflect.MethodDescriptor, rule *annotations.HttpRule, operationID string) {
+	var verb, template string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		verb, template = "get", pattern.Get
+	case *annotations.HttpRule_Post:
+		verb, template = "post", pattern.Post
+	case *annotations.HttpRule_Put:
+		verb, template = "put", pattern.Put
+	case *annotations.HttpRule_Patch:
+		verb, template = "patch", pattern.Patch
+	case *annotations.HttpRule_Delete:
+		verb, template = "delete", pattern.Delete
+	default:
+		return
+	}
+
+	input := method.Input()
+	var parameters []interface{}
+	inPath := map[string]bool{}
+	for _, match := range pathParam.FindAllStringSubmatch(template, -1) {
+		inPath[match[1]] = true
+		parameters = append(parameters, openAPIDoc{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   b.fieldSchema(input.Fields().ByName(protoreflect.Name(match[1]))),
+		})
+	}
+	path := pathParam.ReplaceAllString(template, "{$1}")
+
+	operation := openAPIDoc{
+		"operationId": operationID,
+		"tags":        []interface{}{string(method.Parent().Name())},
+		"summary":     string(method.Name()),
+		"responses": openAPIDoc{
+			"200": openAPIDoc{
+				"description": "OK",
+				"content":     openAPIDoc{"application/json": openAPIDoc{"schema": b.messageSchema(method.Output())}},
+			},
+			"default": openAPIDoc{"$ref": "#/components/responses/Error"},
+		},
+	}
+
+	switch body := rule.GetBody(); body {
+	case "":
+		// Sin body, los campos escalares que no van en la ruta son query params,
+		// igual que los Timestamp y Duration, que el gateway lee de su forma en texto
+		fields := input.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if inPath[string(field.Name())] || field.IsMap() || !queryParamKind(field) {
+				continue
+			}
+			parameter := openAPIDoc{
+				"name":   string(field.Name()),
+				"in":     "query",
+				"schema": b.fieldSchema(field),
+			}
+			if field.Options().(*descriptorpb.FieldOptions).GetDeprecated() {
+				parameter["deprecated"] = true
+			}
+			parameters = append(parameters, parameter)
+		}
+	case "*":
+		operation["requestBody"] = openAPIDoc{
+			"required": true,
+			"content":  openAPIDoc{"application/json": openAPIDoc{"schema": b.messageSchema(input)}},
+		}
+	default:
+		operation["requestBody"] = openAPIDoc{
+			"required": true,
+			"content":  openAPIDoc{"application/json": openAPIDoc{"schema": b.fieldSchema(input.Fields().ByName(protoreflect.Name(body)))}},
+		}
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+
+	b.addOperation(path, verb, operation)
+}
+
+func (b *openAPIBuilder) addOperation(path, verb string, operation openAPIDoc) {
+	if b.paths[path] == nil {
+		b.paths[path] = openAPIDoc{}
+	}
+	b.paths[path][verb] = operation
+}
+
+// addGatewayRoutes documenta las rutas que el gateway atiende a mano
+func (b *openAPIBuilder) addGatewayRoutes() {
+	errorResponse := openAPIDoc{"$ref": "#/components/responses/Error"}
+	eventStream := openAPIDoc{
+		"description": "Server-Sent Events (o WebSocket con Upgrade). Cada evento trae en data el mensaje codificado igual que las respuestas REST.",
+		"content":     openAPIDoc{"text/event-stream": openAPIDoc{"schema": openAPIDoc{"type": "string"}}},
+	}
+	cursor := openAPIDoc{
+		"name":        "since",
+		"in":          "query",
+		"description": "Cursor del último evento recibido (o header Last-Event-ID)",
+		"schema":      openAPIDoc{"type": "string"},
+	}
+	accessToken := openAPIDoc{
+		"name":        "access_token",
+		"in":          "query",
+		"description": "Access token, para clientes que no pueden mandar el header Authorization",
+		"schema":      openAPIDoc{"type": "string"},
+	}
+
+	b.addOperation("/health", "get", openAPIDoc{
+		"operationId": "Gateway_Health",
+		"tags":        []interface{}{"Gateway"},
+		"summary":     "Estado del gateway",
+		"responses":   openAPIDoc{"200": openAPIDoc{"description": "OK"}},
+	})
+	b.addOperation("/api/auth/me", "get", openAPIDoc{
+		"operationId": "Gateway_Me",
+		"tags":        []interface{}{"Gateway"},
+		"summary":     "Usuario dueño del access token",
+		"security":    []interface{}{openAPIDoc{"bearerAuth": []interface{}{}}},
+		"responses": openAPIDoc{
+			"200": openAPIDoc{
+				"description": "OK",
+				"content":     openAPIDoc{"application/json": openAPIDoc{"schema": b.messageSchema((&pb.GetUserByIDResponse{}).ProtoReflect().Descriptor())}},
+			},
+			"default": errorResponse,
+		},
+	})
+	b.addOperation("/api/stream", "get", openAPIDoc{
+		"operationId": "Gateway_Stream",
+		"tags":        []interface{}{"Gateway"},
+		"summary":     "Marcadores en vivo, calificaciones propias y cambios de posición (eventos game, graded y rank)",
+		"security":    []interface{}{openAPIDoc{"bearerAuth": []interface{}{}}},
+		"parameters":  []interface{}{cursor, accessToken},
+		"responses":   openAPIDoc{"200": eventStream, "default": errorResponse},
+	})
+	b.addOperation("/api/stream/public", "get", openAPIDoc{
+		"operationId": "Gateway_PublicStream",
+		"tags":        []interface{}{"Gateway"},
+		"summary":     "Marcadores en vivo y cambios de posición, sin usuario (eventos game y rank)",
+		"parameters":  []interface{}{cursor},
+		"responses":   openAPIDoc{"200": eventStream, "default": errorResponse},
+	})
+	b.addOperation("/api/games/{id}/stream", "get", openAPIDoc{
+		"operationId": "Gateway_GameStream",
+		"tags":        []interface{}{"Gateway"},
+		"summary":     "Cambios de un juego (eventos game)",
+		"parameters": []interface{}{
+			openAPIDoc{"name": "id", "in": "path", "required": true, "schema": openAPIDoc{"type": "string"}},
+			cursor,
+		},
+		"responses": openAPIDoc{"200": eventStream, "default": errorResponse},
+	})
+
+	// Los schemas de los eventos no salen de ninguna RPC unaria
+	b.messageSchema((&pb.GameUpdate{}).ProtoReflect().Descriptor())
+	b.messageSchema((&pb.LeaderboardUpdate{}).ProtoReflect().Descriptor())
+}
+
+// queryParamKind indica si un campo se puede pasar como query param
+func queryParamKind(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		name := field.Message().FullName()
+		return name == "google.protobuf.Timestamp" || name == "google.protobuf.Duration"
+	default:
+		return true
+	}
+}
+
+// messageSchema devuelve la referencia al schema de un mensaje, agregándolo a
+// components la primera vez. Los tipos conocidos de protobuf se escriben
+// inline con su forma en JSON.
+func (b *openAPIBuilder) messageSchema(message protoreflect.MessageDescriptor) openAPIDoc {
+	switch message.FullName() {
+	case "google.protobuf.Timestamp":
+		return openAPIDoc{"type": "string", "format": "date-time", "nullable": true}
+	case "google.protobuf.Duration":
+		return openAPIDoc{"type": "string", "example": "1.5s", "nullable": true}
+	case "google.protobuf.Empty":
+		return openAPIDoc{"type": "object"}
+	}
+
+	name := schemaName(message)
+	if _, ok := b.schemas[name]; ok {
+		return schemaRef(name)
+	}
+	// Se reserva el nombre antes de recorrer los campos por los mensajes recursivos
+	b.schemas[name] = nil
+
+	properties := openAPIDoc{}
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[string(field.Name())] = b.fieldSchema(field)
+	}
+	b.schemas[name] = openAPIDoc{"type": "object", "properties": properties}
+	return schemaRef(name)
+}
+
+// fieldSchema devuelve el schema de un campo según su forma en protojson
+func (b *openAPIBuilder) fieldSchema(field protoreflect.FieldDescriptor) openAPIDoc {
+	if field == nil {
+		return openAPIDoc{"type": "string"}
+	}
+	if field.IsMap() {
+		return openAPIDoc{"type": "object", "additionalProperties": b.valueSchema(field.MapValue())}
+	}
+	schema := b.valueSchema(field)
+	if field.IsList() {
+		return openAPIDoc{"type": "array", "items": schema}
+	}
+	if field.HasOptionalKeyword() {
+		schema["nullable"] = true
+	}
+	return schema
+}
+
+func (b *openAPIBuilder) valueSchema(field protoreflect.FieldDescriptor) openAPIDoc {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return openAPIDoc{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return openAPIDoc{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return openAPIDoc{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return openAPIDoc{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return openAPIDoc{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return openAPIDoc{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return openAPIDoc{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return openAPIDoc{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		return b.enumSchema(field.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.messageSchema(field.Message())
+	default:
+		return openAPIDoc{"type": "string"}
+	}
+}
+
+// enumSchema agrega un enum a components con sus valores por nombre
+func (b *openAPIBuilder) enumSchema(enum protoreflect.EnumDescriptor) openAPIDoc {
+	name := schemaName(enum)
+	if _, ok := b.schemas[name]; !ok {
+		values := enum.Values()
+		names := make([]interface{}, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		b.schemas[name] = openAPIDoc{"type": "string", "enum": names}
+	}
+	return schemaRef(name)
+}
+
+// schemaName saca el paquete del proto: kickoff.Game queda como Game
+func schemaName(descriptor protoreflect.Descriptor) string {
+	return strings.TrimPrefix(string(descriptor.FullName()), string(descriptor.ParentFile().Package())+".")
+}
+
+func schemaRef(name string) openAPIDoc {
+	return openAPIDoc{"$ref": "#/components/schemas/" + name}
+}
+
+// ========================================
+// HTTP Handlers - Documentation
+// ========================================
+
+// openAPIHandler atiende GET /api/openapi.json con la especificación armada al iniciar
+func (g *Gateway) openAPIHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(g.openAPI)
+}
+
+// docsHandler atiende GET /api/docs: Swagger UI sobre /api/openapi.json
+func (g *Gateway) docsHandler(w http.ResponseWriter, r *http.Request) {
+	html := `<!DOCTYPE html>
+<html lang="es">
+<head>
+  <meta charset="UTF-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <title>Kickoff API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({
+        url: '/api/openapi.json',
+        dom_id: '#swagger-ui',
+        deepLinking: true,
+        persistAuthorization: true
+      });
+    };
+  </script>
+</body>
+</html>`
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(html))
+}
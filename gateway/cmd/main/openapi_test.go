@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+)
+
+// loadOpenAPI arma la especificación y la decodifica como JSON genérico
+func loadOpenAPI(t *testing.T) map[string]interface{} {
+	t.Helper()
+	raw, err := buildOpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+// lookup recorre el documento por claves; devuelve nil si alguna no existe
+func lookup(doc interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		doc = m[key]
+	}
+	return doc
+}
+
+func TestOpenAPICoversEveryBinding(t *testing.T) {
+	doc := loadOpenAPI(t)
+	if doc["openapi"] != "3.0.3" {
+		t.Errorf("openapi = %v, want 3.0.3", doc["openapi"])
+	}
+
+	// Cada binding google.api.http, incluidos los adicionales, es una operación
+	bindings := 0
+	for _, file := range apiFiles {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				rule, _ := proto.GetExtension(methods.Get(j).Options(), annotations.E_Http).(*annotations.HttpRule)
+				if rule == nil {
+					continue
+				}
+				for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+					verb, path := httpRulePattern(r)
+					path = pathParam.ReplaceAllString(path, "{$1}")
+					if lookup(doc, "paths", path, verb) == nil {
+						t.Errorf("%s: missing %s %s", methods.Get(j).FullName(), verb, path)
+					}
+					bindings++
+				}
+			}
+		}
+	}
+	if bindings == 0 {
+		t.Fatal("no google.api.http bindings found")
+	}
+}
+
+func TestOpenAPIOperations(t *testing.T) {
+	doc := loadOpenAPI(t)
+
+	tests := []struct {
+		name string
+		keys []string
+		want interface{}
+	}{
+		{"operationId", []string{"paths", "/api/games/by-week/{week}", "get", "operationId"}, "GameService_GetGamesByWeek"},
+		{"body de un POST", []string{"paths", "/api/predictions", "post", "requestBody", "content", "application/json", "schema", "$ref"}, "#/components/schemas/CreatePredictionRequest"},
+		{"respuesta", []string{"paths", "/api/leaderboard/top", "get", "responses", "200", "content", "application/json", "schema", "$ref"}, "#/components/schemas/GetTopUsersResponse"},
+		{"binding adicional", []string{"paths", "/api/predictions/user/{user_id}", "get", "operationId"}, "PredictionService_GetUserPredictions_1"},
+		{"error", []string{"paths", "/api/games/{game_id}", "get", "responses", "default", "$ref"}, "#/components/responses/Error"},
+		{"cuerpo de error", []string{"components", "responses", "Error", "content", "application/json", "schema", "$ref"}, "#/components/schemas/ErrorResponse"},
+		{"nombre del proto", []string{"components", "schemas", "Game", "properties", "home_team_id", "type"}, "string"},
+		{"int64 como string", []string{"components", "schemas", "Game", "properties", "version", "type"}, "string"},
+		{"stream a mano", []string{"paths", "/api/stream/public", "get", "operationId"}, "Gateway_PublicStream"},
+		{"stream de un juego", []string{"paths", "/api/games/{id}/stream", "get", "operationId"}, "Gateway_GameStream"},
+	}
+	for _, tt := range tests {
+		if got := lookup(doc, tt.keys...); got != tt.want {
+			t.Errorf("%s: %s = %v, want %v", tt.name, strings.Join(tt.keys, "."), got, tt.want)
+		}
+	}
+
+	// El stream autenticado pide token; el público no
+	if lookup(doc, "paths", "/api/stream", "get", "security") == nil {
+		t.Error("/api/stream without security requirement")
+	}
+	if lookup(doc, "paths", "/api/stream/public", "get", "security") != nil {
+		t.Error("/api/stream/public should not require a token")
+	}
+
+	// Los enums van por nombre
+	values, _ := lookup(doc, "components", "schemas", "GameStatus", "enum").([]interface{})
+	found := false
+	for _, v := range values {
+		found = found || v == "GAME_STATUS_SCHEDULED"
+	}
+	if !found {
+		t.Errorf("GameStatus enum = %v, want GAME_STATUS_SCHEDULED among the values", values)
+	}
+
+	// Los campos del path no se repiten como query params
+	params, _ := lookup(doc, "paths", "/api/games/by-week/{week}", "get", "parameters").([]interface{})
+	for _, p := range params {
+		if lookup(p, "name") == "week" && lookup(p, "in") != "path" {
+			t.Errorf("week documented in %v, want path", lookup(p, "in"))
+		}
+	}
+
+	// Todo $ref apunta a un schema definido
+	schemas, _ := lookup(doc, "components", "schemas").(map[string]interface{})
+	raw, _ := json.Marshal(doc)
+	for _, match := range strings.Split(string(raw), `"#/components/schemas/`)[1:] {
+		name := match[:strings.Index(match, `"`)]
+		if schemas[name] == nil {
+			t.Errorf("$ref to undefined schema %s", name)
+		}
+	}
+}
+
+func TestOpenAPIHandlers(t *testing.T) {
+	raw, err := buildOpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &Gateway{openAPI: raw}
+
+	w := httptest.NewRecorder()
+	g.openAPIHandler(w, httptest.NewRequest("GET", "/api/openapi.json", nil))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/json" || w.Body.String() != string(raw) {
+		t.Errorf("openAPIHandler() = %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+
+	w = httptest.NewRecorder()
+	g.docsHandler(w, httptest.NewRequest("GET", "/api/docs", nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "/api/openapi.json") {
+		t.Errorf("docsHandler() = %d, want the Swagger UI page pointing to /api/openapi.json", w.Code)
+	}
+}
+
+// httpRulePattern devuelve el verbo y la ruta de un binding
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "get", pattern.Get
+	case *annotations.HttpRule_Post:
+		return "post", pattern.Post
+	case *annotations.HttpRule_Put:
+		return "put", pattern.Put
+	case *annotations.HttpRule_Patch:
+		return "patch", pattern.Patch
+	case *annotations.HttpRule_Delete:
+		return "delete", pattern.Delete
+	}
+	return "", ""
+}
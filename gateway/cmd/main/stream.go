@@ -0,0 +1,445 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "kickoff.com/proto"
+)
+
+const (
+	// streamHeartbeat mantiene abierta la conexión a través de proxies
+	streamHeartbeat = 25 * time.Second
+	// streamRetryDelay es la espera antes de reabrir un stream gRPC caído
+	streamRetryDelay = 2 * time.Second
+)
+
+// streamEvent es un mensaje para el navegador. Las versiones avanzan el
+// cursor de su stream de origen; un evento sin tipo solo avanza el cursor
+// (por ejemplo, la calificación de una predicción de otro usuario). Data es
+// el mensaje del servicio tal cual y se codifica igual que las respuestas REST.
+type streamEvent struct {
+	Type               string // "game", "graded" o "rank"
+	Data               proto.Message
+	GameVersion        int64
+	LeaderboardVersion int64
+}
+
+// streamSink escribe los eventos en la conexión del navegador (SSE o WebSocket)
+type streamSink interface {
+	Send(id, eventType string, data proto.Message) error
+	Heartbeat() error
+}
+
+// ========================================
+// HTTP Handlers - Live stream
+// ========================================
+
+// streamHandler atiende /api/stream: marcadores en vivo, calificaciones de
+// las predicciones del usuario y cambios de posición en el leaderboard.
+// Requiere un access token. Responde con Server-Sent Events o, si el request
+// pide un upgrade, por WebSocket. Cada evento lleva un id
+// "versiónJuegos:versiónLeaderboard" que el cliente devuelve en Last-Event-ID
+// (o en ?since=) para retomar.
+func (g *Gateway) streamHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := g.streamCaller(w, r)
+	if !ok {
+		return
+	}
+	g.serveStream(w, r, userID)
+}
+
+// publicStreamHandler atiende /api/stream/public: el mismo stream sin
+// usuario, solo con los datos públicos (juegos y cambios de posición). Lo
+// usan los frontends, que no inician sesión.
+func (g *Gateway) publicStreamHandler(w http.ResponseWriter, r *http.Request) {
+	g.serveStream(w, r, "")
+}
+
+// serveStream junta WatchGames y WatchLeaderboard; las calificaciones solo se
+// envían si hay usuario y son suyas
+func (g *Gateway) serveStream(w http.ResponseWriter, r *http.Request, userID string) {
+	cursor := r.Header.Get("Last-Event-ID")
+	if cursor == "" {
+		cursor = r.URL.Query().Get("since")
+	}
+	gameVersion, leaderboardVersion, err := parseStreamCursor(cursor)
+	if err != nil {
+		writeError(w, codes.InvalidArgument, "Invalid stream cursor")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	sink, closeSink, ok := openStream(w, r, cancel)
+	if !ok {
+		return
+	}
+	defer closeSink()
+
+	events := make(chan streamEvent, 64)
+	go g.relayGames(ctx, gameVersion, events)
+	go g.relayLeaderboard(ctx, leaderboardVersion, userID, events)
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := sink.Heartbeat(); err != nil {
+				return
+			}
+		case event := <-events:
+			if event.GameVersion > gameVersion {
+				gameVersion = event.GameVersion
+			}
+			if event.LeaderboardVersion > leaderboardVersion {
+				leaderboardVersion = event.LeaderboardVersion
+			}
+			if event.Type == "" {
+				continue
+			}
+			id := fmt.Sprintf("%d:%d", gameVersion, leaderboardVersion)
+			if err := sink.Send(id, event.Type, event.Data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// gameStreamHandler atiende GET /api/games/{id}/stream: los cambios de un
+// solo juego (marcador, estado, reloj) por SSE o WebSocket. El id de cada
+// evento es la versión del juego, que se retoma con Last-Event-ID o ?since=.
+func (g *Gateway) gameStreamHandler(w http.ResponseWriter, r *http.Request) {
+	gameID := r.PathValue("id")
+
+	cursor := r.Header.Get("Last-Event-ID")
+	if cursor == "" {
+		cursor = r.URL.Query().Get("since")
+	}
+	var since int64
+	if cursor != "" {
+		var err error
+		if since, err = strconv.ParseInt(cursor, 10, 64); err != nil || since < 0 {
+			writeError(w, codes.InvalidArgument, "Invalid stream cursor")
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Un juego inexistente se responde con 404 antes de abrir el stream
+	stream, err := g.gameClient.WatchGame(ctx, &pb.WatchGameRequest{GameId: gameID, SinceVersion: since})
+	var first *pb.GameUpdate
+	if err == nil {
+		first, err = stream.Recv()
+	}
+	if err != nil && status.Code(err) != codes.Unavailable {
+		writeRPCError(w, err, "game service")
+		return
+	}
+
+	sink, closeSink, ok := openStream(w, r, cancel)
+	if !ok {
+		return
+	}
+	defer closeSink()
+
+	updates := make(chan *pb.GameUpdate, 16)
+	go func() {
+		if first != nil {
+			since = first.Game.Version
+			if !deliverGame(ctx, updates, first) {
+				return
+			}
+		}
+		for {
+			for err == nil {
+				var update *pb.GameUpdate
+				if update, err = stream.Recv(); err != nil {
+					break
+				}
+				since = update.Game.Version
+				if !deliverGame(ctx, updates, update) {
+					return
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Game %s stream interrupted, reconnecting from version %d: %v", gameID, since, err)
+			if !waitRetry(ctx) {
+				return
+			}
+			stream, err = g.gameClient.WatchGame(ctx, &pb.WatchGameRequest{GameId: gameID, SinceVersion: since})
+		}
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := sink.Heartbeat(); err != nil {
+				return
+			}
+		case update := <-updates:
+			if err := sink.Send(strconv.FormatInt(update.Game.Version, 10), "game", update); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ========================================
+// Helper Functions - Live stream
+// ========================================
+
+// openStream prepara la respuesta para un stream: hace el upgrade si el
+// request pide WebSocket o envía los headers de Server-Sent Events. cancel se
+// llama cuando el cliente WebSocket cierra la conexión; el cierre devuelto
+// libera la conexión tomada. Si falla ya respondió con el error.
+func openStream(w http.ResponseWriter, r *http.Request, cancel context.CancelFunc) (streamSink, func(), bool) {
+	if isWebSocketUpgrade(r) {
+		conn, err := upgradeWebSocket(w, r)
+		if err != nil {
+			log.Printf("Error upgrading stream to WebSocket: %v", err)
+			return nil, nil, false
+		}
+		go func() {
+			// El contexto del request no se cancela con una conexión tomada
+			conn.ReadLoop()
+			cancel()
+		}()
+		return wsSink{conn}, func() { conn.Close() }, true
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, codes.Unimplemented, "Streaming not supported")
+		return nil, nil, false
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	return sseSink{w, flusher}, func() {}, true
+}
+
+// streamCaller identifica al usuario del stream; sin un token válido responde
+// 401. EventSource y WebSocket no permiten enviar headers desde el navegador,
+// así que además del header Authorization se acepta el access token en
+// ?access_token=. El token se quita del request apenas se lee, para que no
+// llegue a ningún log con la URL.
+func (g *Gateway) streamCaller(w http.ResponseWriter, r *http.Request) (string, bool) {
+	query := r.URL.Query()
+	token := query.Get("access_token")
+	if token != "" {
+		query.Del("access_token")
+		r.URL.RawQuery = query.Encode()
+		r.RequestURI = r.URL.RequestURI()
+	}
+
+	if userID := callerID(r); userID != "" {
+		return userID, true
+	}
+	if token == "" {
+		unauthorized(w, "authentication required")
+		return "", false
+	}
+	claims, err := g.tokens.Verify(token, time.Now())
+	if err != nil {
+		unauthorized(w, err.Error())
+		return "", false
+	}
+	return claims.Subject, true
+}
+
+// relayGames reenvía WatchGames al navegador y lo reabre desde la última
+// versión recibida si se corta
+func (g *Gateway) relayGames(ctx context.Context, since int64, out chan<- streamEvent) {
+	for {
+		stream, err := g.gameClient.WatchGames(ctx, &pb.WatchGamesRequest{SinceVersion: since})
+		for err == nil {
+			var update *pb.GameUpdate
+			if update, err = stream.Recv(); err != nil {
+				break
+			}
+			if update.Game.Version > since {
+				since = update.Game.Version
+			}
+			event := streamEvent{
+				Type:        "game",
+				Data:        update,
+				GameVersion: update.Game.Version,
+			}
+			if !deliver(ctx, out, event) {
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Game stream interrupted, reconnecting from version %d: %v", since, err)
+		if !waitRetry(ctx) {
+			return
+		}
+	}
+}
+
+// relayLeaderboard reenvía WatchLeaderboard al navegador: los cambios de
+// posición a todos y cada predicción calificada solo a su dueño
+func (g *Gateway) relayLeaderboard(ctx context.Context, since int64, userID string, out chan<- streamEvent) {
+	for {
+		stream, err := g.leaderboardClient.WatchLeaderboard(ctx, &pb.WatchLeaderboardRequest{SinceVersion: since})
+		for err == nil {
+			var update *pb.LeaderboardUpdate
+			if update, err = stream.Recv(); err != nil {
+				break
+			}
+			if update.Version > since {
+				since = update.Version
+			}
+
+			event := streamEvent{LeaderboardVersion: update.Version}
+			switch update.Type {
+			case pb.LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_PREDICTION_GRADED:
+				if userID != "" && update.UserId == userID {
+					event.Type = "graded"
+					event.Data = update
+				}
+			case pb.LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_RANK_CHANGED:
+				event.Type = "rank"
+				event.Data = update
+			}
+			if !deliver(ctx, out, event) {
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Leaderboard stream interrupted, reconnecting from version %d: %v", since, err)
+		if !waitRetry(ctx) {
+			return
+		}
+	}
+}
+
+// parseStreamCursor lee un cursor "versiónJuegos:versiónLeaderboard"; vacío es 0:0
+func parseStreamCursor(cursor string) (int64, int64, error) {
+	if cursor == "" {
+		return 0, 0, nil
+	}
+	games, leaderboard, ok := strings.Cut(cursor, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("cursor %q must be <games>:<leaderboard>", cursor)
+	}
+	gameVersion, err := strconv.ParseInt(games, 10, 64)
+	if err != nil || gameVersion < 0 {
+		return 0, 0, fmt.Errorf("invalid game version in cursor %q", cursor)
+	}
+	leaderboardVersion, err := strconv.ParseInt(leaderboard, 10, 64)
+	if err != nil || leaderboardVersion < 0 {
+		return 0, 0, fmt.Errorf("invalid leaderboard version in cursor %q", cursor)
+	}
+	return gameVersion, leaderboardVersion, nil
+}
+
+func deliver(ctx context.Context, out chan<- streamEvent, event streamEvent) bool {
+	select {
+	case out <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func deliverGame(ctx context.Context, out chan<- *pb.GameUpdate, update *pb.GameUpdate) bool {
+	select {
+	case out <- update:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func waitRetry(ctx context.Context) bool {
+	timer := time.NewTimer(streamRetryDelay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// sseSink escribe eventos con el formato de Server-Sent Events
+type sseSink struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func (s sseSink) Send(id, eventType string, data proto.Message) error {
+	payload, err := restMarshaler.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "id: %s\nevent: %s\ndata: %s\n\n", id, eventType, payload); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s sseSink) Heartbeat() error {
+	if _, err := fmt.Fprint(s.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// wsSink envía cada evento como un mensaje JSON {"type", "id", "data"}
+type wsSink struct {
+	conn *wsConn
+}
+
+func (s wsSink) Send(id, eventType string, data proto.Message) error {
+	body, err := restMarshaler.Marshal(data)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"type": eventType,
+		"id":   id,
+		"data": json.RawMessage(body),
+	})
+	if err != nil {
+		return err
+	}
+	return s.conn.WriteText(payload)
+}
+
+func (s wsSink) Heartbeat() error {
+	return s.conn.Ping()
+}
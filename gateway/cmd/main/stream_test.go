@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"kickoff.com/pkg/auth"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestParseStreamCursor(t *testing.T) {
+	tests := []struct {
+		cursor          string
+		wantGames       int64
+		wantLeaderboard int64
+		wantErr         bool
+	}{
+		{"", 0, 0, false},
+		{"0:0", 0, 0, false},
+		{"12:7", 12, 7, false},
+		{"12", 0, 0, true},
+		{"12:", 0, 0, true},
+		{":7", 0, 0, true},
+		{"a:7", 0, 0, true},
+		{"12:b", 0, 0, true},
+		{"-1:7", 0, 0, true},
+		{"12:-7", 0, 0, true},
+		{"1:2:3", 0, 0, true},
+	}
+	for _, tt := range tests {
+		games, leaderboard, err := parseStreamCursor(tt.cursor)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseStreamCursor(%q) error = %v, wantErr %v", tt.cursor, err, tt.wantErr)
+			continue
+		}
+		if games != tt.wantGames || leaderboard != tt.wantLeaderboard {
+			t.Errorf("parseStreamCursor(%q) = %d, %d, want %d, %d", tt.cursor, games, leaderboard, tt.wantGames, tt.wantLeaderboard)
+		}
+	}
+}
+
+func TestStreamCaller(t *testing.T) {
+	signer, err := auth.NewSigner(testSecret, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, _ := signer.Issue("user-1", auth.RolePlayer, time.Now())
+	expired, _ := signer.Issue("user-1", auth.RolePlayer, time.Now().Add(-2*time.Hour))
+	g := &Gateway{tokens: signer}
+
+	tests := []struct {
+		name       string
+		target     string
+		wantUser   string
+		wantStatus int
+		wantQuery  string
+	}{
+		{"sin token", "/api/stream?since=1:2", "", http.StatusUnauthorized, "since=1:2"},
+		{"token inválido", "/api/stream?access_token=basura&since=1:2", "", http.StatusUnauthorized, "since=1%3A2"},
+		{"token vencido", "/api/stream?access_token=" + expired, "", http.StatusUnauthorized, ""},
+		{"token válido", "/api/stream?access_token=" + valid + "&since=1:2", "user-1", http.StatusOK, "since=1%3A2"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+		w := httptest.NewRecorder()
+		userID, ok := g.streamCaller(w, r)
+		if userID != tt.wantUser || ok != (tt.wantUser != "") {
+			t.Errorf("%s: streamCaller() = %q, %v, want %q", tt.name, userID, ok, tt.wantUser)
+		}
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.name, w.Code, tt.wantStatus)
+		}
+		// El token no debe quedar en la URL que ven los logs
+		if r.URL.Query().Has("access_token") {
+			t.Errorf("%s: access_token left in query %q", tt.name, r.URL.RawQuery)
+		}
+		if r.URL.RawQuery != tt.wantQuery {
+			t.Errorf("%s: RawQuery = %q, want %q", tt.name, r.URL.RawQuery, tt.wantQuery)
+		}
+		if want := r.URL.RequestURI(); r.RequestURI != want {
+			t.Errorf("%s: RequestURI = %q, want %q", tt.name, r.RequestURI, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+)
+
+const (
+	// restTimeout es el límite de un llamado REST
+	restTimeout = 5 * time.Second
+	// restLongTimeout es el límite de las RPCs que recorren la temporada entera
+	restLongTimeout = 60 * time.Second
+)
+
+// longRunningRoutes son las rutas que usan restLongTimeout
+var longRunningRoutes = map[string]bool{
+	"POST /api/games/import":            true,
+	"POST /api/leaderboard/recalculate": true,
+}
+
+// restMarshaler codifica los mensajes con los nombres de campo del proto
+// (snake_case) y los enums por nombre, incluidos los campos en cero. Es el
+// mismo formato para las respuestas REST y para los eventos del stream.
+var restMarshaler = &runtime.JSONPb{
+	MarshalOptions: protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	},
+}
+
+// ========================================
+// REST transcoding
+// ========================================
+
+// initRESTMux registra las rutas generadas desde las anotaciones
+// google.api.http de los protos sobre los clientes gRPC ya conectados. El
+// header Authorization se reenvía como metadata y cada servicio resuelve ahí
+// quién llama y con qué rol.
+func (g *Gateway) initRESTMux(ctx context.Context) error {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, restMarshaler),
+		runtime.WithErrorHandler(restErrorHandler),
+		runtime.WithRoutingErrorHandler(restRoutingErrorHandler),
+	)
+
+	if err := pb.RegisterUserServiceHandlerClient(ctx, mux, g.userClient); err != nil {
+		return fmt.Errorf("failed to register user service routes: %v", err)
+	}
+	if err := pb.RegisterGameServiceHandlerClient(ctx, mux, g.gameClient); err != nil {
+		return fmt.Errorf("failed to register game service routes: %v", err)
+	}
+	if err := pb.RegisterPredictionServiceHandlerClient(ctx, mux, g.predictionClient); err != nil {
+		return fmt.Errorf("failed to register prediction service routes: %v", err)
+	}
+	if err := pb.RegisterLeaderboardServiceHandlerClient(ctx, mux, g.leaderboardClient); err != nil {
+		return fmt.Errorf("failed to register leaderboard service routes: %v", err)
+	}
+
+	g.rest = mux
+	log.Println("✅ REST routes registered from google.api.http annotations")
+	return nil
+}
+
+// restHandler atiende las rutas generadas con el timeout que corresponde
+func (g *Gateway) restHandler(w http.ResponseWriter, r *http.Request) {
+	timeout := restTimeout
+	if longRunningRoutes[r.Method+" "+r.URL.Path] {
+		timeout = restLongTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+
+	g.rest.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// restErrorHandler responde los errores de las rutas generadas (de la RPC o
+// del request, como un body que no es JSON válido) con un ErrorResponse
+func restErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	service := "service"
+	if method, ok := runtime.RPCMethod(ctx); ok {
+		service = method
+	}
+	writeRPCError(w, err, service)
+}
+
+// restRoutingErrorHandler responde las rutas /api/ que no existen (404) o que
+// no aceptan el método (405) con el mismo formato
+func restRoutingErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	code := codes.NotFound
+	switch httpStatus {
+	case http.StatusMethodNotAllowed:
+		code = codes.Unimplemented
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	}
+
+	resp := shared.NewErrorResponse(status.New(code, http.StatusText(httpStatus)))
+	resp.Code = httpStatus
+	writeErrorResponse(w, resp)
+}
+
+// writeProto responde un mensaje con el mismo formato que las rutas generadas
+func writeProto(w http.ResponseWriter, code int, message proto.Message) {
+	payload, err := restMarshaler.Marshal(message)
+	if err != nil {
+		log.Printf("Error encoding response: %v", err)
+		writeError(w, codes.Internal, "Error encoding response")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(payload)
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+)
+
+// recorder guarda el último request que llegó a un cliente falso y el header
+// Authorization reenviado como metadata
+type recorder struct {
+	got  proto.Message
+	auth string
+}
+
+func (r *recorder) record(ctx context.Context, req proto.Message) {
+	r.got = req
+	r.auth = ""
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+		r.auth = md.Get("authorization")[0]
+	}
+}
+
+type fakeRESTGameClient struct {
+	pb.GameServiceClient
+	*recorder
+}
+
+func (c fakeRESTGameClient) GetGamesByWeek(ctx context.Context, req *pb.GetGamesByWeekRequest, _ ...grpc.CallOption) (*pb.GetGamesByWeekResponse, error) {
+	c.record(ctx, req)
+	game := &pb.Game{Id: "game_1", HomeTeamId: "KC", AwayTeamId: "BUF", Week: req.Week, Status: pb.GameStatus_GAME_STATUS_SCHEDULED}
+	return &pb.GetGamesByWeekResponse{Games: []*pb.Game{game}, Total: 1}, nil
+}
+
+func (c fakeRESTGameClient) GetTeamsByDivision(ctx context.Context, req *pb.GetTeamsByDivisionRequest, _ ...grpc.CallOption) (*pb.GetTeamsByDivisionResponse, error) {
+	c.record(ctx, req)
+	return &pb.GetTeamsByDivisionResponse{}, nil
+}
+
+type fakeRESTPredictionClient struct {
+	pb.PredictionServiceClient
+	*recorder
+}
+
+func (c fakeRESTPredictionClient) DeletePrediction(ctx context.Context, req *pb.DeletePredictionRequest, _ ...grpc.CallOption) (*pb.DeletePredictionResponse, error) {
+	c.record(ctx, req)
+	switch req.PredictionId {
+	case "missing":
+		return nil, status.Error(codes.NotFound, "prediction not found")
+	case "bad":
+		return nil, shared.InvalidField("prediction_id", "invalid prediction id")
+	case "crash":
+		return nil, status.Error(codes.Internal, "connection reset by peer")
+	}
+	return &pb.DeletePredictionResponse{Success: true}, nil
+}
+
+func (c fakeRESTPredictionClient) GetUserPredictions(ctx context.Context, req *pb.GetUserPredictionsRequest, _ ...grpc.CallOption) (*pb.GetUserPredictionsResponse, error) {
+	c.record(ctx, req)
+	return &pb.GetUserPredictionsResponse{}, nil
+}
+
+type fakeRESTLeaderboardClient struct {
+	pb.LeaderboardServiceClient
+	*recorder
+}
+
+func (c fakeRESTLeaderboardClient) GetTopUsers(ctx context.Context, req *pb.GetTopUsersRequest, _ ...grpc.CallOption) (*pb.GetTopUsersResponse, error) {
+	c.record(ctx, req)
+	return &pb.GetTopUsersResponse{}, nil
+}
+
+// newRESTGateway arma un Gateway con las rutas generadas sobre clientes falsos
+func newRESTGateway(t *testing.T) (*Gateway, *recorder) {
+	t.Helper()
+	rec := &recorder{}
+	g := &Gateway{
+		userClient:        pb.NewUserServiceClient(nil),
+		gameClient:        fakeRESTGameClient{recorder: rec},
+		predictionClient:  fakeRESTPredictionClient{recorder: rec},
+		leaderboardClient: fakeRESTLeaderboardClient{recorder: rec},
+	}
+	if err := g.initRESTMux(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return g, rec
+}
+
+func TestRESTRoutes(t *testing.T) {
+	g, rec := newRESTGateway(t)
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		auth     string
+		want     proto.Message
+		wantAuth string
+	}{
+		{"campo del path", "GET", "/api/games/by-week/3", "",
+			&pb.GetGamesByWeekRequest{Week: 3}, ""},
+		{"enum por nombre en el path", "GET", "/api/teams/by-division/DIVISION_NFC_WEST", "",
+			&pb.GetTeamsByDivisionRequest{Division: pb.Division_DIVISION_NFC_WEST}, ""},
+		{"DELETE con token", "DELETE", "/api/predictions/pred_1", "Bearer tok",
+			&pb.DeletePredictionRequest{PredictionId: "pred_1"}, "Bearer tok"},
+		{"binding adicional", "GET", "/api/predictions/user/user_1", "",
+			&pb.GetUserPredictionsRequest{UserId: "user_1"}, ""},
+		{"query con nombre del proto", "GET", "/api/leaderboard/top?top_n=5&season=2024", "",
+			&pb.GetTopUsersRequest{TopN: 5, Season: 2024}, ""},
+	}
+	for _, tt := range tests {
+		rec.got = nil
+		r := httptest.NewRequest(tt.method, tt.target, nil)
+		if tt.auth != "" {
+			r.Header.Set("Authorization", tt.auth)
+		}
+		w := httptest.NewRecorder()
+		g.restHandler(w, r)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: status = %d, want 200 (%s)", tt.name, w.Code, w.Body)
+			continue
+		}
+		if !proto.Equal(rec.got, tt.want) {
+			t.Errorf("%s: request = %v, want %v", tt.name, rec.got, tt.want)
+		}
+		if rec.auth != tt.wantAuth {
+			t.Errorf("%s: authorization = %q, want %q", tt.name, rec.auth, tt.wantAuth)
+		}
+	}
+}
+
+func TestRESTResponseEncoding(t *testing.T) {
+	g, _ := newRESTGateway(t)
+
+	w := httptest.NewRecorder()
+	g.restHandler(w, httptest.NewRequest("GET", "/api/games/by-week/3", nil))
+	body := w.Body.String()
+	// Nombres de campo del proto, enums por nombre y los campos en cero
+	for _, want := range []string{`"home_team_id":"KC"`, `"status":"GAME_STATUS_SCHEDULED"`, `"total":1`, `"home_score":0`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("body %s does not contain %s", body, want)
+		}
+	}
+
+}
+
+func TestRESTErrors(t *testing.T) {
+	g, _ := newRESTGateway(t)
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		want   shared.ErrorResponse
+	}{
+		{"no encontrado", "DELETE", "/api/predictions/missing",
+			shared.ErrorResponse{Error: "NOT_FOUND", Message: "prediction not found", Code: http.StatusNotFound}},
+		{"campo inválido", "DELETE", "/api/predictions/bad",
+			shared.ErrorResponse{Error: "INVALID_ARGUMENT", Message: "invalid prediction id", Code: http.StatusBadRequest,
+				Details: []shared.FieldViolation{{Field: "prediction_id", Description: "invalid prediction id"}}}},
+		{"error interno", "DELETE", "/api/predictions/crash",
+			shared.ErrorResponse{Error: "INTERNAL", Message: shared.ErrInternalServer.Error(), Code: http.StatusInternalServerError}},
+		{"ruta inexistente", "GET", "/api/nope",
+			shared.ErrorResponse{Error: "NOT_FOUND", Message: "Not Found", Code: http.StatusNotFound}},
+		{"método no soportado", "PATCH", "/api/games/by-week/3",
+			shared.ErrorResponse{Error: "UNIMPLEMENTED", Message: "Method Not Allowed", Code: http.StatusMethodNotAllowed}},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		g.restHandler(w, httptest.NewRequest(tt.method, tt.target, nil))
+		if w.Code != tt.want.Code || w.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("%s: status = %d %q, want %d application/json", tt.name, w.Code, w.Header().Get("Content-Type"), tt.want.Code)
+			continue
+		}
+		var got shared.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Errorf("%s: body %s: %v", tt.name, w.Body, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: body = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWriteErrorUnauthenticated(t *testing.T) {
+	w := httptest.NewRecorder()
+	writeError(w, codes.Unauthenticated, "missing token")
+	if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") != "Bearer" {
+		t.Errorf("writeError() = %d, WWW-Authenticate %q, want 401 with Bearer", w.Code, w.Header().Get("WWW-Authenticate"))
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+)
+
+// El protocolo (handshake, máscara, fragmentación, control frames y close)
+// queda a cargo de gorilla/websocket; acá solo se adapta la conexión al
+// stream, que es de salida: el cliente no debería mandar mensajes.
+
+const (
+	// wsMaxMessage limita lo que se acepta del cliente
+	wsMaxMessage = 64 * 1024
+	// wsWriteTimeout evita que un cliente que no lee bloquee el stream
+	wsWriteTimeout = 10 * time.Second
+)
+
+// wsAllowedOrigins son los orígenes de otros sitios que pueden abrir un
+// stream por WebSocket (STREAM_ALLOWED_ORIGINS, separados por comas)
+var wsAllowedOrigins = parseOrigins(os.Getenv("STREAM_ALLOWED_ORIGINS"))
+
+var wsUpgrader = websocket.Upgrader{
+	HandshakeTimeout: 10 * time.Second,
+	CheckOrigin:      sameOrigin,
+	Error: func(w http.ResponseWriter, r *http.Request, httpStatus int, reason error) {
+		code := codes.InvalidArgument
+		if httpStatus == http.StatusForbidden {
+			code = codes.PermissionDenied
+		}
+		writeError(w, code, reason.Error())
+	},
+}
+
+// wsConn es una conexión WebSocket del lado del servidor
+type wsConn struct {
+	conn *websocket.Conn
+}
+
+// isWebSocketUpgrade indica si el request pide cambiar a WebSocket
+func isWebSocketUpgrade(r *http.Request) bool {
+	return websocket.IsWebSocketUpgrade(r)
+}
+
+// upgradeWebSocket completa el handshake y toma la conexión. Si falla ya
+// respondió con el error HTTP correspondiente (403 para otro origen).
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetReadLimit(wsMaxMessage)
+	return &wsConn{conn: conn}, nil
+}
+
+// sameOrigin acepta el upgrade sin Origin (clientes que no son navegadores),
+// desde el mismo host que el gateway o desde un origen de wsAllowedOrigins.
+// Sin este control cualquier sitio podría abrir el stream de un usuario con
+// su token.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	return wsAllowedOrigins[strings.ToLower(u.Scheme+"://"+u.Host)]
+}
+
+func parseOrigins(value string) map[string]bool {
+	origins := make(map[string]bool)
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+		}
+	}
+	return origins
+}
+
+// WriteText envía un mensaje de texto
+func (c *wsConn) WriteText(data []byte) error {
+	c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// Ping envía un ping; el navegador responde solo y mantiene viva la conexión
+func (c *wsConn) Ping() error {
+	return c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+}
+
+// ReadLoop lee hasta que el cliente cierra la conexión o viola el protocolo.
+// Los ping y close los responde la librería; los mensajes se descartan.
+func (c *wsConn) ReadLoop() error {
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *wsConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	pb "kickoff.com/proto"
+)
+
+func TestParseOrigins(t *testing.T) {
+	got := parseOrigins(" https://Kickoff.example/ ,, http://localhost:3000")
+	want := []string{"https://kickoff.example", "http://localhost:3000"}
+	if len(got) != len(want) {
+		t.Fatalf("parseOrigins() = %v, want %v", got, want)
+	}
+	for _, origin := range want {
+		if !got[origin] {
+			t.Errorf("parseOrigins() missing %q: %v", origin, got)
+		}
+	}
+	if len(parseOrigins("")) != 0 {
+		t.Error("parseOrigins(\"\") should be empty")
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	defer func(previous map[string]bool) { wsAllowedOrigins = previous }(wsAllowedOrigins)
+	wsAllowedOrigins = parseOrigins("https://kickoff.example")
+
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{"", true},
+		{"http://gateway.local:8080", true},
+		{"https://GATEWAY.local:8080", true},
+		{"https://kickoff.example", true},
+		{"HTTPS://Kickoff.Example", true},
+		{"http://kickoff.example", false},
+		{"https://evil.example", false},
+		{"http://gateway.local", false},
+		{"://bad", false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "http://gateway.local:8080/api/stream", nil)
+		if tt.origin != "" {
+			r.Header.Set("Origin", tt.origin)
+		}
+		if got := sameOrigin(r); got != tt.want {
+			t.Errorf("sameOrigin(Origin %q) = %v, want %v", tt.origin, got, tt.want)
+		}
+	}
+}
+
+// wsTestServer hace el upgrade, envía un evento con wsSink y espera a que el
+// cliente cierre
+func wsTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWebSocketUpgrade(r) {
+			t.Error("request is not a WebSocket upgrade")
+		}
+		conn, err := upgradeWebSocket(w, r)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		update := &pb.GameUpdate{Game: &pb.Game{Id: "game-1", Week: 3}}
+		if err := (wsSink{conn}).Send("4:2", "game", update); err != nil {
+			t.Errorf("Send() error = %v", err)
+		}
+		if err := conn.Ping(); err != nil {
+			t.Errorf("Ping() error = %v", err)
+		}
+		conn.ReadLoop()
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWebSocketUpgrade(t *testing.T) {
+	server := wsTestServer(t)
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	tests := []struct {
+		name       string
+		origin     string
+		wantStatus int
+	}{
+		{"sin origin", "", http.StatusSwitchingProtocols},
+		{"mismo origen", server.URL, http.StatusSwitchingProtocols},
+		{"otro origen", "https://evil.example", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.origin != "" {
+			header.Set("Origin", tt.origin)
+		}
+		conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+		if resp == nil {
+			t.Fatalf("%s: Dial() error = %v", tt.name, err)
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.name, resp.StatusCode, tt.wantStatus)
+		}
+		if conn != nil {
+			conn.Close()
+		}
+	}
+}
+
+func TestWebSocketFraming(t *testing.T) {
+	server := wsTestServer(t)
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	pinged := make(chan struct{}, 1)
+	conn.SetPingHandler(func(string) error {
+		pinged <- struct{}{}
+		return nil
+	})
+
+	kind, payload, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kind != websocket.TextMessage {
+		t.Errorf("message type = %d, want text", kind)
+	}
+	var event struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+		Data struct {
+			Game struct {
+				ID   string `json:"id"`
+				Week int32  `json:"week"`
+			} `json:"game"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		t.Fatalf("payload %s: %v", payload, err)
+	}
+	if event.Type != "game" || event.ID != "4:2" || event.Data.Game.ID != "game-1" || event.Data.Game.Week != 3 {
+		t.Errorf("event = %+v, want game 4:2 for game-1 week 3", event)
+	}
+
+	// El ping es un control frame: lo procesa el próximo read
+	go conn.ReadMessage()
+	<-pinged
+}
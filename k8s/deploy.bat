@@ -16,6 +16,7 @@ kubectl apply -f k8s/base/namespace.yaml
 echo.
 echo Step 3: Creating ConfigMaps...
 kubectl apply -f k8s/config/configmap.yaml
+kubectl apply -f k8s/config/auth-secret.yaml
 
 echo.
 echo Step 4: Creating Services...
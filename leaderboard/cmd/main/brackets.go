@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Playoff Brackets
+// ========================================
+
+// GetBracketStandings devuelve la tabla de brackets de playoffs de una
+// temporada por puntos; a igual puntaje va primero quien todavía puede sumar más
+func (ls *LeaderboardService) GetBracketStandings(ctx context.Context, req *pb.GetBracketStandingsRequest) (*pb.GetBracketStandingsResponse, error) {
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", bracketOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.BracketStanding{}).Where("season = ?", req.Season)
+	if req.LeagueId != "" {
+		league, err := fetchLeagueForMember(ctx, database.DB, req.LeagueId)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("user_id IN (?)",
+			database.DB.Model(&models.LeagueMember{}).Select("user_id").Where("league_id = ?", league.ID))
+	}
+
+	standings, total, next, err := findRanked(rankedTable(database.DB, query, bracketRankOrder), page,
+		func(s rankedBracket) string { return s.UserID })
+	if err != nil {
+		log.Printf("Error fetching bracket standings: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch bracket standings: %v", err)
+	}
+
+	resp := &pb.GetBracketStandingsResponse{Season: req.Season, Total: int32(total), NextPageToken: next}
+	for _, standing := range standings {
+		resp.Standings = append(resp.Standings, &pb.BracketStanding{
+			UserId:    standing.UserID,
+			Season:    int32(standing.Season),
+			Points:    int32(standing.Points),
+			Correct:   int32(standing.Correct),
+			Incorrect: int32(standing.Incorrect),
+			MaxPoints: int32(standing.MaxPoints),
+			Rank:      int32(standing.Rank),
+		})
+	}
+
+	return resp, nil
+}
+
+// ========================================
+// Helper Functions - Playoff Brackets
+// ========================================
+
+// bracketRankOrder es el orden de la tabla: empatan en posición quienes
+// comparten puntos y puntos alcanzables
+const bracketRankOrder = "points DESC, max_points DESC"
+
+// rankedBracket es una fila de la tabla de brackets con su posición
+type rankedBracket struct {
+	models.BracketStanding
+	Rank int
+}
+
+var bracketOrders = rankOrders(func(s rankedBracket) int { return s.Rank })
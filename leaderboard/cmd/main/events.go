@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	"kickoff.com/pkg/events"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// Event Subscriptions
+// ========================================
+
+func (ls *LeaderboardService) subscribeEvents(ctx context.Context, transport events.Transport) {
+	events.Subscribe(ctx, transport, "leaderboard.stats", ls.handlePredictionGraded,
+		events.TypePredictionGraded)
+	events.Subscribe(ctx, transport, "leaderboard.survivor", ls.handleSurvivorEntryUpdated,
+		events.TypeSurvivorEntryUpdated)
+	events.Subscribe(ctx, transport, "leaderboard.brackets", ls.handleBracketScored,
+		events.TypeBracketScored)
+}
+
+// handlePredictionGraded aplica el resultado de una predicción a las estadísticas del usuario
+func (ls *LeaderboardService) handlePredictionGraded(ctx context.Context, event events.Event) error {
+	var payload events.PredictionGraded
+	if err := event.Decode(&payload); err != nil {
+		log.Printf("Skipping malformed event %s: %v", event.ID, err)
+		return nil
+	}
+	if payload.PredictionID == "" || payload.UserID == "" || !validResults[payload.Result] {
+		log.Printf("Skipping invalid PredictionGraded event %s", event.ID)
+		return nil
+	}
+
+	_, err := applyResults([]*pb.GradedPrediction{{
+		PredictionId:  payload.PredictionID,
+		UserId:        payload.UserID,
+		GameId:        payload.GameID,
+		Result:        payload.Result,
+		Points:        int32(payload.Points),
+		Season:        int32(payload.Season),
+		Week:          int32(payload.Week),
+		AgainstSpread: payload.AgainstSpread,
+		Push:          payload.Push,
+	}})
+	return err
+}
+
+// handleSurvivorEntryUpdated guarda el estado del usuario en el survivor pool.
+// El evento trae el estado completo, así que reaplicarlo no cambia nada.
+func (ls *LeaderboardService) handleSurvivorEntryUpdated(ctx context.Context, event events.Event) error {
+	var payload events.SurvivorEntryUpdated
+	if err := event.Decode(&payload); err != nil {
+		log.Printf("Skipping malformed event %s: %v", event.ID, err)
+		return nil
+	}
+	if payload.UserID == "" || payload.Season <= 0 {
+		log.Printf("Skipping invalid SurvivorEntryUpdated event %s", event.ID)
+		return nil
+	}
+
+	db := database.DB.WithContext(ctx)
+	if payload.Picks == 0 {
+		return db.Where("user_id = ? AND season = ?", payload.UserID, payload.Season).
+			Delete(&models.SurvivorStanding{}).Error
+	}
+
+	standing := models.SurvivorStanding{
+		UserID:         payload.UserID,
+		Season:         payload.Season,
+		Alive:          payload.Alive,
+		EliminatedWeek: payload.EliminatedWeek,
+		WeeksSurvived:  payload.WeeksSurvived,
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "season"}},
+		DoUpdates: clause.AssignmentColumns([]string{"alive", "eliminated_week", "weeks_survived", "updated_at"}),
+	}).Create(&standing).Error
+}
+
+// handleBracketScored guarda el puntaje del bracket de playoffs del usuario.
+// Igual que en el survivor, el evento trae el puntaje completo.
+func (ls *LeaderboardService) handleBracketScored(ctx context.Context, event events.Event) error {
+	var payload events.BracketScored
+	if err := event.Decode(&payload); err != nil {
+		log.Printf("Skipping malformed event %s: %v", event.ID, err)
+		return nil
+	}
+	if payload.UserID == "" || payload.Season <= 0 {
+		log.Printf("Skipping invalid BracketScored event %s", event.ID)
+		return nil
+	}
+
+	standing := models.BracketStanding{
+		UserID:    payload.UserID,
+		Season:    payload.Season,
+		Points:    payload.Points,
+		Correct:   payload.Correct,
+		Incorrect: payload.Incorrect,
+		MaxPoints: payload.MaxPoints,
+	}
+	return database.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "season"}},
+		DoUpdates: clause.AssignmentColumns([]string{"points", "correct", "incorrect", "max_points", "updated_at"}),
+	}).Create(&standing).Error
+}
@@ -0,0 +1,654 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	pb "kickoff.com/proto"
+)
+
+const (
+	maxLeagueNameLength = 100
+	inviteCodeLength    = 8
+)
+
+// inviteAlphabet omite caracteres que se confunden al dictar un código (0/O, 1/I/L)
+const inviteAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// leagueOrders es el orden de GetUserLeagues: por fecha de creación
+var leagueOrders = pagination.Orders[models.League]{
+	{Name: "created_at", Column: "leagues.created_at", Kind: pagination.Time, Value: func(l models.League) interface{} { return l.CreatedAt }, ID: "leagues.id"},
+}
+
+// inviteOrders es el orden de GetLeagueInvites: por fecha de creación
+var inviteOrders = pagination.Orders[models.LeagueInvite]{
+	{Name: "created_at", Column: "created_at", Kind: pagination.Time, Value: func(i models.LeagueInvite) interface{} { return i.CreatedAt }, ID: "code"},
+}
+
+// ========================================
+// gRPC Handlers - Leagues
+// ========================================
+
+func (ls *LeaderboardService) CreateLeague(ctx context.Context, req *pb.CreateLeagueRequest) (*pb.CreateLeagueResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, shared.InvalidField("name", "name is required")
+	}
+	if len(name) > maxLeagueNameLength {
+		return nil, shared.InvalidField("name", "name must be at most %d characters", maxLeagueNameLength)
+	}
+
+	league := models.League{
+		ID:      idgen.New(idgen.PrefixLeague),
+		Name:    name,
+		OwnerID: userID,
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&league).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.LeagueMember{
+			LeagueID: league.ID,
+			UserID:   userID,
+			Role:     models.LeagueRoleAdmin,
+		}).Error
+	})
+	if err != nil {
+		log.Printf("Error creating league: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create league: %v", err)
+	}
+
+	log.Printf("Created league: %s (%s) by %s", league.Name, league.ID, userID)
+
+	return &pb.CreateLeagueResponse{
+		League:  leagueToProto(league, 1),
+		Message: "League created successfully",
+	}, nil
+}
+
+func (ls *LeaderboardService) GetLeague(ctx context.Context, req *pb.GetLeagueRequest) (*pb.GetLeagueResponse, error) {
+	if req.LeagueId == "" {
+		return nil, shared.InvalidField("league_id", "league_id is required")
+	}
+
+	league, err := fetchLeagueForMember(ctx, database.DB, req.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []models.LeagueMember
+	if err := database.DB.Where("league_id = ?", league.ID).Order("joined_at").Find(&members).Error; err != nil {
+		log.Printf("Error fetching league members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch league members: %v", err)
+	}
+
+	resp := &pb.GetLeagueResponse{
+		League: leagueToProto(league, len(members)),
+	}
+	for _, member := range members {
+		resp.Members = append(resp.Members, memberToProto(member))
+	}
+	return resp, nil
+}
+
+func (ls *LeaderboardService) GetUserLeagues(ctx context.Context, req *pb.GetUserLeaguesRequest) (*pb.GetUserLeaguesResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", leagueOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.League{}).
+		Joins("JOIN league_members ON league_members.league_id = leagues.id").
+		Where("league_members.user_id = ?", userID)
+	leagues, total, next, err := pagination.Find(query, page, func(l models.League) string { return l.ID })
+	if err != nil {
+		log.Printf("Error fetching user leagues: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch leagues: %v", err)
+	}
+
+	var pbLeagues []*pb.League
+	for _, league := range leagues {
+		pbLeagues = append(pbLeagues, leagueToProto(league, countMembers(database.DB, league.ID)))
+	}
+
+	return &pb.GetUserLeaguesResponse{
+		Leagues:       pbLeagues,
+		Total:         int32(total),
+		NextPageToken: next,
+	}, nil
+}
+
+func (ls *LeaderboardService) CreateLeagueInvite(ctx context.Context, req *pb.CreateLeagueInviteRequest) (*pb.CreateLeagueInviteResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LeagueId == "" {
+		return nil, shared.InvalidField("league_id", "league_id is required")
+	}
+	if req.MaxUses < 0 || req.ExpiresInHours < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_uses and expires_in_hours cannot be negative")
+	}
+
+	if _, err := requireLeagueAdmin(ctx, database.DB, req.LeagueId, userID); err != nil {
+		return nil, err
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		log.Printf("Error generating invite code: %v", err)
+		return nil, status.Error(codes.Internal, "failed to generate invite code")
+	}
+
+	invite := models.LeagueInvite{
+		Code:      code,
+		LeagueID:  req.LeagueId,
+		CreatedBy: userID,
+		MaxUses:   int(req.MaxUses),
+	}
+	if req.ExpiresInHours > 0 {
+		expiresAt := time.Now().UTC().Add(time.Duration(req.ExpiresInHours) * time.Hour)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	if err := database.DB.Create(&invite).Error; err != nil {
+		log.Printf("Error creating invite: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create invite: %v", err)
+	}
+
+	log.Printf("Created invite %s for league %s", invite.Code, invite.LeagueID)
+
+	return &pb.CreateLeagueInviteResponse{
+		Invite: inviteToProto(invite),
+	}, nil
+}
+
+func (ls *LeaderboardService) GetLeagueInvites(ctx context.Context, req *pb.GetLeagueInvitesRequest) (*pb.GetLeagueInvitesResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LeagueId == "" {
+		return nil, shared.InvalidField("league_id", "league_id is required")
+	}
+
+	if _, err := requireLeagueAdmin(ctx, database.DB, req.LeagueId, userID); err != nil {
+		return nil, err
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", inviteOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.LeagueInvite{}).Where("league_id = ?", req.LeagueId)
+	invites, total, next, err := pagination.Find(query, page, func(i models.LeagueInvite) string { return i.Code })
+	if err != nil {
+		log.Printf("Error fetching invites: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch invites: %v", err)
+	}
+
+	resp := &pb.GetLeagueInvitesResponse{Total: int32(total), NextPageToken: next}
+	for _, invite := range invites {
+		resp.Invites = append(resp.Invites, inviteToProto(invite))
+	}
+	return resp, nil
+}
+
+func (ls *LeaderboardService) RevokeLeagueInvite(ctx context.Context, req *pb.RevokeLeagueInviteRequest) (*pb.RevokeLeagueInviteResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code == "" {
+		return nil, shared.InvalidField("code", "code is required")
+	}
+
+	var invite models.LeagueInvite
+	if err := database.DB.Where("code = ?", normalizeInviteCode(req.Code)).First(&invite).Error; err != nil ||
+		(req.LeagueId != "" && invite.LeagueID != req.LeagueId) {
+		return nil, status.Error(codes.NotFound, "Invite not found")
+	}
+
+	if _, err := requireLeagueAdmin(ctx, database.DB, invite.LeagueID, userID); err != nil {
+		return nil, err
+	}
+
+	if invite.RevokedAt == nil {
+		if err := database.DB.Model(&invite).Update("revoked_at", time.Now().UTC()).Error; err != nil {
+			log.Printf("Error revoking invite: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to revoke invite: %v", err)
+		}
+	}
+
+	return &pb.RevokeLeagueInviteResponse{
+		Success: true,
+		Message: "Invite revoked successfully",
+	}, nil
+}
+
+func (ls *LeaderboardService) JoinLeague(ctx context.Context, req *pb.JoinLeagueRequest) (*pb.JoinLeagueResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code == "" {
+		return nil, shared.InvalidField("code", "code is required")
+	}
+
+	var league models.League
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// El lock evita superar max_uses con uniones concurrentes
+		var invite models.LeagueInvite
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("code = ?", normalizeInviteCode(req.Code)).
+			First(&invite).Error; err != nil {
+			return status.Error(codes.NotFound, "Invite not found")
+		}
+		if !invite.Usable(time.Now()) {
+			return status.Error(codes.FailedPrecondition, "invite is expired, revoked or fully used")
+		}
+
+		var err error
+		if league, err = fetchLeague(tx, invite.LeagueID); err != nil {
+			return err
+		}
+
+		var existing models.LeagueMember
+		if tx.Where("league_id = ? AND user_id = ?", league.ID, userID).First(&existing).Error == nil {
+			return status.Error(codes.AlreadyExists, "user is already a member of this league")
+		}
+
+		if err := tx.Create(&models.LeagueMember{
+			LeagueID: league.ID,
+			UserID:   userID,
+			Role:     models.LeagueRoleMember,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&invite).Update("uses", gorm.Expr("uses + 1")).Error
+	})
+	if err != nil {
+		return nil, leagueTxError("join league", err)
+	}
+
+	log.Printf("User %s joined league %s", userID, league.ID)
+
+	return &pb.JoinLeagueResponse{
+		League:  leagueToProto(league, countMembers(database.DB, league.ID)),
+		Message: "Joined league successfully",
+	}, nil
+}
+
+func (ls *LeaderboardService) LeaveLeague(ctx context.Context, req *pb.LeaveLeagueRequest) (*pb.LeaveLeagueResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LeagueId == "" {
+		return nil, shared.InvalidField("league_id", "league_id is required")
+	}
+
+	deleted := false
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		league, err := fetchLeague(tx, req.LeagueId)
+		if err != nil {
+			return err
+		}
+
+		var member models.LeagueMember
+		if err := tx.Where("league_id = ? AND user_id = ?", league.ID, userID).First(&member).Error; err != nil {
+			return status.Error(codes.NotFound, "user is not a member of this league")
+		}
+
+		// El último miembro cierra la liga
+		if countMembers(tx, league.ID) == 1 {
+			deleted = true
+			if err := tx.Delete(&member).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.LeagueInvite{}).Where("league_id = ? AND revoked_at IS NULL", league.ID).
+				Update("revoked_at", time.Now().UTC()).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&league).Error
+		}
+
+		// Una liga con miembros siempre necesita al menos un admin
+		if member.Role == models.LeagueRoleAdmin && countAdmins(tx, league.ID) == 1 {
+			return status.Error(codes.FailedPrecondition, "promote another member to admin before leaving")
+		}
+
+		if err := tx.Delete(&member).Error; err != nil {
+			return err
+		}
+
+		// Si se va el dueño, la liga pasa al admin más antiguo
+		if league.OwnerID == userID {
+			var successor models.LeagueMember
+			if err := tx.Where("league_id = ? AND role = ?", league.ID, models.LeagueRoleAdmin).
+				Order("joined_at").First(&successor).Error; err != nil {
+				return err
+			}
+			return tx.Model(&league).Update("owner_id", successor.UserID).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, leagueTxError("leave league", err)
+	}
+
+	message := "Left league successfully"
+	if deleted {
+		message = "Left league successfully; the league was closed because it has no members"
+	}
+	log.Printf("User %s left league %s", userID, req.LeagueId)
+
+	return &pb.LeaveLeagueResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+func (ls *LeaderboardService) UpdateLeagueMember(ctx context.Context, req *pb.UpdateLeagueMemberRequest) (*pb.UpdateLeagueMemberResponse, error) {
+	actingUserID, err := auth.ActingUser(ctx, req.ActingUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LeagueId == "" || req.MemberUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "league_id and member_user_id are required")
+	}
+
+	role := models.LeagueRole(req.Role)
+	if role != models.LeagueRoleAdmin && role != models.LeagueRoleMember {
+		return nil, shared.InvalidField("role", "invalid role %q", req.Role)
+	}
+
+	var member models.LeagueMember
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := requireLeagueAdmin(ctx, tx, req.LeagueId, actingUserID); err != nil {
+			return err
+		}
+
+		if err := tx.Where("league_id = ? AND user_id = ?", req.LeagueId, req.MemberUserId).First(&member).Error; err != nil {
+			return status.Error(codes.NotFound, "user is not a member of this league")
+		}
+		if member.Role == role {
+			return nil
+		}
+		if member.Role == models.LeagueRoleAdmin && countAdmins(tx, req.LeagueId) == 1 {
+			return status.Error(codes.FailedPrecondition, "a league needs at least one admin")
+		}
+
+		member.Role = role
+		return tx.Model(&member).Update("role", role).Error
+	})
+	if err != nil {
+		return nil, leagueTxError("update league member", err)
+	}
+
+	log.Printf("User %s is now %s of league %s", member.UserID, member.Role, req.LeagueId)
+
+	return &pb.UpdateLeagueMemberResponse{
+		Member:  memberToProto(member),
+		Message: "League member updated successfully",
+	}, nil
+}
+
+func (ls *LeaderboardService) RemoveLeagueMember(ctx context.Context, req *pb.RemoveLeagueMemberRequest) (*pb.RemoveLeagueMemberResponse, error) {
+	actingUserID, err := auth.ActingUser(ctx, req.ActingUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LeagueId == "" || req.MemberUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "league_id and member_user_id are required")
+	}
+	if actingUserID == req.MemberUserId {
+		return nil, status.Error(codes.InvalidArgument, "use LeaveLeague to leave a league")
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		league, err := requireLeagueAdmin(ctx, tx, req.LeagueId, actingUserID)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Where("league_id = ? AND user_id = ?", league.ID, req.MemberUserId).Delete(&models.LeagueMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return status.Error(codes.NotFound, "user is not a member of this league")
+		}
+
+		// Quien la administra pasa a ser dueño si se expulsa al dueño
+		if league.OwnerID == req.MemberUserId {
+			return tx.Model(&league).Update("owner_id", actingUserID).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, leagueTxError("remove league member", err)
+	}
+
+	log.Printf("User %s removed %s from league %s", actingUserID, req.MemberUserId, req.LeagueId)
+
+	return &pb.RemoveLeagueMemberResponse{
+		Success: true,
+		Message: "League member removed successfully",
+	}, nil
+}
+
+func (ls *LeaderboardService) GetLeagueLeaderboard(ctx context.Context, req *pb.GetLeagueLeaderboardRequest) (*pb.GetLeagueLeaderboardResponse, error) {
+	if req.LeagueId == "" {
+		return nil, shared.InvalidField("league_id", "league_id is required")
+	}
+
+	sc, err := newScope(req.Season, req.Week)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := pagination.LegacyLimit(req.PageSize, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pagination.New(pageSize, req.PageToken, "", standingOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	league, err := fetchLeagueForMember(ctx, database.DB, req.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Todos los miembros aparecen en la tabla, aunque todavía no tengan
+	// predicciones calificadas en el periodo
+	members := database.DB.Table("league_members AS m").
+		Select("m.user_id, "+
+			"COALESCE(s.total_predictions, 0) AS total_predictions, "+
+			"COALESCE(s.correct_predictions, 0) AS correct_predictions, "+
+			"COALESCE(s.wrong_predictions, 0) AS wrong_predictions, "+
+			"COALESCE(s.total_points, 0) AS total_points, "+
+			"COALESCE(s.ats_wins, 0) AS ats_wins, "+
+			"COALESCE(s.ats_losses, 0) AS ats_losses, "+
+			"COALESCE(s.ats_pushes, 0) AS ats_pushes").
+		Joins("LEFT JOIN (?) AS s ON s.user_id = m.user_id", sc.standingsQuery(database.DB)).
+		Where("m.league_id = ?", league.ID)
+	standings, totalUsers, next, err := findStandings(rankedTable(database.DB, members, rankOrder), page)
+	if err != nil {
+		log.Printf("Error fetching league leaderboard: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch league leaderboard: %v", err)
+	}
+
+	var pbLeaderboard []*pb.UserScore
+	for _, stats := range standings {
+		pbLeaderboard = append(pbLeaderboard, scopedStatsToProto(stats, sc))
+	}
+
+	return &pb.GetLeagueLeaderboardResponse{
+		League:        leagueToProto(league, int(totalUsers)),
+		Leaderboard:   pbLeaderboard,
+		TotalUsers:    int32(totalUsers),
+		Season:        int32(sc.season),
+		Week:          int32(sc.week),
+		NextPageToken: next,
+	}, nil
+}
+
+// ========================================
+// Helper Functions - Leagues
+// ========================================
+
+func fetchLeague(db *gorm.DB, leagueID string) (models.League, error) {
+	var league models.League
+	if err := db.Where("id = ?", leagueID).First(&league).Error; err != nil {
+		return league, status.Error(codes.NotFound, "League not found")
+	}
+	return league, nil
+}
+
+// fetchLeagueForMember devuelve la liga solo si quien llama es miembro: las
+// ligas son privadas y el resto recibe NotFound para no revelar que existen.
+// Los usuarios con rol league_admin o site_admin ven cualquier liga.
+func fetchLeagueForMember(ctx context.Context, db *gorm.DB, leagueID string) (models.League, error) {
+	userID, err := auth.ActingUser(ctx, "")
+	if err != nil {
+		return models.League{}, err
+	}
+	league, err := fetchLeague(db, leagueID)
+	if err != nil || auth.CallerHasRole(ctx, auth.RoleLeagueAdmin) {
+		return league, err
+	}
+
+	var count int64
+	db.Model(&models.LeagueMember{}).Where("league_id = ? AND user_id = ?", leagueID, userID).Count(&count)
+	if count == 0 {
+		return league, status.Error(codes.NotFound, "League not found")
+	}
+	return league, nil
+}
+
+// requireLeagueAdmin devuelve la liga si el usuario es uno de sus admins. Los
+// usuarios con rol league_admin o site_admin administran cualquier liga.
+func requireLeagueAdmin(ctx context.Context, db *gorm.DB, leagueID, userID string) (models.League, error) {
+	league, err := fetchLeague(db, leagueID)
+	if err != nil {
+		return league, err
+	}
+	if auth.CallerHasRole(ctx, auth.RoleLeagueAdmin) {
+		return league, nil
+	}
+
+	var member models.LeagueMember
+	if err := db.Where("league_id = ? AND user_id = ?", leagueID, userID).First(&member).Error; err != nil ||
+		member.Role != models.LeagueRoleAdmin {
+		return league, status.Error(codes.PermissionDenied, "only league admins can do this")
+	}
+	return league, nil
+}
+
+func countMembers(db *gorm.DB, leagueID string) int {
+	var count int64
+	db.Model(&models.LeagueMember{}).Where("league_id = ?", leagueID).Count(&count)
+	return int(count)
+}
+
+func countAdmins(db *gorm.DB, leagueID string) int {
+	var count int64
+	db.Model(&models.LeagueMember{}).Where("league_id = ? AND role = ?", leagueID, models.LeagueRoleAdmin).Count(&count)
+	return int(count)
+}
+
+// leagueTxError devuelve tal cual los errores gRPC de una transacción y
+// traduce el resto a Internal
+func leagueTxError(action string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	log.Printf("Error trying to %s: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %w", err)
+	}
+	for i := range b {
+		b[i] = inviteAlphabet[int(b[i])%len(inviteAlphabet)]
+	}
+	return string(b), nil
+}
+
+// normalizeInviteCode acepta códigos escritos en minúsculas o con espacios
+func normalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func leagueToProto(league models.League, memberCount int) *pb.League {
+	return &pb.League{
+		Id:          league.ID,
+		Name:        league.Name,
+		OwnerId:     league.OwnerID,
+		MemberCount: int32(memberCount),
+		CreatedAt:   timestamppb.New(league.CreatedAt),
+	}
+}
+
+func memberToProto(member models.LeagueMember) *pb.LeagueMember {
+	return &pb.LeagueMember{
+		UserId:   member.UserID,
+		Role:     string(member.Role),
+		JoinedAt: timestamppb.New(member.JoinedAt),
+	}
+}
+
+func inviteToProto(invite models.LeagueInvite) *pb.LeagueInvite {
+	pbInvite := &pb.LeagueInvite{
+		Code:      invite.Code,
+		LeagueId:  invite.LeagueID,
+		CreatedBy: invite.CreatedBy,
+		MaxUses:   int32(invite.MaxUses),
+		Uses:      int32(invite.Uses),
+		Revoked:   invite.RevokedAt != nil,
+	}
+	if invite.ExpiresAt != nil {
+		pbInvite.ExpiresAt = timestamppb.New(*invite.ExpiresAt)
+	}
+	return pbInvite
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	"kickoff.com/pkg/auth"
+	pb "kickoff.com/proto"
+)
+
+// useTestDB apunta database.DB a una base SQLite en memoria con las tablas del servicio
+func useTestDB(t *testing.T) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		sqlDB.Close()
+	})
+	if err := database.AutoMigrate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// asUser devuelve un contexto autenticado como userID, como lo deja el interceptor
+func asUser(userID string) context.Context {
+	return auth.NewContext(context.Background(), auth.Claims{Subject: userID})
+}
+
+func wantCode(t *testing.T, name string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Errorf("%s: code = %v (%v), want %v", name, got, err, want)
+	}
+}
+
+func TestInviteUsable(t *testing.T) {
+	now := time.Date(2026, 9, 10, 20, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name   string
+		invite models.LeagueInvite
+		want   bool
+	}{
+		{"ilimitado", models.LeagueInvite{Uses: 40}, true},
+		{"con usos disponibles", models.LeagueInvite{MaxUses: 2, Uses: 1}, true},
+		{"agotado", models.LeagueInvite{MaxUses: 2, Uses: 2}, false},
+		{"sin vencer", models.LeagueInvite{ExpiresAt: &future}, true},
+		{"vencido", models.LeagueInvite{ExpiresAt: &past}, false},
+		{"vence justo ahora", models.LeagueInvite{ExpiresAt: &now}, false},
+		{"revocado", models.LeagueInvite{RevokedAt: &past}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.invite.Usable(now); got != tt.want {
+			t.Errorf("%s: Usable() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewInviteCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		code, err := newInviteCode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) != inviteCodeLength {
+			t.Errorf("code %q has %d characters, want %d", code, len(code), inviteCodeLength)
+		}
+		for _, c := range code {
+			if !strings.ContainsRune(inviteAlphabet, c) {
+				t.Errorf("code %q has %q, outside the invite alphabet", code, c)
+			}
+		}
+		if seen[code] {
+			t.Errorf("code %q repeated", code)
+		}
+		seen[code] = true
+	}
+	if got := normalizeInviteCode("  abc23x "); got != "ABC23X" {
+		t.Errorf("normalizeInviteCode() = %q, want ABC23X", got)
+	}
+}
+
+func TestJoinLeagueWithInvite(t *testing.T) {
+	useTestDB(t)
+	ls := &LeaderboardService{}
+	created, err := ls.CreateLeague(asUser("user_owner"), &pb.CreateLeagueRequest{Name: "Oficina"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	leagueID := created.League.Id
+
+	// Solo un admin puede crear invitaciones
+	_, err = ls.CreateLeagueInvite(asUser("user_stranger"), &pb.CreateLeagueInviteRequest{LeagueId: leagueID})
+	wantCode(t, "invitación de un extraño", err, codes.PermissionDenied)
+
+	invite, err := ls.CreateLeagueInvite(asUser("user_owner"), &pb.CreateLeagueInviteRequest{LeagueId: leagueID, MaxUses: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := strings.ToLower(invite.Invite.Code)
+
+	joined, err := ls.JoinLeague(asUser("user_1"), &pb.JoinLeagueRequest{Code: code})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if joined.League.Id != leagueID || joined.League.MemberCount != 2 {
+		t.Errorf("JoinLeague() = %+v", joined.League)
+	}
+
+	_, err = ls.JoinLeague(asUser("user_2"), &pb.JoinLeagueRequest{Code: code})
+	wantCode(t, "invitación agotada", err, codes.FailedPrecondition)
+	_, err = ls.JoinLeague(asUser("user_2"), &pb.JoinLeagueRequest{Code: "NOPE99"})
+	wantCode(t, "código inexistente", err, codes.NotFound)
+
+	open, err := ls.CreateLeagueInvite(asUser("user_owner"), &pb.CreateLeagueInviteRequest{LeagueId: leagueID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ls.JoinLeague(asUser("user_1"), &pb.JoinLeagueRequest{Code: open.Invite.Code})
+	wantCode(t, "ya es miembro", err, codes.AlreadyExists)
+
+	if _, err := ls.RevokeLeagueInvite(asUser("user_owner"), &pb.RevokeLeagueInviteRequest{Code: open.Invite.Code}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ls.JoinLeague(asUser("user_2"), &pb.JoinLeagueRequest{Code: open.Invite.Code})
+	wantCode(t, "invitación revocada", err, codes.FailedPrecondition)
+}
+
+func TestLeaveLeague(t *testing.T) {
+	useTestDB(t)
+	ls := &LeaderboardService{}
+	created, err := ls.CreateLeague(asUser("user_owner"), &pb.CreateLeagueRequest{Name: "Oficina"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	leagueID := created.League.Id
+	for _, userID := range []string{"user_1", "user_2"} {
+		if err := database.DB.Create(&models.LeagueMember{LeagueID: leagueID, UserID: userID, Role: models.LeagueRoleMember}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// El único admin no puede irse mientras haya miembros
+	_, err = ls.LeaveLeague(asUser("user_owner"), &pb.LeaveLeagueRequest{LeagueId: leagueID})
+	wantCode(t, "único admin", err, codes.FailedPrecondition)
+
+	// Con otro admin, el dueño se va y la liga pasa a ese admin
+	if _, err := ls.UpdateLeagueMember(asUser("user_owner"), &pb.UpdateLeagueMemberRequest{
+		LeagueId: leagueID, MemberUserId: "user_1", Role: string(models.LeagueRoleAdmin),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ls.LeaveLeague(asUser("user_owner"), &pb.LeaveLeagueRequest{LeagueId: leagueID}); err != nil {
+		t.Fatal(err)
+	}
+	league, err := fetchLeague(database.DB, leagueID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if league.OwnerID != "user_1" {
+		t.Errorf("owner = %s, want user_1", league.OwnerID)
+	}
+
+	// Un miembro no puede expulsar a otro; el admin sí
+	_, err = ls.RemoveLeagueMember(asUser("user_2"), &pb.RemoveLeagueMemberRequest{LeagueId: leagueID, MemberUserId: "user_1"})
+	wantCode(t, "expulsión sin ser admin", err, codes.PermissionDenied)
+	if _, err := ls.RemoveLeagueMember(asUser("user_1"), &pb.RemoveLeagueMemberRequest{LeagueId: leagueID, MemberUserId: "user_2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// El último miembro cierra la liga
+	if _, err := ls.LeaveLeague(asUser("user_1"), &pb.LeaveLeagueRequest{LeagueId: leagueID}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ls.GetLeague(asUser("user_1"), &pb.GetLeagueRequest{LeagueId: leagueID})
+	wantCode(t, "liga cerrada", err, codes.NotFound)
+}
+
+func TestGetLeagueLeaderboard(t *testing.T) {
+	useTestDB(t)
+	ls := &LeaderboardService{}
+	created, err := ls.CreateLeague(asUser("user_a"), &pb.CreateLeagueRequest{Name: "Oficina"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	leagueID := created.League.Id
+	for _, userID := range []string{"user_b", "user_c", "user_d"} {
+		if err := database.DB.Create(&models.LeagueMember{LeagueID: leagueID, UserID: userID, Role: models.LeagueRoleMember}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// user_b y user_c empatan; user_d no tiene predicciones calificadas;
+	// user_x no es miembro y no aparece
+	scored := []models.ScoredPrediction{
+		{PredictionID: "pred_1", UserID: "user_a", GameID: "game_1", Season: 2026, Week: 1, Result: "correct", Points: 1},
+		{PredictionID: "pred_2", UserID: "user_a", GameID: "game_2", Season: 2026, Week: 1, Result: "correct", Points: 1},
+		{PredictionID: "pred_3", UserID: "user_b", GameID: "game_1", Season: 2026, Week: 1, Result: "correct", Points: 1},
+		{PredictionID: "pred_4", UserID: "user_c", GameID: "game_2", Season: 2026, Week: 1, Result: "correct", Points: 1},
+		{PredictionID: "pred_5", UserID: "user_x", GameID: "game_1", Season: 2026, Week: 1, Result: "correct", Points: 1},
+		{PredictionID: "pred_6", UserID: "user_b", GameID: "game_3", Season: 2026, Week: 2, Result: "correct", Points: 1},
+	}
+	if err := database.DB.Create(&scored).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// Las ligas son privadas: un extraño no la ve y sin token no hay usuario
+	_, err = ls.GetLeagueLeaderboard(asUser("user_stranger"), &pb.GetLeagueLeaderboardRequest{LeagueId: leagueID})
+	wantCode(t, "tabla vista por un extraño", err, codes.NotFound)
+	_, err = ls.GetLeagueLeaderboard(context.Background(), &pb.GetLeagueLeaderboardRequest{LeagueId: leagueID})
+	wantCode(t, "tabla sin token", err, codes.Unauthenticated)
+
+	resp, err := ls.GetLeagueLeaderboard(asUser("user_b"), &pb.GetLeagueLeaderboardRequest{LeagueId: leagueID, Season: 2026, Week: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		userID string
+		rank   int32
+		points int32
+	}{
+		{"user_a", 1, 2},
+		{"user_b", 2, 1},
+		{"user_c", 2, 1},
+		{"user_d", 4, 0},
+	}
+	if len(resp.Leaderboard) != len(want) || resp.TotalUsers != 4 {
+		t.Fatalf("leaderboard has %d rows of %d users, want %d of 4", len(resp.Leaderboard), resp.TotalUsers, len(want))
+	}
+	for i, w := range want {
+		got := resp.Leaderboard[i]
+		if got.UserId != w.userID || got.Rank != w.rank || got.Points != w.points {
+			t.Errorf("row %d = %s rank %d points %d, want %s rank %d points %d",
+				i, got.UserId, got.Rank, got.Points, w.userID, w.rank, w.points)
+		}
+	}
+}
@@ -12,19 +12,32 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 
 	"kickoff.com/leaderboard/internal/database"
 	"kickoff.com/leaderboard/internal/models"
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
 	pb "kickoff.com/proto"
 )
 
 const serviceName = "leaderboard"
 
+// privilegedMethods son los métodos del Leaderboard Service que exigen un rol
+var privilegedMethods = auth.Methods{
+	pb.LeaderboardService_RecalculateLeaderboard_FullMethodName: {auth.RoleSiteAdmin},
+	pb.LeaderboardService_ApplyPredictionResults_FullMethodName: {auth.RoleSiteAdmin},
+}
+
 type LeaderboardService struct {
 	pb.UnimplementedLeaderboardServiceServer
+	gameClient pb.GameServiceClient
 }
 
 func main() {
@@ -42,14 +55,48 @@ func main() {
 	defer database.Close()
 	log.Println("✅ Connected to PostgreSQL database")
 
-	leaderboardService := &LeaderboardService{}
+	// Conectar al Game Service para contar los juegos de cada semana/temporada
+	gameConn, err := grpc.NewClient("game-service:9082", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to game service: %v", err)
+	}
+	defer gameConn.Close()
+	log.Println("✅ Connected to Game Service gRPC (game-service:9082)")
+
+	leaderboardService := &LeaderboardService{
+		gameClient: pb.NewGameServiceClient(gameConn),
+	}
+
+	// Eventos: aplicar las predicciones calificadas a las estadísticas
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transport, err := events.NewTransportFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize event transport: %v", err)
+	}
+	leaderboardService.subscribeEvents(ctx, transport)
+
+	// Verificador de los access tokens propagados (mismo secreto que el Gateway)
+	tokens, err := auth.NewSignerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize token verifier: %v", err)
+	}
+
+	// Conectar al User Service para rechazar los tokens de usuarios desactivados
+	userConn, err := grpc.NewClient("user-service:9081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to user service: %v", err)
+	}
+	defer userConn.Close()
+	log.Println("✅ Connected to User Service gRPC (user-service:9081)")
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor(tokens, privilegedMethods, auth.UserServiceActive(pb.NewUserServiceClient(userConn)))))
 	pb.RegisterLeaderboardServiceServer(grpcServer, leaderboardService)
 
 	healthServer := health.NewServer()
@@ -68,57 +115,54 @@ func main() {
 
 	<-sigChan
 	log.Println("Shutting down gracefully...")
-	database.Close()
+	cancel()
 	grpcServer.GracefulStop()
+	database.Close()
 }
 
+// GetLeaderboard devuelve la tabla general (season 0), la de una temporada o
+// la de una semana
 func (ls *LeaderboardService) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
-	var userStats []models.UserStats
-	query := database.DB.Order("total_points DESC, correct_predictions DESC")
-
-	if req.Limit > 0 {
-		query = query.Limit(int(req.Limit))
+	sc, err := newScope(req.Season, req.Week)
+	if err != nil {
+		return nil, err
 	}
-	if req.Offset > 0 {
-		query = query.Offset(int(req.Offset))
+	pageSize, err := pagination.LegacyLimit(req.PageSize, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
 	}
-
-	if err := query.Find(&userStats).Error; err != nil {
-		log.Printf("Error fetching leaderboard: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to fetch leaderboard: %v", err)
+	page, err := pagination.New(pageSize, req.PageToken, "", standingOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
 	}
 
-	// Actualizar rangos
-	for i := range userStats {
-		userStats[i].Rank = i + 1 + int(req.Offset)
-		database.DB.Model(&userStats[i]).Update("rank", userStats[i].Rank)
+	standings, totalUsers, next, err := findStandings(sc.rankedQuery(database.DB), page)
+	if err != nil {
+		log.Printf("Error fetching leaderboard for season %d week %d: %v", sc.season, sc.week, err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch leaderboard: %v", err)
 	}
 
 	var pbLeaderboard []*pb.UserScore
-	for _, stats := range userStats {
-		pbLeaderboard = append(pbLeaderboard, &pb.UserScore{
-			UserId:      stats.UserID,
-			CorrectPicks: int32(stats.CorrectPredictions),
-			TotalPicks:  int32(stats.TotalPredictions),
-			Percentage:  calculatePercentage(stats.CorrectPredictions, stats.TotalPredictions),
-			Rank:        int32(stats.Rank),
-		})
+	for _, stats := range standings {
+		pbLeaderboard = append(pbLeaderboard, scopedStatsToProto(stats, sc))
 	}
 
-	// Contar total de usuarios
-	var totalUsers int64
-	database.DB.Model(&models.UserStats{}).Count(&totalUsers)
+	gamesFinished, gamesTotal := ls.countGames(ctx, sc)
 
 	return &pb.GetLeaderboardResponse{
 		Leaderboard:   pbLeaderboard,
 		TotalUsers:    int32(totalUsers),
-		GamesFinished: 0, // TODO: obtener de game service
+		GamesFinished: int32(gamesFinished),
+		GamesTotal:    int32(gamesTotal),
+		Season:        int32(sc.season),
+		Week:          int32(sc.week),
+		NextPageToken: next,
 	}, nil
 }
 
 func (ls *LeaderboardService) GetUserStats(ctx context.Context, req *pb.GetUserStatsRequest) (*pb.GetUserStatsResponse, error) {
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, shared.InvalidField("user_id", "user_id is required")
 	}
 
 	var userStats models.UserStats
@@ -126,29 +170,16 @@ func (ls *LeaderboardService) GetUserStats(ctx context.Context, req *pb.GetUserS
 
 	if result.Error != nil {
 		// Si no existe, crear un registro inicial
-		userStats = models.UserStats{
-			ID:                 fmt.Sprintf("stats_%s", req.UserId),
-			UserID:             req.UserId,
-			TotalPredictions:   0,
-			CorrectPredictions: 0,
-			WrongPredictions:   0,
-			TotalPoints:        0,
-			Rank:               0,
-		}
-		if err := database.DB.Create(&userStats).Error; err != nil {
+		created, err := ensureUserStats(database.DB, req.UserId)
+		if err != nil {
 			log.Printf("Error creating user stats: %v", err)
 			return nil, status.Errorf(codes.Internal, "failed to create user stats: %v", err)
 		}
+		userStats = created
 	}
 
 	return &pb.GetUserStatsResponse{
-		UserStats: &pb.UserScore{
-			UserId:      userStats.UserID,
-			CorrectPicks: int32(userStats.CorrectPredictions),
-			TotalPicks:  int32(userStats.TotalPredictions),
-			Percentage:  calculatePercentage(userStats.CorrectPredictions, userStats.TotalPredictions),
-			Rank:        int32(userStats.Rank),
-		},
+		UserStats:        modelStatsToProto(userStats),
 		Predictions:      []*pb.PredictionDetail{}, // TODO: obtener de prediction service
 		TotalPredictions: int32(userStats.TotalPredictions),
 	}, nil
@@ -160,27 +191,20 @@ func (ls *LeaderboardService) GetTopUsers(ctx context.Context, req *pb.GetTopUse
 		limit = 10
 	}
 
-	var userStats []models.UserStats
-	if err := database.DB.Order("total_points DESC, correct_predictions DESC").Limit(limit).Find(&userStats).Error; err != nil {
-		log.Printf("Error fetching top users: %v", err)
-		return nil, status.Errorf(codes.Internal, "failed to fetch top users: %v", err)
+	sc, err := newScope(req.Season, req.Week)
+	if err != nil {
+		return nil, err
 	}
 
-	// Actualizar rangos
-	for i := range userStats {
-		userStats[i].Rank = i + 1
-		database.DB.Model(&userStats[i]).Update("rank", userStats[i].Rank)
+	standings, err := fetchStandings(sc, limit)
+	if err != nil {
+		log.Printf("Error fetching top users: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch top users: %v", err)
 	}
 
 	var pbPlayers []*pb.UserScore
-	for _, stats := range userStats {
-		pbPlayers = append(pbPlayers, &pb.UserScore{
-			UserId:      stats.UserID,
-			CorrectPicks: int32(stats.CorrectPredictions),
-			TotalPicks:  int32(stats.TotalPredictions),
-			Percentage:  calculatePercentage(stats.CorrectPredictions, stats.TotalPredictions),
-			Rank:        int32(stats.Rank),
-		})
+	for _, stats := range standings {
+		pbPlayers = append(pbPlayers, scopedStatsToProto(stats, sc))
 	}
 
 	return &pb.GetTopUsersResponse{
@@ -191,64 +215,126 @@ func (ls *LeaderboardService) GetTopUsers(ctx context.Context, req *pb.GetTopUse
 
 func (ls *LeaderboardService) GetUserRank(ctx context.Context, req *pb.GetUserRankRequest) (*pb.GetUserRankResponse, error) {
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, shared.InvalidField("user_id", "user_id is required")
 	}
 
-	var userStats models.UserStats
-	if err := database.DB.Where("user_id = ?", req.UserId).First(&userStats).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "User stats not found")
+	sc, err := newScope(req.Season, req.Week)
+	if err != nil {
+		return nil, err
 	}
 
-	// Contar cuántos usuarios tienen mejor puntaje
-	var betterCount int64
-	database.DB.Model(&models.UserStats{}).
-		Where("total_points > ? OR (total_points = ? AND correct_predictions > ?)",
-			userStats.TotalPoints, userStats.TotalPoints, userStats.CorrectPredictions).
-		Count(&betterCount)
-
-	rank := int(betterCount) + 1
-	userStats.Rank = rank
-	database.DB.Model(&userStats).Update("rank", rank)
+	standing, found, err := fetchUserStanding(sc, req.UserId)
+	if err != nil {
+		log.Printf("Error fetching user rank: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch user rank: %v", err)
+	}
+	if !found {
+		if sc.allTime() {
+			return nil, status.Error(codes.NotFound, "User stats not found")
+		}
+		return nil, status.Error(codes.NotFound, "User has no graded predictions in this period")
+	}
 
-	// Contar total de usuarios
-	var totalUsers int64
-	database.DB.Model(&models.UserStats{}).Count(&totalUsers)
+	totalUsers, err := countStandingUsers(sc)
+	if err != nil {
+		log.Printf("Error counting leaderboard users: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to count users: %v", err)
+	}
 
 	return &pb.GetUserRankResponse{
-		UserScore: &pb.UserScore{
-			UserId:      userStats.UserID,
-			CorrectPicks: int32(userStats.CorrectPredictions),
-			TotalPicks:  int32(userStats.TotalPredictions),
-			Percentage:  calculatePercentage(userStats.CorrectPredictions, userStats.TotalPredictions),
-			Rank:        int32(rank),
-		},
-		Rank:       int32(rank),
+		UserScore:  scopedStatsToProto(standing, sc),
+		Rank:       int32(standing.Rank),
 		TotalUsers: int32(totalUsers),
 	}, nil
 }
 
+func (ls *LeaderboardService) GetUserWeeklyStats(ctx context.Context, req *pb.GetUserWeeklyStatsRequest) (*pb.GetUserWeeklyStatsResponse, error) {
+	if req.UserId == "" {
+		return nil, shared.InvalidField("user_id", "user_id is required")
+	}
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+
+	season := scope{season: int(req.Season)}
+
+	var weeks []int
+	if err := season.scoredQuery(database.DB).Where("user_id = ?", req.UserId).
+		Distinct().Order("week").Pluck("week", &weeks).Error; err != nil {
+		log.Printf("Error fetching weeks for user %s: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch weekly stats: %v", err)
+	}
+
+	resp := &pb.GetUserWeeklyStatsResponse{
+		UserId: req.UserId,
+		Season: req.Season,
+	}
+	for _, week := range weeks {
+		sc := scope{season: season.season, week: week}
+		standing, found, err := fetchUserStanding(sc, req.UserId)
+		if err != nil {
+			log.Printf("Error fetching week %d stats for user %s: %v", week, req.UserId, err)
+			return nil, status.Errorf(codes.Internal, "failed to fetch weekly stats: %v", err)
+		}
+		if found {
+			resp.Weeks = append(resp.Weeks, scopedStatsToProto(standing, sc))
+		}
+	}
+
+	totals, found, err := fetchUserStanding(season, req.UserId)
+	if err != nil {
+		log.Printf("Error fetching season stats for user %s: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch season stats: %v", err)
+	}
+	if !found {
+		totals = models.UserStats{UserID: req.UserId}
+	}
+	resp.SeasonTotals = scopedStatsToProto(totals, season)
+
+	return resp, nil
+}
+
 func (ls *LeaderboardService) RecalculateLeaderboard(ctx context.Context, req *pb.RecalculateLeaderboardRequest) (*pb.RecalculateLeaderboardResponse, error) {
-	// Obtener todos los usuarios ordenados por puntos
-	var userStats []models.UserStats
-	if err := database.DB.Order("total_points DESC, correct_predictions DESC").Find(&userStats).Error; err != nil {
+	processed, err := recalculateRanks()
+	if err != nil {
 		log.Printf("Error fetching users for recalculation: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch users: %v", err)
 	}
 
-	// Actualizar rangos
-	for i := range userStats {
-		userStats[i].Rank = i + 1
-		if err := database.DB.Model(&userStats[i]).Update("rank", userStats[i].Rank).Error; err != nil {
-			log.Printf("Error updating rank for user %s: %v", userStats[i].UserID, err)
-		}
-	}
+	log.Printf("Recalculated leaderboard for %d users", processed)
 
-	log.Printf("Recalculated leaderboard for %d users", len(userStats))
+	// Contar juegos con predicciones calificadas
+	var gamesEvaluated int64
+	database.DB.Model(&models.ScoredPrediction{}).Distinct("game_id").Count(&gamesEvaluated)
 
 	return &pb.RecalculateLeaderboardResponse{
 		Message:        "Leaderboard recalculated successfully",
-		UsersProcessed: int32(len(userStats)),
-		GamesEvaluated: 0, // TODO: integrar con game service
+		UsersProcessed: int32(processed),
+		GamesEvaluated: int32(gamesEvaluated),
+	}, nil
+}
+
+func (ls *LeaderboardService) ApplyPredictionResults(ctx context.Context, req *pb.ApplyPredictionResultsRequest) (*pb.ApplyPredictionResultsResponse, error) {
+	for _, result := range req.Results {
+		if result.PredictionId == "" || result.UserId == "" {
+			return nil, status.Error(codes.InvalidArgument, "prediction_id and user_id are required for every result")
+		}
+		if !validResults[result.Result] {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid result %q for prediction %s", result.Result, result.PredictionId)
+		}
+	}
+
+	usersUpdated, err := applyResults(req.Results)
+	if err != nil {
+		log.Printf("Error applying prediction results: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to apply prediction results: %v", err)
+	}
+
+	log.Printf("Applied %d prediction results (%d users updated)", len(req.Results), usersUpdated)
+
+	return &pb.ApplyPredictionResultsResponse{
+		Applied:      int32(len(req.Results)),
+		UsersUpdated: int32(usersUpdated),
 	}, nil
 }
 
@@ -256,6 +342,208 @@ func (ls *LeaderboardService) RecalculateLeaderboard(ctx context.Context, req *p
 // Helper Functions
 // ========================================
 
+// applyResults aplica resultados de predicciones a las estadísticas en una sola
+// transacción y devuelve cuántos usuarios cambiaron. Es idempotente: cada
+// predicción solo suma la diferencia con el último resultado aplicado.
+func applyResults(results []*pb.GradedPrediction) (int, error) {
+	usersUpdated := make(map[string]bool)
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var graded []models.LeaderboardUpdate
+		for _, result := range results {
+			var previous models.ScoredPrediction
+			found := tx.Where("prediction_id = ?", result.PredictionId).First(&previous).Error == nil
+
+			// Solo sumar la diferencia respecto al resultado aplicado anteriormente
+			delta := contributionOf(result.Result, int(result.Points), result.AgainstSpread, result.Push)
+			if found {
+				delta = delta.minus(contributionOf(previous.Result, previous.Points, previous.AgainstSpread, previous.Push))
+			}
+
+			scored := models.ScoredPrediction{
+				PredictionID:  result.PredictionId,
+				UserID:        result.UserId,
+				GameID:        result.GameId,
+				Season:        int(result.Season),
+				Week:          int(result.Week),
+				Result:        result.Result,
+				Points:        int(result.Points),
+				AgainstSpread: result.AgainstSpread,
+				Push:          result.Push,
+			}
+			// Los resultados sin semana (predicciones antiguas) conservan la ya registrada
+			if found && scored.Season == 0 {
+				scored.Season = previous.Season
+				scored.Week = previous.Week
+			}
+			if err := tx.Save(&scored).Error; err != nil {
+				return err
+			}
+
+			// Los streams solo reciben las calificaciones que cambiaron algo
+			if !found || previous.Result != scored.Result || previous.Points != scored.Points || previous.Push != scored.Push {
+				graded = append(graded, models.LeaderboardUpdate{
+					Type:          models.LeaderboardUpdatePredictionGraded,
+					UserID:        scored.UserID,
+					PredictionID:  scored.PredictionID,
+					GameID:        scored.GameID,
+					Result:        scored.Result,
+					Season:        scored.Season,
+					Week:          scored.Week,
+					AgainstSpread: scored.AgainstSpread,
+					Push:          scored.Push,
+					Points:        scored.Points,
+				})
+			}
+
+			if delta.isZero() {
+				continue
+			}
+
+			if _, err := ensureUserStats(tx, result.UserId); err != nil {
+				return err
+			}
+			if err := tx.Model(&models.UserStats{}).Where("user_id = ?", result.UserId).Updates(map[string]interface{}{
+				"total_predictions":   gorm.Expr("total_predictions + ?", delta.total),
+				"correct_predictions": gorm.Expr("correct_predictions + ?", delta.correct),
+				"wrong_predictions":   gorm.Expr("wrong_predictions + ?", delta.wrong),
+				"total_points":        gorm.Expr("total_points + ?", delta.points),
+				"ats_wins":            gorm.Expr("ats_wins + ?", delta.atsWins),
+				"ats_losses":          gorm.Expr("ats_losses + ?", delta.atsLosses),
+				"ats_pushes":          gorm.Expr("ats_pushes + ?", delta.atsPushes),
+			}).Error; err != nil {
+				return err
+			}
+			usersUpdated[result.UserId] = true
+		}
+		return appendLeaderboardUpdates(tx, graded)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(usersUpdated) > 0 {
+		if _, err := recalculateRanks(); err != nil {
+			log.Printf("Error recalculating ranks: %v", err)
+		}
+	}
+
+	return len(usersUpdated), nil
+}
+
+var validResults = map[string]bool{
+	"pending":   true,
+	"correct":   true,
+	"incorrect": true,
+	"void":      true,
+}
+
+// statsDelta es el aporte de una predicción calificada a los contadores de UserStats
+type statsDelta struct {
+	total, correct, wrong, points int
+	atsWins, atsLosses, atsPushes int
+}
+
+func contributionOf(result string, points int, againstSpread, push bool) statsDelta {
+	var delta statsDelta
+	switch result {
+	case "correct":
+		delta = statsDelta{total: 1, correct: 1, points: points}
+		if againstSpread {
+			delta.atsWins = 1
+		}
+	case "incorrect":
+		delta = statsDelta{total: 1, wrong: 1, points: points}
+		if againstSpread {
+			delta.atsLosses = 1
+		}
+	case "void":
+		// void no cuenta para las estadísticas, salvo el push en el récord ATS
+		if againstSpread && push {
+			delta.atsPushes = 1
+		}
+	}
+	return delta
+}
+
+func (d statsDelta) minus(other statsDelta) statsDelta {
+	return statsDelta{
+		total:     d.total - other.total,
+		correct:   d.correct - other.correct,
+		wrong:     d.wrong - other.wrong,
+		points:    d.points - other.points,
+		atsWins:   d.atsWins - other.atsWins,
+		atsLosses: d.atsLosses - other.atsLosses,
+		atsPushes: d.atsPushes - other.atsPushes,
+	}
+}
+
+func (d statsDelta) isZero() bool {
+	return d == statsDelta{}
+}
+
+// ensureUserStats devuelve las estadísticas del usuario, creándolas si no existen
+func ensureUserStats(db *gorm.DB, userID string) (models.UserStats, error) {
+	userStats := models.UserStats{
+		ID:     fmt.Sprintf("stats_%s", userID),
+		UserID: userID,
+	}
+	err := db.Where(models.UserStats{UserID: userID}).FirstOrCreate(&userStats).Error
+	return userStats, err
+}
+
+// recalculateRanks reasigna los rangos de todos los usuarios según sus puntos
+// y registra en el feed de cambios cada rango que se movió
+func recalculateRanks() (int, error) {
+	var userStats []models.UserStats
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// Bloquear el feed antes de leer evita que dos recálculos se pisen
+		if err := lockLeaderboardUpdates(tx); err != nil {
+			return err
+		}
+		if err := tx.Order(rankOrder + ", user_id").Find(&userStats).Error; err != nil {
+			return err
+		}
+
+		var changes []models.LeaderboardUpdate
+		for i, rank := range competitionRanks(userStats) {
+			if userStats[i].Rank == rank {
+				continue
+			}
+			changes = append(changes, models.LeaderboardUpdate{
+				Type:         models.LeaderboardUpdateRankChanged,
+				UserID:       userStats[i].UserID,
+				Points:       userStats[i].TotalPoints,
+				Rank:         rank,
+				PreviousRank: userStats[i].Rank,
+			})
+			userStats[i].Rank = rank
+			if err := tx.Model(&userStats[i]).Update("rank", rank).Error; err != nil {
+				return err
+			}
+		}
+		return appendLeaderboardUpdates(tx, changes)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(userStats), nil
+}
+
+func modelStatsToProto(stats models.UserStats) *pb.UserScore {
+	return &pb.UserScore{
+		UserId:       stats.UserID,
+		CorrectPicks: int32(stats.CorrectPredictions),
+		TotalPicks:   int32(stats.TotalPredictions),
+		Percentage:   calculatePercentage(stats.CorrectPredictions, stats.TotalPredictions),
+		Rank:         int32(stats.Rank),
+		Points:       int32(stats.TotalPoints),
+		AtsWins:      int32(stats.ATSWins),
+		AtsLosses:    int32(stats.ATSLosses),
+		AtsPushes:    int32(stats.ATSPushes),
+	}
+}
+
 func calculatePercentage(correct, total int) float64 {
 	if total == 0 {
 		return 0.0
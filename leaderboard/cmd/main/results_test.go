@@ -0,0 +1,56 @@
+package main
+
+import "testing"
+
+func TestContributionOf(t *testing.T) {
+	tests := []struct {
+		result        string
+		points        int
+		againstSpread bool
+		push          bool
+		want          statsDelta
+	}{
+		{"correct", 1, false, false, statsDelta{total: 1, correct: 1, points: 1}},
+		{"incorrect", 0, false, false, statsDelta{total: 1, wrong: 1}},
+		{"void", 0, false, false, statsDelta{}},
+		{"pending", 0, false, false, statsDelta{}},
+		{"correct", 1, true, false, statsDelta{total: 1, correct: 1, points: 1, atsWins: 1}},
+		{"incorrect", 0, true, false, statsDelta{total: 1, wrong: 1, atsLosses: 1}},
+		{"void", 0, true, true, statsDelta{atsPushes: 1}},
+		{"void", 0, true, false, statsDelta{}},
+	}
+	for _, tt := range tests {
+		if got := contributionOf(tt.result, tt.points, tt.againstSpread, tt.push); got != tt.want {
+			t.Errorf("contributionOf(%q, %d, %v, %v) = %+v, want %+v", tt.result, tt.points, tt.againstSpread, tt.push, got, tt.want)
+		}
+	}
+}
+
+// Recalificar solo aplica la diferencia con el resultado anterior
+func TestStatsDeltaRegrade(t *testing.T) {
+	tests := []struct {
+		name             string
+		previous, result string
+		want             statsDelta
+	}{
+		{"primera calificación", "pending", "correct", statsDelta{total: 1, correct: 1, points: 1}},
+		{"mismo resultado", "correct", "correct", statsDelta{}},
+		{"corrección de marcador", "correct", "incorrect", statsDelta{correct: -1, wrong: 1, points: -1}},
+		{"juego cancelado después", "incorrect", "void", statsDelta{total: -1, wrong: -1}},
+	}
+	for _, tt := range tests {
+		points := func(result string) int {
+			if result == "correct" {
+				return 1
+			}
+			return 0
+		}
+		got := contributionOf(tt.result, points(tt.result), false, false).minus(contributionOf(tt.previous, points(tt.previous), false, false))
+		if got != tt.want {
+			t.Errorf("%s: delta = %+v, want %+v", tt.name, got, tt.want)
+		}
+		if got.isZero() != (tt.want == statsDelta{}) {
+			t.Errorf("%s: isZero() = %v", tt.name, got.isZero())
+		}
+	}
+}
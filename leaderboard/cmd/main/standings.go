@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// Weekly & Season Standings
+// ========================================
+
+// scope identifica qué tabla se consulta: la general (season 0), una
+// temporada completa (week 0) o una semana de una temporada
+type scope struct {
+	season int
+	week   int
+}
+
+func newScope(season, week int32) (scope, error) {
+	if season < 0 || week < 0 {
+		return scope{}, status.Error(codes.InvalidArgument, "season and week cannot be negative")
+	}
+	if week > 0 && season == 0 {
+		return scope{}, shared.InvalidField("season", "season is required for weekly standings")
+	}
+	return scope{season: int(season), week: int(week)}, nil
+}
+
+func (s scope) allTime() bool {
+	return s.season == 0
+}
+
+// scoredQuery filtra las predicciones calificadas que cuentan para el scope
+// (todas las temporadas si season es 0). Igual que en user_stats, pending y
+// void no cuentan, salvo los push que suman al récord ATS.
+func (s scope) scoredQuery(db *gorm.DB) *gorm.DB {
+	query := db.Model(&models.ScoredPrediction{}).
+		Where("result IN ? OR push", []string{"correct", "incorrect"})
+	if s.season > 0 {
+		query = query.Where("season = ?", s.season)
+	}
+	if s.week > 0 {
+		query = query.Where("week = ?", s.week)
+	}
+	return query
+}
+
+// standingsQuery agrega por usuario las predicciones calificadas del scope,
+// con los mismos nombres de columna que user_stats
+func (s scope) standingsQuery(db *gorm.DB) *gorm.DB {
+	return s.scoredQuery(db).
+		Select("user_id, " +
+			"SUM(CASE WHEN result IN ('correct', 'incorrect') THEN 1 ELSE 0 END) AS total_predictions, " +
+			"SUM(CASE WHEN result = 'correct' THEN 1 ELSE 0 END) AS correct_predictions, " +
+			"SUM(CASE WHEN result = 'incorrect' THEN 1 ELSE 0 END) AS wrong_predictions, " +
+			"SUM(points) AS total_points, " +
+			"SUM(CASE WHEN against_spread AND result = 'correct' THEN 1 ELSE 0 END) AS ats_wins, " +
+			"SUM(CASE WHEN against_spread AND result = 'incorrect' THEN 1 ELSE 0 END) AS ats_losses, " +
+			"SUM(CASE WHEN push THEN 1 ELSE 0 END) AS ats_pushes").
+		Group("user_id")
+}
+
+// rankOrder es el orden de las tablas. Los empates en puntos y aciertos
+// comparten rango (ranking de competición: 1, 2, 2, 4) y se listan por user_id.
+const rankOrder = "total_points DESC, correct_predictions DESC"
+
+// statsTable devuelve las filas de la tabla del scope: las estadísticas
+// acumuladas de user_stats para la general, o standingsQuery para una
+// temporada o semana. Las dos tienen las mismas columnas y ninguna el rango.
+func (s scope) statsTable(db *gorm.DB) *gorm.DB {
+	if s.allTime() {
+		return db.Model(&models.UserStats{}).
+			Select("id, user_id, total_predictions, correct_predictions, wrong_predictions, " +
+				"total_points, ats_wins, ats_losses, ats_pushes")
+	}
+	return s.standingsQuery(db)
+}
+
+// rankedQuery numera la tabla del scope con RANK(), así la lista y la
+// consulta de un usuario dan el mismo rango para los empatados
+func (s scope) rankedQuery(db *gorm.DB) *gorm.DB {
+	return rankedTable(db, s.statsTable(db), rankOrder)
+}
+
+// rankedTable agrega a las filas de table (una por usuario) la columna rank,
+// con RANK() según order
+func rankedTable(db *gorm.DB, table *gorm.DB, order string) *gorm.DB {
+	return db.Table("(?) AS standings", table).
+		Select("standings.*, RANK() OVER (ORDER BY " + order + ") AS rank")
+}
+
+// rankOrders es el único orden de una tabla numerada por rankedTable: por
+// rango y, entre empatados, por user_id
+func rankOrders[T any](rank func(T) int) pagination.Orders[T] {
+	return pagination.Orders[T]{
+		{Name: "rank", Column: "rank", Kind: pagination.Int, Value: func(row T) interface{} { return rank(row) }, ID: "user_id"},
+	}
+}
+
+// findRanked trae una página de una tabla numerada por rankedTable, con el
+// total de filas y el token de la página siguiente. La tabla se envuelve
+// para que el cursor pueda filtrar por rank; Unscoped porque la envoltura
+// no tiene deleted_at aunque T lo tenga.
+func findRanked[T any](ranked *gorm.DB, page pagination.Page[T], userID func(T) string) ([]T, int64, string, error) {
+	return pagination.Find(database.DB.Table("(?) AS ranked", ranked).Unscoped(), page, userID)
+}
+
+// standingOrders son los órdenes de las tablas de puntos
+var standingOrders = rankOrders(func(s models.UserStats) int { return s.Rank })
+
+// findStandings trae una página de una tabla de puntos ya numerada
+func findStandings(ranked *gorm.DB, page pagination.Page[models.UserStats]) ([]models.UserStats, int64, string, error) {
+	return findRanked(ranked, page, func(s models.UserStats) string { return s.UserID })
+}
+
+// fetchStandings devuelve los primeros limit usuarios de la tabla del scope
+// con su rango
+func fetchStandings(s scope, limit int) ([]models.UserStats, error) {
+	query := s.rankedQuery(database.DB).Order("rank, user_id").Limit(limit)
+
+	var standings []models.UserStats
+	if err := query.Scan(&standings).Error; err != nil {
+		return nil, err
+	}
+	return standings, nil
+}
+
+// fetchUserStanding devuelve la fila del usuario en la tabla del scope con su
+// rango, o false si no figura en ella (en una temporada o semana, si no tiene
+// predicciones calificadas en ese periodo)
+func fetchUserStanding(s scope, userID string) (models.UserStats, bool, error) {
+	var rows []models.UserStats
+	if err := database.DB.Table("(?) AS ranked", s.rankedQuery(database.DB)).
+		Where("user_id = ?", userID).
+		Scan(&rows).Error; err != nil {
+		return models.UserStats{}, false, err
+	}
+	if len(rows) == 0 {
+		return models.UserStats{}, false, nil
+	}
+	return rows[0], true, nil
+}
+
+// countStandingUsers cuenta los usuarios de la tabla del scope
+func countStandingUsers(s scope) (int64, error) {
+	var total int64
+	var err error
+	if s.allTime() {
+		err = database.DB.Model(&models.UserStats{}).Count(&total).Error
+	} else {
+		err = s.scoredQuery(database.DB).Distinct("user_id").Count(&total).Error
+	}
+	return total, err
+}
+
+// competitionRanks devuelve el rango de cada fila de stats, ya ordenada por
+// rankOrder, con la misma regla que RANK() en rankedQuery
+func competitionRanks(stats []models.UserStats) []int {
+	ranks := make([]int, len(stats))
+	for i := range stats {
+		if i > 0 && stats[i].TotalPoints == stats[i-1].TotalPoints &&
+			stats[i].CorrectPredictions == stats[i-1].CorrectPredictions {
+			ranks[i] = ranks[i-1]
+		} else {
+			ranks[i] = i + 1
+		}
+	}
+	return ranks
+}
+
+// countGames consulta al Game Service cuántos juegos tiene el scope y cuántos
+// ya tienen resultado definitivo (completados o cancelados). Solo usa el total
+// de cada listado filtrado, así que no trae los juegos.
+func (ls *LeaderboardService) countGames(ctx context.Context, s scope) (finished, total int) {
+	count := func(gameStatus pb.GameStatus) (int, error) {
+		resp, err := ls.gameClient.GetAllGames(ctx, &pb.GetAllGamesRequest{
+			PageSize: 1,
+			Season:   int32(s.season),
+			WeekFrom: int32(s.week),
+			WeekTo:   int32(s.week),
+			Status:   gameStatus,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return int(resp.Total), nil
+	}
+
+	var err error
+	var completed, canceled int
+	if total, err = count(pb.GameStatus_GAME_STATUS_UNSPECIFIED); err == nil {
+		if completed, err = count(pb.GameStatus_GAME_STATUS_COMPLETED); err == nil {
+			canceled, err = count(pb.GameStatus_GAME_STATUS_CANCELED)
+		}
+	}
+	if err != nil {
+		log.Printf("Error fetching games for leaderboard: %v", err)
+		return 0, 0
+	}
+	return completed + canceled, total
+}
+
+func scopedStatsToProto(stats models.UserStats, s scope) *pb.UserScore {
+	score := modelStatsToProto(stats)
+	score.Season = int32(s.season)
+	score.Week = int32(s.week)
+	return score
+}
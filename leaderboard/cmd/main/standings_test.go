@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"kickoff.com/leaderboard/internal/models"
+)
+
+func TestCompetitionRanks(t *testing.T) {
+	stats := func(rows ...[2]int) []models.UserStats {
+		out := make([]models.UserStats, len(rows))
+		for i, r := range rows {
+			out[i] = models.UserStats{TotalPoints: r[0], CorrectPredictions: r[1]}
+		}
+		return out
+	}
+	tests := []struct {
+		name  string
+		stats []models.UserStats
+		want  []int
+	}{
+		{"vacía", nil, []int{}},
+		{"sin empates", stats([2]int{10, 10}, [2]int{8, 8}, [2]int{5, 5}), []int{1, 2, 3}},
+		{"empate comparte rango y salta el siguiente", stats([2]int{10, 10}, [2]int{8, 8}, [2]int{8, 8}, [2]int{5, 5}), []int{1, 2, 2, 4}},
+		{"los aciertos desempatan los puntos", stats([2]int{10, 9}, [2]int{10, 8}, [2]int{10, 8}), []int{1, 2, 2}},
+		{"todos empatados", stats([2]int{0, 0}, [2]int{0, 0}, [2]int{0, 0}), []int{1, 1, 1}},
+	}
+	for _, tt := range tests {
+		if got := competitionRanks(tt.stats); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: competitionRanks() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewScope(t *testing.T) {
+	tests := []struct {
+		season, week int32
+		want         scope
+		wantErr      bool
+	}{
+		{0, 0, scope{}, false},
+		{2025, 0, scope{season: 2025}, false},
+		{2025, 3, scope{season: 2025, week: 3}, false},
+		{0, 3, scope{}, true},
+		{-1, 0, scope{}, true},
+		{2025, -1, scope{}, true},
+	}
+	for _, tt := range tests {
+		got, err := newScope(tt.season, tt.week)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("newScope(%d, %d) = (%+v, %v), want (%+v, err %v)", tt.season, tt.week, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
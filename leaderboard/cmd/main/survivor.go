@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Survivor Pool
+// ========================================
+
+// GetSurvivorStandings devuelve la tabla del survivor pool de una temporada:
+// primero los que siguen vivos y después los eliminados, del que más duró al
+// que menos. Empatan en posición quienes comparten estado y semanas.
+func (ls *LeaderboardService) GetSurvivorStandings(ctx context.Context, req *pb.GetSurvivorStandingsRequest) (*pb.GetSurvivorStandingsResponse, error) {
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", survivorOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.SurvivorStanding{}).Where("season = ?", req.Season)
+	if req.LeagueId != "" {
+		league, err := fetchLeagueForMember(ctx, database.DB, req.LeagueId)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("user_id IN (?)",
+			database.DB.Model(&models.LeagueMember{}).Select("user_id").Where("league_id = ?", league.ID))
+	}
+
+	standings, total, next, err := findRanked(rankedTable(database.DB, query, survivorRankOrder), page,
+		func(s rankedSurvivor) string { return s.UserID })
+	if err != nil {
+		log.Printf("Error fetching survivor standings: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch survivor standings: %v", err)
+	}
+
+	// Los vivos y eliminados se cuentan sobre toda la tabla, no sobre la página
+	var alive int64
+	if err := query.Session(&gorm.Session{}).Where("alive").Count(&alive).Error; err != nil {
+		log.Printf("Error counting survivor entrants: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch survivor standings: %v", err)
+	}
+
+	resp := &pb.GetSurvivorStandingsResponse{
+		Season:        req.Season,
+		Alive:         int32(alive),
+		Eliminated:    int32(total - alive),
+		NextPageToken: next,
+	}
+	for _, standing := range standings {
+		resp.Standings = append(resp.Standings, &pb.SurvivorStanding{
+			UserId:         standing.UserID,
+			Season:         int32(standing.Season),
+			Alive:          standing.Alive,
+			EliminatedWeek: int32(standing.EliminatedWeek),
+			WeeksSurvived:  int32(standing.WeeksSurvived),
+			Rank:           int32(standing.Rank),
+		})
+	}
+
+	return resp, nil
+}
+
+// ========================================
+// Helper Functions - Survivor Pool
+// ========================================
+
+// survivorRankOrder es el orden de la tabla: empatan en posición quienes
+// comparten estado y semanas
+const survivorRankOrder = "alive DESC, weeks_survived DESC, eliminated_week DESC"
+
+// rankedSurvivor es una fila de la tabla del survivor con su posición
+type rankedSurvivor struct {
+	models.SurvivorStanding
+	Rank int
+}
+
+var survivorOrders = rankOrders(func(s rankedSurvivor) int { return s.Rank })
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"kickoff.com/leaderboard/internal/database"
+	"kickoff.com/leaderboard/internal/models"
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+)
+
+const (
+	// watchPollInterval es cada cuánto un stream busca entradas nuevas en el feed
+	watchPollInterval = 500 * time.Millisecond
+	// watchBatchSize es el máximo de entradas que se leen por consulta
+	watchBatchSize = 500
+)
+
+// ========================================
+// gRPC Handlers - Live updates
+// ========================================
+
+// WatchLeaderboard envía las predicciones calificadas y los cambios de
+// posición en la tabla general a medida que ocurren. Con since_version = 0
+// empieza por la posición actual de cada usuario; con la última versión
+// recibida retoma sin perder cambios.
+func (ls *LeaderboardService) WatchLeaderboard(req *pb.WatchLeaderboardRequest, stream pb.LeaderboardService_WatchLeaderboardServer) error {
+	if req.SinceVersion < 0 {
+		return shared.InvalidField("since_version", "since_version cannot be negative")
+	}
+	ctx := stream.Context()
+
+	last := req.SinceVersion
+	if last == 0 {
+		// La versión se lee antes que la tabla: un cambio en el medio se
+		// envía dos veces, pero nunca se pierde
+		var latest models.LeaderboardUpdate
+		if err := database.DB.WithContext(ctx).Order("version DESC").Limit(1).Find(&latest).Error; err != nil {
+			log.Printf("Error watching leaderboard: %v", err)
+			return status.Errorf(codes.Internal, "failed to watch leaderboard: %v", err)
+		}
+		last = latest.Version
+
+		var userStats []models.UserStats
+		if err := database.DB.WithContext(ctx).Where("rank > 0").Order("rank").Find(&userStats).Error; err != nil {
+			log.Printf("Error watching leaderboard: %v", err)
+			return status.Errorf(codes.Internal, "failed to watch leaderboard: %v", err)
+		}
+		for _, stats := range userStats {
+			if err := stream.Send(&pb.LeaderboardUpdate{
+				Version:      last,
+				Type:         pb.LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_RANK_CHANGED,
+				UserId:       stats.UserID,
+				Rank:         int32(stats.Rank),
+				PreviousRank: int32(stats.Rank),
+				Points:       int32(stats.TotalPoints),
+				Snapshot:     true,
+				CreatedAt:    timestamppb.New(stats.UpdatedAt),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		var updates []models.LeaderboardUpdate
+		if err := database.DB.WithContext(ctx).
+			Where("version > ?", last).
+			Order("version").
+			Limit(watchBatchSize).
+			Find(&updates).Error; err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("Error watching leaderboard: %v", err)
+			return status.Errorf(codes.Internal, "failed to watch leaderboard: %v", err)
+		}
+
+		for _, update := range updates {
+			if err := stream.Send(modelUpdateToProto(update)); err != nil {
+				return err
+			}
+			last = update.Version
+		}
+		if len(updates) == watchBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchPollInterval):
+		}
+	}
+}
+
+// ========================================
+// Helper Functions - Live updates
+// ========================================
+
+// lockLeaderboardUpdates bloquea el feed hasta el final de la transacción. Las
+// lecturas siguen funcionando, pero las versiones se asignan y se hacen
+// visibles en orden, así un stream nunca saltea una versión todavía sin confirmar.
+func lockLeaderboardUpdates(tx *gorm.DB) error {
+	return tx.Exec("LOCK TABLE leaderboard_updates IN EXCLUSIVE MODE").Error
+}
+
+// appendLeaderboardUpdates agrega las entradas al feed de cambios
+func appendLeaderboardUpdates(tx *gorm.DB, updates []models.LeaderboardUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	if err := lockLeaderboardUpdates(tx); err != nil {
+		return err
+	}
+	return tx.Create(&updates).Error
+}
+
+func modelUpdateToProto(update models.LeaderboardUpdate) *pb.LeaderboardUpdate {
+	pbUpdate := &pb.LeaderboardUpdate{
+		Version:   update.Version,
+		UserId:    update.UserID,
+		Points:    int32(update.Points),
+		CreatedAt: timestamppb.New(update.CreatedAt),
+	}
+	switch update.Type {
+	case models.LeaderboardUpdatePredictionGraded:
+		pbUpdate.Type = pb.LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_PREDICTION_GRADED
+		pbUpdate.Prediction = &pb.GradedPrediction{
+			PredictionId:  update.PredictionID,
+			UserId:        update.UserID,
+			GameId:        update.GameID,
+			Result:        update.Result,
+			Points:        int32(update.Points),
+			Season:        int32(update.Season),
+			Week:          int32(update.Week),
+			AgainstSpread: update.AgainstSpread,
+			Push:          update.Push,
+		}
+	case models.LeaderboardUpdateRankChanged:
+		pbUpdate.Type = pb.LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_RANK_CHANGED
+		pbUpdate.Rank = int32(update.Rank)
+		pbUpdate.PreviousRank = int32(update.PreviousRank)
+	}
+	return pbUpdate
+}
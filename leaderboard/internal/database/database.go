@@ -63,6 +63,13 @@ func AutoMigrate() error {
 	log.Println("Running auto-migration for Leaderboard service...")
 	return DB.AutoMigrate(
 		&models.UserStats{},
+		&models.ScoredPrediction{},
+		&models.League{},
+		&models.LeagueMember{},
+		&models.LeagueInvite{},
+		&models.SurvivorStanding{},
+		&models.BracketStanding{},
+		&models.LeaderboardUpdate{},
 	)
 }
 
@@ -13,6 +13,9 @@ type UserStats struct {
 	CorrectPredictions int            `gorm:"default:0" json:"correctPredictions"`
 	WrongPredictions   int            `gorm:"default:0" json:"wrongPredictions"`
 	TotalPoints        int            `gorm:"default:0" json:"totalPoints"`
+	ATSWins            int            `gorm:"default:0" json:"atsWins"`
+	ATSLosses          int            `gorm:"default:0" json:"atsLosses"`
+	ATSPushes          int            `gorm:"default:0" json:"atsPushes"`
 	Rank               int            `gorm:"default:0" json:"rank"`
 	CreatedAt          time.Time      `gorm:"autoCreateTime" json:"createdAt"`
 	UpdatedAt          time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
@@ -23,3 +26,93 @@ type UserStats struct {
 func (UserStats) TableName() string {
 	return "user_stats"
 }
+
+// ScoredPrediction registra el último resultado aplicado de cada predicción,
+// para que reaplicar una calificación solo sume la diferencia. Las tablas por
+// semana y temporada se calculan agregando estas filas.
+type ScoredPrediction struct {
+	PredictionID  string    `gorm:"primaryKey;type:varchar(50)" json:"predictionId"`
+	UserID        string    `gorm:"not null;type:varchar(50);index" json:"userId"`
+	GameID        string    `gorm:"not null;type:varchar(50);index" json:"gameId"`
+	Season        int       `gorm:"default:0;index:idx_scored_period" json:"season"`
+	Week          int       `gorm:"default:0;index:idx_scored_period" json:"week"`
+	Result        string    `gorm:"type:varchar(20);not null" json:"result"`
+	Points        int       `gorm:"default:0" json:"points"`
+	AgainstSpread bool      `gorm:"default:false" json:"againstSpread"`
+	Push          bool      `gorm:"default:false" json:"push"` // Pick ATS anulado por push
+	CreatedAt     time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (ScoredPrediction) TableName() string {
+	return "scored_predictions"
+}
+
+// SurvivorStanding es el estado de un usuario en el survivor pool de una
+// temporada, tal como lo publica el Prediction Service
+type SurvivorStanding struct {
+	UserID         string    `gorm:"primaryKey;type:varchar(50)" json:"userId"`
+	Season         int       `gorm:"primaryKey;index" json:"season"`
+	Alive          bool      `gorm:"not null" json:"alive"`
+	EliminatedWeek int       `gorm:"default:0" json:"eliminatedWeek"` // 0 mientras sigue vivo
+	WeeksSurvived  int       `gorm:"default:0" json:"weeksSurvived"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (SurvivorStanding) TableName() string {
+	return "survivor_standings"
+}
+
+// BracketStanding es el puntaje del bracket de playoffs de un usuario en una
+// temporada, tal como lo publica el Prediction Service
+type BracketStanding struct {
+	UserID    string    `gorm:"primaryKey;type:varchar(50)" json:"userId"`
+	Season    int       `gorm:"primaryKey;index" json:"season"`
+	Points    int       `gorm:"default:0" json:"points"`
+	Correct   int       `gorm:"default:0" json:"correct"`
+	Incorrect int       `gorm:"default:0" json:"incorrect"`
+	MaxPoints int       `gorm:"default:0" json:"maxPoints"` // Puntos todavía alcanzables
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (BracketStanding) TableName() string {
+	return "bracket_standings"
+}
+
+// LeaderboardUpdateType distingue las entradas del feed de cambios
+type LeaderboardUpdateType string
+
+const (
+	LeaderboardUpdatePredictionGraded LeaderboardUpdateType = "prediction_graded"
+	LeaderboardUpdateRankChanged      LeaderboardUpdateType = "rank_changed"
+)
+
+// LeaderboardUpdate es una entrada del feed de cambios que consume
+// WatchLeaderboard: una predicción calificada o un cambio de posición en la
+// tabla general. Las entradas se agregan con la tabla bloqueada, así las
+// versiones se hacen visibles en orden y un stream puede retomar desde la
+// última que recibió.
+type LeaderboardUpdate struct {
+	Version       int64                 `gorm:"primaryKey;autoIncrement" json:"version"`
+	Type          LeaderboardUpdateType `gorm:"type:varchar(20);not null" json:"type"`
+	UserID        string                `gorm:"not null;type:varchar(50)" json:"userId"`
+	PredictionID  string                `gorm:"type:varchar(50)" json:"predictionId,omitempty"` // prediction_graded
+	GameID        string                `gorm:"type:varchar(50)" json:"gameId,omitempty"`
+	Result        string                `gorm:"type:varchar(20)" json:"result,omitempty"`
+	Season        int                   `gorm:"default:0" json:"season,omitempty"`
+	Week          int                   `gorm:"default:0" json:"week,omitempty"`
+	AgainstSpread bool                  `gorm:"default:false" json:"againstSpread,omitempty"`
+	Push          bool                  `gorm:"default:false" json:"push,omitempty"`
+	Points        int                   `gorm:"default:0" json:"points"`                 // De la predicción, o totales del usuario en rank_changed
+	Rank          int                   `gorm:"default:0" json:"rank,omitempty"`         // rank_changed
+	PreviousRank  int                   `gorm:"default:0" json:"previousRank,omitempty"` // 0 = primera vez en la tabla
+	CreatedAt     time.Time             `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (LeaderboardUpdate) TableName() string {
+	return "leaderboard_updates"
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LeagueRole string
+
+const (
+	LeagueRoleAdmin  LeagueRole = "admin"
+	LeagueRoleMember LeagueRole = "member"
+)
+
+// League es una liga privada: sus miembros compiten entre sí con las mismas
+// predicciones que cuentan para la tabla general
+type League struct {
+	ID        string         `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	Name      string         `gorm:"not null;type:varchar(100)" json:"name"`
+	OwnerID   string         `gorm:"not null;type:varchar(50);index" json:"ownerId"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName especifica el nombre de la tabla
+func (League) TableName() string {
+	return "leagues"
+}
+
+// LeagueMember es la pertenencia de un usuario a una liga
+type LeagueMember struct {
+	LeagueID string     `gorm:"primaryKey;type:varchar(50)" json:"leagueId"`
+	UserID   string     `gorm:"primaryKey;type:varchar(50);index" json:"userId"`
+	Role     LeagueRole `gorm:"type:varchar(20);not null;default:'member'" json:"role"`
+	JoinedAt time.Time  `gorm:"autoCreateTime" json:"joinedAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (LeagueMember) TableName() string {
+	return "league_members"
+}
+
+// LeagueInvite es un código para unirse a una liga
+type LeagueInvite struct {
+	Code      string     `gorm:"primaryKey;type:varchar(20)" json:"code"`
+	LeagueID  string     `gorm:"not null;type:varchar(50);index" json:"leagueId"`
+	CreatedBy string     `gorm:"not null;type:varchar(50)" json:"createdBy"`
+	MaxUses   int        `gorm:"default:0" json:"maxUses"` // 0 = ilimitado
+	Uses      int        `gorm:"default:0" json:"uses"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (LeagueInvite) TableName() string {
+	return "league_invites"
+}
+
+// Usable indica si el código todavía permite unirse a la liga
+func (i LeagueInvite) Usable(now time.Time) bool {
+	if i.RevokedAt != nil {
+		return false
+	}
+	if i.ExpiresAt != nil && !now.Before(*i.ExpiresAt) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataKey es la clave de metadata gRPC con el access token del usuario
+// que originó el llamado, con el mismo formato que el header HTTP
+const metadataKey = "authorization"
+
+type callerKey struct{}
+
+// Methods asocia cada método privilegiado de un servicio (nombre completo,
+// por ejemplo pb.GameService_CreateGame_FullMethodName) con los roles que lo
+// pueden llamar. Un site_admin puede llamar a todos.
+type Methods map[string][]Role
+
+// ActiveFunc indica si un usuario sigue activo. Los access tokens no se
+// pueden revocar, así que sin este control un usuario desactivado seguiría
+// operando hasta que venza su token.
+type ActiveFunc func(ctx context.Context, userID string) (bool, error)
+
+// UnaryServerInterceptor verifica el access token propagado en la metadata
+// "authorization" y guarda el usuario en el contexto (ver Caller). Los métodos
+// de privileged exigen uno de sus roles: sin token responden Unauthenticated y
+// con otro rol PermissionDenied. El resto sigue abierto como antes, pero un
+// token inválido o vencido se rechaza siempre, igual que el de un usuario
+// desactivado según active (si no es nil).
+func UnaryServerInterceptor(verifier *Signer, privileged Methods, active ActiveFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		claims, authenticated, err := verifyMetadata(ctx, verifier)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if authenticated && active != nil {
+			ok, err := active(ctx, claims.Subject)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "failed to check user: %v", err)
+			}
+			if !ok {
+				return nil, status.Error(codes.PermissionDenied, "user is deactivated")
+			}
+		}
+		if authenticated {
+			ctx = NewContext(ctx, claims)
+		}
+
+		roles, restricted := privileged[info.FullMethod]
+		if !restricted {
+			return handler(ctx, req)
+		}
+		if !authenticated {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+		if !claims.HasRole(roles...) {
+			return nil, status.Errorf(codes.PermissionDenied, "role %s cannot call %s", claims.RoleOf(), info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// NewContext devuelve una copia de ctx con claims como usuario del llamado.
+// La usa el interceptor después de verificar el token.
+func NewContext(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, callerKey{}, claims)
+}
+
+// Caller devuelve el usuario autenticado del llamado gRPC, si lo hay
+func Caller(ctx context.Context) (Claims, bool) {
+	claims, ok := ctx.Value(callerKey{}).(Claims)
+	return claims, ok
+}
+
+// CallerHasRole indica si el usuario del llamado tiene alguno de los roles
+func CallerHasRole(ctx context.Context, roles ...Role) bool {
+	claims, ok := Caller(ctx)
+	return ok && claims.HasRole(roles...)
+}
+
+// ActingUser devuelve el usuario en nombre del cual se hace el llamado: el
+// autenticado si userID está vacío o es él mismo. Solo un site_admin puede
+// actuar por otro usuario. Lo usan los métodos que antes confiaban en el
+// user_id que completaba el gateway.
+func ActingUser(ctx context.Context, userID string) (string, error) {
+	claims, ok := Caller(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if userID == "" || userID == claims.Subject {
+		return claims.Subject, nil
+	}
+	if !claims.HasRole(RoleSiteAdmin) {
+		return "", status.Error(codes.PermissionDenied, "cannot act on behalf of another user")
+	}
+	return userID, nil
+}
+
+// WithToken agrega el access token a la metadata de los llamados gRPC que se
+// hagan con el contexto
+func WithToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, metadataKey, "Bearer "+token)
+}
+
+// CacheActive guarda durante ttl la respuesta de check para cada usuario, así
+// los servicios que consultan al User Service no lo llaman en cada request.
+// Una desactivación tarda como mucho ttl en aplicarse.
+func CacheActive(check ActiveFunc, ttl time.Duration) ActiveFunc {
+	type entry struct {
+		active  bool
+		checked time.Time
+	}
+	var mu sync.Mutex
+	cache := make(map[string]entry)
+
+	return func(ctx context.Context, userID string) (bool, error) {
+		now := time.Now()
+		mu.Lock()
+		cached, ok := cache[userID]
+		mu.Unlock()
+		if ok && now.Sub(cached.checked) < ttl {
+			return cached.active, nil
+		}
+
+		active, err := check(ctx, userID)
+		if err != nil {
+			return false, err
+		}
+		mu.Lock()
+		for id, e := range cache {
+			if now.Sub(e.checked) >= ttl {
+				delete(cache, id)
+			}
+		}
+		cache[userID] = entry{active: active, checked: now}
+		mu.Unlock()
+		return active, nil
+	}
+}
+
+// verifyMetadata lee y verifica el token de la metadata entrante; sin token
+// el llamado es anónimo
+func verifyMetadata(ctx context.Context, verifier *Signer) (Claims, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(metadataKey)) == 0 {
+		return Claims{}, false, nil
+	}
+	token, ok := BearerToken(md.Get(metadataKey)[0])
+	if !ok {
+		return Claims{}, false, ErrInvalidToken
+	}
+	claims, err := verifier.Verify(token, time.Now())
+	if err != nil {
+		return Claims{}, false, err
+	}
+	return claims, true, nil
+}
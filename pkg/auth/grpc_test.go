@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCacheActive(t *testing.T) {
+	calls := map[string]int{}
+	inactive := map[string]bool{"bob": true}
+	var failing bool
+	check := func(ctx context.Context, userID string) (bool, error) {
+		calls[userID]++
+		if failing {
+			return false, errors.New("user service down")
+		}
+		return !inactive[userID], nil
+	}
+	active := CacheActive(check, 50*time.Millisecond)
+	ctx := context.Background()
+
+	steps := []struct {
+		name       string
+		userID     string
+		wait       time.Duration
+		deactivate bool
+		failing    bool
+		want       bool
+		wantErr    bool
+		wantCalls  int
+	}{
+		{name: "primer llamado consulta", userID: "alice", want: true, wantCalls: 1},
+		{name: "dentro del ttl usa la cache", userID: "alice", want: true, wantCalls: 1},
+		{name: "otro usuario consulta aparte", userID: "bob", want: false, wantCalls: 1},
+		{name: "inactivo también se cachea", userID: "bob", want: false, wantCalls: 1},
+		{name: "desactivar no se ve antes del ttl", userID: "alice", deactivate: true, want: true, wantCalls: 1},
+		{name: "vencido el ttl vuelve a consultar", userID: "alice", wait: 60 * time.Millisecond, want: false, wantCalls: 2},
+		{name: "un error no se cachea", userID: "carol", failing: true, wantErr: true, wantCalls: 1},
+		{name: "después del error vuelve a consultar", userID: "carol", want: true, wantCalls: 2},
+	}
+	for _, step := range steps {
+		time.Sleep(step.wait)
+		if step.deactivate {
+			inactive[step.userID] = true
+		}
+		failing = step.failing
+		got, err := active(ctx, step.userID)
+		if (err != nil) != step.wantErr {
+			t.Fatalf("%s: error = %v, wantErr %v", step.name, err, step.wantErr)
+		}
+		if got != step.want {
+			t.Errorf("%s: active = %v, want %v", step.name, got, step.want)
+		}
+		if calls[step.userID] != step.wantCalls {
+			t.Errorf("%s: %d checks, want %d", step.name, calls[step.userID], step.wantCalls)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	signer, err := NewSigner(testSecret, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	player, _ := signer.Issue("player-1", RolePlayer, now)
+	keeper, _ := signer.Issue("keeper-1", RoleScoreKeeper, now)
+	admin, _ := signer.Issue("admin-1", RoleSiteAdmin, now)
+	deactivated, _ := signer.Issue("gone-1", RolePlayer, now)
+	broken, _ := signer.Issue("broken-1", RolePlayer, now)
+	expired, _ := signer.Issue("player-1", RolePlayer, now.Add(-2*time.Hour))
+
+	const (
+		open       = "/proto.GameService/GetGame"
+		restricted = "/proto.GameService/UpdateScore"
+	)
+	privileged := Methods{restricted: {RoleScoreKeeper}}
+	active := func(ctx context.Context, userID string) (bool, error) {
+		switch userID {
+		case "gone-1":
+			return false, nil
+		case "broken-1":
+			return false, errors.New("user service down")
+		}
+		return true, nil
+	}
+	interceptor := UnaryServerInterceptor(signer, privileged, active)
+
+	tests := []struct {
+		name       string
+		method     string
+		token      string
+		wantCode   codes.Code
+		wantCaller string
+	}{
+		{"método abierto sin token", open, "", codes.OK, ""},
+		{"método abierto con token", open, player, codes.OK, "player-1"},
+		{"método abierto con token inválido", open, "basura", codes.Unauthenticated, ""},
+		{"método abierto con token vencido", open, expired, codes.Unauthenticated, ""},
+		{"privilegiado sin token", restricted, "", codes.Unauthenticated, ""},
+		{"privilegiado con otro rol", restricted, player, codes.PermissionDenied, ""},
+		{"privilegiado con su rol", restricted, keeper, codes.OK, "keeper-1"},
+		{"site_admin puede todo", restricted, admin, codes.OK, "admin-1"},
+		{"usuario desactivado", open, deactivated, codes.PermissionDenied, ""},
+		{"falla la consulta de activo", open, broken, codes.Unavailable, ""},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(metadataKey, "Bearer "+tt.token))
+		}
+		var caller string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, _ := Caller(ctx)
+			caller = claims.Subject
+			return "ok", nil
+		}
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+		if code := status.Code(err); code != tt.wantCode {
+			t.Errorf("%s: code = %v, want %v (%v)", tt.name, code, tt.wantCode, err)
+		}
+		if caller != tt.wantCaller {
+			t.Errorf("%s: caller = %q, want %q", tt.name, caller, tt.wantCaller)
+		}
+	}
+}
+
+func TestActingUser(t *testing.T) {
+	withCaller := func(subject string, role Role) context.Context {
+		return NewContext(context.Background(), Claims{Subject: subject, Role: role})
+	}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		userID   string
+		want     string
+		wantCode codes.Code
+	}{
+		{"anónimo", context.Background(), "", "", codes.Unauthenticated},
+		{"sin user_id es el llamador", withCaller("u1", RolePlayer), "", "u1", codes.OK},
+		{"el mismo usuario", withCaller("u1", RolePlayer), "u1", "u1", codes.OK},
+		{"otro usuario sin permiso", withCaller("u1", RoleLeagueAdmin), "u2", "", codes.PermissionDenied},
+		{"site_admin por otro usuario", withCaller("admin", RoleSiteAdmin), "u2", "u2", codes.OK},
+	}
+	for _, tt := range tests {
+		got, err := ActingUser(tt.ctx, tt.userID)
+		if got != tt.want || status.Code(err) != tt.wantCode {
+			t.Errorf("%s: ActingUser() = %q, %v, want %q, %v", tt.name, got, err, tt.want, tt.wantCode)
+		}
+	}
+}
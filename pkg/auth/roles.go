@@ -0,0 +1,47 @@
+package auth
+
+// Role es el rol de un usuario en el sitio
+type Role string
+
+const (
+	RolePlayer      Role = "player"       // Predice y juega en ligas (todos los usuarios)
+	RoleLeagueAdmin Role = "league_admin" // Administra cualquier liga privada
+	RoleScoreKeeper Role = "score_keeper" // Carga marcadores, anotaciones, reloj y estado de los juegos
+	RoleSiteAdmin   Role = "site_admin"   // Puede llamar a todos los métodos privilegiados
+)
+
+// Roles son todos los roles válidos
+var Roles = []Role{RolePlayer, RoleLeagueAdmin, RoleScoreKeeper, RoleSiteAdmin}
+
+// Valid indica si el rol es uno de los definidos
+func (r Role) Valid() bool {
+	for _, role := range Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleOf devuelve el rol de los claims; los tokens sin rol son de un player
+func (c Claims) RoleOf() Role {
+	if c.Role == "" {
+		return RolePlayer
+	}
+	return c.Role
+}
+
+// HasRole indica si el usuario tiene alguno de los roles. Un site_admin
+// cumple con cualquiera.
+func (c Claims) HasRole(roles ...Role) bool {
+	role := c.RoleOf()
+	if role == RoleSiteAdmin {
+		return true
+	}
+	for _, allowed := range roles {
+		if role == allowed {
+			return true
+		}
+	}
+	return false
+}
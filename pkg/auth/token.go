@@ -0,0 +1,127 @@
+// Package auth firma y verifica los access tokens de sesión.
+//
+// Los tokens son JWT firmados con HMAC-SHA256 (HS256) usando el secreto
+// compartido AUTH_TOKEN_SECRET. El User Service los emite al registrar o
+// iniciar sesión y el Gateway los verifica localmente en cada request, sin
+// llamar al User Service. El Gateway los reenvía a los servicios en la
+// metadata de cada llamado gRPC, donde UnaryServerInterceptor vuelve a
+// verificarlos y controla el rol antes de los métodos privilegiados.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid access token")
+	ErrTokenExpired = errors.New("access token expired")
+)
+
+// DefaultAccessTokenTTL es la duración de un access token si no se configura AUTH_ACCESS_TOKEN_TTL
+const DefaultAccessTokenTTL = 15 * time.Minute
+
+// Claims son los datos firmados dentro de un access token
+type Claims struct {
+	Subject   string `json:"sub"`            // ID del usuario
+	Role      Role   `json:"role,omitempty"` // Rol al emitir el token; vacío = player
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer emite y verifica access tokens con un secreto compartido
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewSigner(secret string, ttl time.Duration) (*Signer, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("auth: token secret must be at least 32 characters")
+	}
+	if ttl <= 0 {
+		ttl = DefaultAccessTokenTTL
+	}
+	return &Signer{secret: []byte(secret), ttl: ttl}, nil
+}
+
+// NewSignerFromEnv crea un Signer a partir de AUTH_TOKEN_SECRET y
+// AUTH_ACCESS_TOKEN_TTL (por ejemplo "15m")
+func NewSignerFromEnv() (*Signer, error) {
+	ttl := DefaultAccessTokenTTL
+	if value := os.Getenv("AUTH_ACCESS_TOKEN_TTL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("auth: invalid AUTH_ACCESS_TOKEN_TTL %q: %w", value, err)
+		}
+		ttl = parsed
+	}
+	return NewSigner(os.Getenv("AUTH_TOKEN_SECRET"), ttl)
+}
+
+// Issue firma un access token para el usuario y devuelve su vencimiento. Un
+// cambio de rol se refleja en el próximo token (al renovar la sesión).
+func (s *Signer) Issue(userID string, role Role, now time.Time) (string, time.Time) {
+	expiresAt := now.Add(s.ttl)
+	claims, _ := json.Marshal(Claims{
+		Subject:   userID,
+		Role:      role,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+	return unsigned + "." + s.sign(unsigned), expiresAt
+}
+
+// Verify comprueba la firma y el vencimiento de un access token
+func (s *Signer) Verify(token string, now time.Time) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != jwtHeader {
+		return Claims{}, ErrInvalidToken
+	}
+
+	expected := s.sign(parts[0] + "." + parts[1])
+	if !hmac.Equal([]byte(parts[2]), []byte(expected)) {
+		return Claims{}, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Claims{}, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Subject == "" {
+		return Claims{}, ErrInvalidToken
+	}
+
+	if now.Unix() >= claims.ExpiresAt {
+		return Claims{}, ErrTokenExpired
+	}
+	return claims, nil
+}
+
+// BearerToken extrae el token de un header "Authorization: Bearer <token>"
+func BearerToken(header string) (string, bool) {
+	const prefix = "Bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
+
+// jwtHeader es {"alg":"HS256","typ":"JWT"} codificado; es el único que se acepta
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func (s *Signer) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestNewSigner(t *testing.T) {
+	if _, err := NewSigner("too-short", time.Minute); err == nil {
+		t.Error("NewSigner() accepted a secret shorter than 32 characters")
+	}
+	signer, err := NewSigner(testSecret, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.ttl != DefaultAccessTokenTTL {
+		t.Errorf("ttl = %v, want %v", signer.ttl, DefaultAccessTokenTTL)
+	}
+
+	t.Setenv("AUTH_TOKEN_SECRET", testSecret)
+	t.Setenv("AUTH_ACCESS_TOKEN_TTL", "5m")
+	signer, err = NewSignerFromEnv()
+	if err != nil || signer.ttl != 5*time.Minute {
+		t.Errorf("NewSignerFromEnv() = %v, %v, want a 5m signer", signer, err)
+	}
+	t.Setenv("AUTH_ACCESS_TOKEN_TTL", "soon")
+	if _, err := NewSignerFromEnv(); err == nil {
+		t.Error("NewSignerFromEnv() accepted an invalid AUTH_ACCESS_TOKEN_TTL")
+	}
+}
+
+func TestIssueAndVerify(t *testing.T) {
+	signer, err := NewSigner(testSecret, 15*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := NewSigner(strings.Repeat("x", 32), 15*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, 9, 10, 20, 0, 0, 0, time.UTC)
+
+	token, expiresAt := signer.Issue("user_1", RoleScoreKeeper, now)
+	if !expiresAt.Equal(now.Add(15 * time.Minute)) {
+		t.Errorf("expiresAt = %v, want %v", expiresAt, now.Add(15*time.Minute))
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("token %q is not a JWT", token)
+	}
+
+	// Cambiar un byte del payload invalida la firma
+	tampered := parts[0] + "." + parts[1][:len(parts[1])-1] + "A." + parts[2]
+	if parts[1][len(parts[1])-1] == 'A' {
+		tampered = parts[0] + "." + parts[1][:len(parts[1])-1] + "B." + parts[2]
+	}
+
+	tests := []struct {
+		name    string
+		signer  *Signer
+		token   string
+		now     time.Time
+		wantErr error
+	}{
+		{"válido", signer, token, now.Add(time.Minute), nil},
+		{"un segundo antes de vencer", signer, token, expiresAt.Add(-time.Second), nil},
+		{"vencido", signer, token, expiresAt, ErrTokenExpired},
+		{"otro secreto", other, token, now, ErrInvalidToken},
+		{"payload alterado", signer, tampered, now, ErrInvalidToken},
+		{"sin firma", signer, parts[0] + "." + parts[1], now, ErrInvalidToken},
+		{"otro algoritmo", signer, "eyJhbGciOiJub25lIn0." + parts[1] + "." + parts[2], now, ErrInvalidToken},
+		{"vacío", signer, "", now, ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		claims, err := tt.signer.Verify(tt.token, tt.now)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: Verify() error = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (claims.Subject != "user_1" || claims.Role != RoleScoreKeeper || claims.IssuedAt != now.Unix() || claims.ExpiresAt != expiresAt.Unix()) {
+			t.Errorf("%s: Verify() claims = %+v", tt.name, claims)
+		}
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+		wantOK bool
+	}{
+		{"Bearer abc.def.ghi", "abc.def.ghi", true},
+		{"bearer abc.def.ghi ", "abc.def.ghi", true},
+		{"Bearer ", "", false},
+		{"Basic dXNlcjpwYXNz", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := BearerToken(tt.header)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("BearerToken(%q) = %q, %v, want %q, %v", tt.header, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
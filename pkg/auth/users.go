@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "kickoff.com/proto"
+)
+
+// UserCacheTTL es cuánto se recuerda si un usuario está activo en los
+// servicios que se lo preguntan al User Service
+const UserCacheTTL = 30 * time.Second
+
+// UserServiceActive consulta al User Service si un usuario sigue activo (un
+// usuario inexistente cuenta como inactivo), con la respuesta en cache por
+// UserCacheTTL
+func UserServiceActive(client pb.UserServiceClient) ActiveFunc {
+	return CacheActive(func(ctx context.Context, userID string) (bool, error) {
+		resp, err := client.GetUserByID(ctx, &pb.GetUserByIDRequest{UserId: userID})
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return resp.User.GetActive(), nil
+	}, UserCacheTTL)
+}
@@ -0,0 +1,134 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Type identifica el tipo de un evento de dominio
+type Type string
+
+const (
+	TypeGameScheduled        Type = "GameScheduled"
+	TypeGameStatusChanged    Type = "GameStatusChanged"
+	TypeGameRescheduled      Type = "GameRescheduled"
+	TypeScoreUpdated         Type = "ScoreUpdated"
+	TypePredictionCreated    Type = "PredictionCreated"
+	TypePredictionGraded     Type = "PredictionGraded"
+	TypeUserDeactivated      Type = "UserDeactivated"
+	TypeSurvivorEntryUpdated Type = "SurvivorEntryUpdated"
+	TypeBracketScored        Type = "BracketScored"
+)
+
+// Event es un evento de dominio tal como viaja por el transporte
+type Event struct {
+	ID          string          `json:"id"`
+	Type        Type            `json:"type"`
+	Source      string          `json:"source"`      // Servicio que lo emitió
+	AggregateID string          `json:"aggregateId"` // Entidad afectada (game_id, user_id, ...)
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurredAt"`
+	Sequence    int64           `json:"sequence,omitempty"` // Posición en el log del transporte
+}
+
+// Decode deserializa el payload del evento en v
+func (e Event) Decode(v interface{}) error {
+	return json.Unmarshal(e.Payload, v)
+}
+
+// ========================================
+// Payloads
+// ========================================
+
+type GameScheduled struct {
+	GameID     string    `json:"gameId"`
+	Week       int       `json:"week"`
+	Season     int       `json:"season"`
+	HomeTeamID string    `json:"homeTeamId"`
+	AwayTeamID string    `json:"awayTeamId"`
+	GameTime   time.Time `json:"gameTime"`
+}
+
+type GameStatusChanged struct {
+	GameID         string `json:"gameId"`
+	PreviousStatus string `json:"previousStatus"`
+	Status         string `json:"status"`
+	HomeScore      int    `json:"homeScore"`
+	AwayScore      int    `json:"awayScore"`
+	WinnerTeamID   string `json:"winnerTeamId,omitempty"`
+	Override       bool   `json:"override,omitempty"` // Cambio forzado fuera de las transiciones permitidas
+	Reason         string `json:"reason,omitempty"`
+}
+
+// GameRescheduled indica que un juego cambió de horario (y quizás de semana).
+// PredictionPolicy dice qué hacer con sus predicciones pendientes: "keep" o "void".
+type GameRescheduled struct {
+	GameID           string    `json:"gameId"`
+	Season           int       `json:"season"`
+	PreviousWeek     int       `json:"previousWeek"`
+	Week             int       `json:"week"`
+	PreviousGameTime time.Time `json:"previousGameTime"`
+	GameTime         time.Time `json:"gameTime"`
+	PredictionPolicy string    `json:"predictionPolicy"`
+	Reason           string    `json:"reason,omitempty"`
+}
+
+// Políticas de GameRescheduled para las predicciones pendientes
+const (
+	PredictionPolicyKeep = "keep"
+	PredictionPolicyVoid = "void"
+)
+
+type ScoreUpdated struct {
+	GameID    string `json:"gameId"`
+	HomeScore int    `json:"homeScore"`
+	AwayScore int    `json:"awayScore"`
+}
+
+type PredictionCreated struct {
+	PredictionID      string `json:"predictionId"`
+	UserID            string `json:"userId"`
+	GameID            string `json:"gameId"`
+	PredictedWinnerID string `json:"predictedWinnerId"`
+}
+
+type PredictionGraded struct {
+	PredictionID string `json:"predictionId"`
+	UserID       string `json:"userId"`
+	GameID       string `json:"gameId"`
+	Result       string `json:"result"` // "pending", "correct", "incorrect", "void"
+	Points       int    `json:"points"`
+	Season       int    `json:"season,omitempty"`
+	Week         int    `json:"week,omitempty"`
+	// AgainstSpread marca los picks ATS; Push, los anulados porque el
+	// margen final quedó exactamente en la línea
+	AgainstSpread bool `json:"againstSpread,omitempty"`
+	Push          bool `json:"push,omitempty"`
+}
+
+// SurvivorEntryUpdated lleva el estado completo de la participación de un
+// usuario en el survivor pool de una temporada; Picks == 0 indica que la
+// participación se retiró (borró su único pick)
+type SurvivorEntryUpdated struct {
+	UserID         string `json:"userId"`
+	Season         int    `json:"season"`
+	Alive          bool   `json:"alive"`
+	EliminatedWeek int    `json:"eliminatedWeek,omitempty"`
+	WeeksSurvived  int    `json:"weeksSurvived"`
+	Picks          int    `json:"picks"`
+}
+
+// BracketScored lleva el puntaje completo del bracket de playoffs de un
+// usuario; se emite al guardar el bracket y cada vez que se recalifica
+type BracketScored struct {
+	UserID    string `json:"userId"`
+	Season    int    `json:"season"`
+	Points    int    `json:"points"`
+	Correct   int    `json:"correct"`
+	Incorrect int    `json:"incorrect"`
+	MaxPoints int    `json:"maxPoints"`
+}
+
+type UserDeactivated struct {
+	UserID string `json:"userId"`
+}
@@ -0,0 +1,231 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testDB abre una base SQLite en memoria con las tablas de eventos. Una sola
+// conexión alcanza: los handlers de estos tests no usan la base.
+func testDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&OutboxEvent{}, &DomainEvent{}, &EventConsumer{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestIsPermanent(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"marcado", Permanent(errors.New("bad payload")), true},
+		{"marcado y envuelto", fmt.Errorf("grading: %w", Permanent(errors.New("bad payload"))), true},
+		{"NotFound", status.Error(codes.NotFound, "game not found"), true},
+		{"InvalidArgument envuelto", fmt.Errorf("fetch: %w", status.Error(codes.InvalidArgument, "bad id")), true},
+		{"Unavailable", status.Error(codes.Unavailable, "connection refused"), false},
+		{"error de base", errors.New("connection reset"), false},
+	}
+	for _, tt := range tests {
+		if got := IsPermanent(tt.err); got != tt.want {
+			t.Errorf("%s: IsPermanent(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+	if Permanent(nil) != nil {
+		t.Error("Permanent(nil) should be nil")
+	}
+}
+
+// recordingTransport guarda lo publicado; failWith simula un transporte caído
+type recordingTransport struct {
+	published []Event
+	failWith  error
+}
+
+func (r *recordingTransport) Publish(ctx context.Context, events ...Event) error {
+	if r.failWith != nil {
+		return r.failWith
+	}
+	r.published = append(r.published, events...)
+	return nil
+}
+
+func (r *recordingTransport) Subscribe(ctx context.Context, consumer string, handler Handler, types ...Type) error {
+	return nil
+}
+
+func TestPublishIsTransactional(t *testing.T) {
+	db := testDB(t)
+	payload := GameStatusChanged{GameID: "game_1", Status: "completed"}
+
+	rollback := errors.New("rollback")
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := Publish(tx, "game", TypeGameStatusChanged, "game_1", payload); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("Transaction() error = %v", err)
+	}
+	var count int64
+	db.Model(&OutboxEvent{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("rolled back transaction left %d outbox events", count)
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		return Publish(tx, "game", TypeGameStatusChanged, "game_1", payload)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var saved OutboxEvent
+	if err := db.First(&saved).Error; err != nil {
+		t.Fatal(err)
+	}
+	if saved.Type != string(TypeGameStatusChanged) || saved.Source != "game" || saved.AggregateID != "game_1" || saved.PublishedAt != nil {
+		t.Errorf("saved event = %+v", saved)
+	}
+}
+
+func TestRelayBatch(t *testing.T) {
+	db := testDB(t)
+	for _, gameID := range []string{"game_1", "game_2", "game_3"} {
+		if err := Publish(db, "game", TypeGameStatusChanged, gameID, GameStatusChanged{GameID: gameID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx := context.Background()
+
+	// Con el transporte caído el lote queda pendiente para el próximo intento
+	transport := &recordingTransport{failWith: errors.New("events_db unavailable")}
+	relay := NewRelay(db, transport)
+	if published, err := relay.relayBatch(ctx); err == nil || published != 0 {
+		t.Fatalf("relayBatch() = %d, %v, want an error", published, err)
+	}
+	var pending int64
+	db.Model(&OutboxEvent{}).Where("published_at IS NULL").Count(&pending)
+	if pending != 3 {
+		t.Fatalf("%d pending events after a failed publish, want 3", pending)
+	}
+
+	transport.failWith = nil
+	relay.BatchSize = 2
+	for _, want := range []int{2, 1, 0} {
+		published, err := relay.relayBatch(ctx)
+		if err != nil || published != want {
+			t.Fatalf("relayBatch() = %d, %v, want %d", published, err, want)
+		}
+	}
+	if len(transport.published) != 3 {
+		t.Fatalf("published %d events, want 3", len(transport.published))
+	}
+	for i, gameID := range []string{"game_1", "game_2", "game_3"} {
+		if got := transport.published[i].AggregateID; got != gameID {
+			t.Errorf("event %d is for %s, want %s (outbox order)", i, got, gameID)
+		}
+	}
+}
+
+// seedLog agrega eventos al log compartido como lo haría Publish, sin el
+// advisory lock que SQLite no tiene
+func seedLog(t *testing.T, db *gorm.DB, types ...Type) {
+	t.Helper()
+	for i, eventType := range types {
+		row := DomainEvent{
+			EventID:     fmt.Sprintf("evt_%d", i+1),
+			Type:        string(eventType),
+			Source:      "test",
+			AggregateID: fmt.Sprintf("game_%d", i+1),
+			Payload:     "{}",
+		}
+		if err := db.Create(&row).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func consumerPosition(t *testing.T, db *gorm.DB, consumer string) int64 {
+	t.Helper()
+	var state EventConsumer
+	if err := db.Where("name = ?", consumer).First(&state).Error; err != nil {
+		t.Fatal(err)
+	}
+	return state.LastSequence
+}
+
+func TestDeliverBatch(t *testing.T) {
+	const consumer = "prediction.settlement"
+	db := testDB(t)
+	seedLog(t, db, TypeGameStatusChanged, TypePredictionGraded, TypeGameStatusChanged, TypeGameStatusChanged)
+	if err := db.Create(&EventConsumer{Name: consumer}).Error; err != nil {
+		t.Fatal(err)
+	}
+	transport := &PostgresTransport{DB: db, BatchSize: 100}
+	ctx := context.Background()
+
+	// game_3 falla primero por una caída, después porque el juego no existe
+	var seen []string
+	errs := map[string][]error{
+		"game_3": {status.Error(codes.Unavailable, "game service down"), status.Error(codes.NotFound, "game not found")},
+	}
+	handler := func(ctx context.Context, event Event) error {
+		seen = append(seen, event.AggregateID)
+		if queue := errs[event.AggregateID]; len(queue) > 0 {
+			errs[event.AggregateID] = queue[1:]
+			return queue[0]
+		}
+		return nil
+	}
+	types := []Type{TypeGameStatusChanged}
+
+	steps := []struct {
+		name          string
+		wantDelivered int
+		wantFailed    bool
+		wantPosition  int64
+		wantSeen      []string
+	}{
+		// El error transitorio frena al consumidor en game_3 sin perder lo anterior
+		{"transitorio", 2, true, 2, []string{"game_1", "game_3"}},
+		// El reintento encuentra un error permanente: se saltea y sigue
+		{"permanente", 2, false, 4, []string{"game_3", "game_4"}},
+		{"al día", 0, false, 4, nil},
+	}
+	for _, step := range steps {
+		seen = nil
+		delivered, failed, err := transport.deliverBatch(ctx, consumer, handler, types)
+		if err != nil {
+			t.Fatalf("%s: deliverBatch() error = %v", step.name, err)
+		}
+		if delivered != step.wantDelivered || failed != step.wantFailed {
+			t.Errorf("%s: deliverBatch() = %d, %v, want %d, %v", step.name, delivered, failed, step.wantDelivered, step.wantFailed)
+		}
+		if got := consumerPosition(t, db, consumer); got != step.wantPosition {
+			t.Errorf("%s: position = %d, want %d", step.name, got, step.wantPosition)
+		}
+		if fmt.Sprint(seen) != fmt.Sprint(step.wantSeen) {
+			t.Errorf("%s: handled %v, want %v", step.name, seen, step.wantSeen)
+		}
+	}
+}
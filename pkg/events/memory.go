@@ -0,0 +1,86 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// MemoryTransport es un transporte en proceso, útil para desarrollo local
+// cuando todos los publicadores y suscriptores viven en el mismo binario
+type MemoryTransport struct {
+	mu      sync.Mutex
+	log     []Event
+	seen    map[string]bool
+	offsets map[string]int64
+	notify  chan struct{}
+}
+
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{
+		seen:    make(map[string]bool),
+		offsets: make(map[string]int64),
+		notify:  make(chan struct{}),
+	}
+}
+
+func (m *MemoryTransport) Publish(ctx context.Context, events ...Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, event := range events {
+		if m.seen[event.ID] {
+			continue
+		}
+		m.seen[event.ID] = true
+		event.Sequence = int64(len(m.log)) + 1
+		m.log = append(m.log, event)
+	}
+
+	// Despertar a los suscriptores que esperan eventos nuevos
+	close(m.notify)
+	m.notify = make(chan struct{})
+	return nil
+}
+
+func (m *MemoryTransport) Subscribe(ctx context.Context, consumer string, handler Handler, types ...Type) error {
+	for {
+		m.mu.Lock()
+		offset := m.offsets[consumer]
+		pending := append([]Event(nil), m.log[offset:]...)
+		wait := m.notify
+		m.mu.Unlock()
+
+		for _, event := range pending {
+			if matches(types, event.Type) {
+				if err := handler(ctx, event); err != nil {
+					if !IsPermanent(err) {
+						log.Printf("Event %s (%s) failed for %s: %v", event.ID, event.Type, consumer, err)
+						break
+					}
+					log.Printf("Event consumer %s: skipping event %s (%s): %v", consumer, event.ID, event.Type, err)
+				}
+			}
+			m.mu.Lock()
+			m.offsets[consumer] = event.Sequence
+			m.mu.Unlock()
+		}
+
+		m.mu.Lock()
+		caughtUp := m.offsets[consumer] == int64(len(m.log))
+		m.mu.Unlock()
+
+		if !caughtUp {
+			if !sleep(ctx, retryDelay) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
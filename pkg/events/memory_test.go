@@ -0,0 +1,125 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// collect suscribe un handler al transporte y devuelve los agregados que vio
+// una vez que llegaron want eventos (o falla por timeout)
+func collect(t *testing.T, transport Transport, want int, handler Handler, types ...Type) []string {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	seen := make(chan string, 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- transport.Subscribe(ctx, "test", func(ctx context.Context, event Event) error {
+			seen <- event.AggregateID
+			if handler != nil {
+				return handler(ctx, event)
+			}
+			return nil
+		}, types...)
+	}()
+
+	var got []string
+	timeout := time.After(2 * time.Second)
+	for len(got) < want {
+		select {
+		case id := <-seen:
+			got = append(got, id)
+		case <-timeout:
+			t.Fatalf("received %v, want %d events", got, want)
+		}
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Subscribe() error = %v, want context.Canceled", err)
+	}
+	return got
+}
+
+func event(id string, eventType Type, gameID string) Event {
+	return Event{ID: id, Type: eventType, Source: "test", AggregateID: gameID, Payload: []byte("{}")}
+}
+
+func TestMemoryTransportOrderAndFilter(t *testing.T) {
+	transport := NewMemoryTransport()
+	ctx := context.Background()
+	if err := transport.Publish(ctx,
+		event("evt_1", TypeGameStatusChanged, "game_1"),
+		event("evt_2", TypePredictionGraded, "game_1"),
+		event("evt_3", TypeScoreUpdated, "game_2"),
+	); err != nil {
+		t.Fatal(err)
+	}
+	// Republicar el mismo ID (un relay que reintenta) no duplica el evento
+	if err := transport.Publish(ctx, event("evt_1", TypeGameStatusChanged, "game_1"), event("evt_4", TypeGameStatusChanged, "game_3")); err != nil {
+		t.Fatal(err)
+	}
+
+	got := collect(t, transport, 3, nil, TypeGameStatusChanged, TypeScoreUpdated)
+	if fmt.Sprint(got) != "[game_1 game_2 game_3]" {
+		t.Errorf("delivered %v, want [game_1 game_2 game_3]", got)
+	}
+	if len(transport.log) != 4 {
+		t.Errorf("log has %d events, want 4", len(transport.log))
+	}
+	if offset := transport.offsets["test"]; offset != 4 {
+		t.Errorf("offset = %d, want 4", offset)
+	}
+}
+
+func TestMemoryTransportErrors(t *testing.T) {
+	defer func(delay time.Duration) { retryDelay = delay }(retryDelay)
+	retryDelay = time.Millisecond
+
+	transport := NewMemoryTransport()
+	if err := transport.Publish(context.Background(),
+		event("evt_1", TypeGameStatusChanged, "game_1"),
+		event("evt_2", TypeGameStatusChanged, "game_2"),
+		event("evt_3", TypeGameStatusChanged, "game_3"),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// game_1 falla una vez por una caída y se reintenta; game_2 no existe y se saltea
+	failures := map[string]error{"game_1": status.Error(codes.Unavailable, "game service down")}
+	handler := func(ctx context.Context, event Event) error {
+		if event.AggregateID == "game_2" {
+			return status.Error(codes.NotFound, "game not found")
+		}
+		err := failures[event.AggregateID]
+		delete(failures, event.AggregateID)
+		return err
+	}
+
+	got := collect(t, transport, 4, handler)
+	if fmt.Sprint(got) != "[game_1 game_1 game_2 game_3]" {
+		t.Errorf("delivered %v, want [game_1 game_1 game_2 game_3]", got)
+	}
+}
+
+func TestNewTransportFromEnv(t *testing.T) {
+	t.Setenv("EVENT_TRANSPORT", "memory")
+	transport, err := NewTransportFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := transport.(*MemoryTransport); !ok {
+		t.Errorf("NewTransportFromEnv() = %T, want *MemoryTransport", transport)
+	}
+
+	t.Setenv("EVENT_TRANSPORT", "kafka")
+	if _, err := NewTransportFromEnv(); err == nil {
+		t.Error("NewTransportFromEnv() accepted an unknown transport")
+	}
+}
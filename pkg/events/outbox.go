@@ -0,0 +1,122 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/pkg/idgen"
+)
+
+// OutboxEvent es un evento pendiente de publicar, guardado en la base de datos
+// del propio servicio dentro de la misma transacción que el cambio de estado
+type OutboxEvent struct {
+	ID          string     `gorm:"primaryKey;type:varchar(50)"`
+	Type        string     `gorm:"not null;type:varchar(50)"`
+	Source      string     `gorm:"not null;type:varchar(50)"`
+	AggregateID string     `gorm:"type:varchar(50);index"`
+	Payload     string     `gorm:"type:jsonb;not null"`
+	OccurredAt  time.Time  `gorm:"not null;index"`
+	PublishedAt *time.Time `gorm:"index"`
+}
+
+// TableName especifica el nombre de la tabla
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// Publish registra un evento en el outbox usando la transacción tx, de modo
+// que el evento solo existe si el cambio de estado que lo origina se confirma
+func Publish(tx *gorm.DB, source string, eventType Type, aggregateID string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+
+	return tx.Create(&OutboxEvent{
+		ID:          idgen.New(idgen.PrefixEvent),
+		Type:        string(eventType),
+		Source:      source,
+		AggregateID: aggregateID,
+		Payload:     string(data),
+		OccurredAt:  time.Now().UTC(),
+	}).Error
+}
+
+// Relay copia periódicamente los eventos no publicados del outbox al transporte
+type Relay struct {
+	DB        *gorm.DB
+	Transport Transport
+	Interval  time.Duration
+	BatchSize int
+}
+
+func NewRelay(db *gorm.DB, transport Transport) *Relay {
+	return &Relay{
+		DB:        db,
+		Transport: transport,
+		Interval:  500 * time.Millisecond,
+		BatchSize: 100,
+	}
+}
+
+// Run publica el outbox hasta que se cancela el contexto
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		published, err := r.relayBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Outbox relay error: %v", err)
+		}
+
+		if published < r.BatchSize {
+			if !sleep(ctx, r.Interval) {
+				return
+			}
+		}
+	}
+}
+
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	published := 0
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED permite que varias réplicas del servicio corran el relay
+		var pending []OutboxEvent
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").
+			Order("occurred_at").
+			Limit(r.BatchSize).
+			Find(&pending).Error; err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		batch := make([]Event, 0, len(pending))
+		ids := make([]string, 0, len(pending))
+		for _, row := range pending {
+			batch = append(batch, Event{
+				ID:          row.ID,
+				Type:        Type(row.Type),
+				Source:      row.Source,
+				AggregateID: row.AggregateID,
+				Payload:     json.RawMessage(row.Payload),
+				OccurredAt:  row.OccurredAt,
+			})
+			ids = append(ids, row.ID)
+		}
+
+		if err := r.Transport.Publish(ctx, batch...); err != nil {
+			return err
+		}
+
+		published = len(pending)
+		return tx.Model(&OutboxEvent{}).Where("id IN ?", ids).Update("published_at", time.Now().UTC()).Error
+	})
+	return published, err
+}
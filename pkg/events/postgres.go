@@ -0,0 +1,268 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+// DomainEvent es una fila del log compartido de eventos (events_db)
+type DomainEvent struct {
+	Sequence    int64     `gorm:"primaryKey;autoIncrement"`
+	EventID     string    `gorm:"uniqueIndex;not null;type:varchar(50)"`
+	Type        string    `gorm:"not null;type:varchar(50);index"`
+	Source      string    `gorm:"not null;type:varchar(50)"`
+	AggregateID string    `gorm:"type:varchar(50);index"`
+	Payload     string    `gorm:"type:jsonb;not null"`
+	OccurredAt  time.Time `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (DomainEvent) TableName() string {
+	return "domain_events"
+}
+
+// EventConsumer guarda hasta qué secuencia procesó cada consumidor
+type EventConsumer struct {
+	Name         string    `gorm:"primaryKey;type:varchar(100)"`
+	LastSequence int64     `gorm:"not null;default:0"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (EventConsumer) TableName() string {
+	return "event_consumers"
+}
+
+// publishLockKey identifica el advisory lock que serializa las publicaciones
+// en domain_events
+const publishLockKey int64 = 0x6b69636b6f6666 // "kickoff"
+
+// PostgresTransport usa una base de datos compartida (events_db) como log de
+// eventos. Cada consumidor lee por polling a partir de su última secuencia;
+// las réplicas de un mismo servicio comparten el nombre del consumidor y se
+// coordinan con un lock de fila, así cada evento lo procesa una sola réplica.
+// Las publicaciones se serializan con un advisory lock, así una secuencia solo
+// se vuelve visible cuando todas las menores ya están confirmadas.
+type PostgresTransport struct {
+	DB           *gorm.DB
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// NewPostgresTransport se conecta a la base de eventos (EVENTS_DB_NAME, por
+// defecto events_db) con las mismas credenciales que el servicio, creándola si
+// todavía no existe
+func NewPostgresTransport() (*PostgresTransport, error) {
+	dbname := getEnv("EVENTS_DB_NAME", "events_db")
+
+	db, err := openPostgres(dbname)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) || pgErr.Code != "3D000" {
+			return nil, fmt.Errorf("failed to connect to events database: %w", err)
+		}
+		// La base no existe (volumen de PostgreSQL creado antes de events_db)
+		if err := createDatabase(dbname); err != nil {
+			return nil, fmt.Errorf("failed to create events database: %w", err)
+		}
+		if db, err = openPostgres(dbname); err != nil {
+			return nil, fmt.Errorf("failed to connect to events database: %w", err)
+		}
+	}
+
+	if err := db.AutoMigrate(&DomainEvent{}, &EventConsumer{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate events database: %w", err)
+	}
+
+	log.Println("✅ Connected to PostgreSQL events database:", dbname)
+
+	return &PostgresTransport{
+		DB:           db,
+		PollInterval: 500 * time.Millisecond,
+		BatchSize:    100,
+	}, nil
+}
+
+func (p *PostgresTransport) Publish(ctx context.Context, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]DomainEvent, 0, len(events))
+	for _, event := range events {
+		rows = append(rows, DomainEvent{
+			EventID:     event.ID,
+			Type:        string(event.Type),
+			Source:      event.Source,
+			AggregateID: event.AggregateID,
+			Payload:     string(event.Payload),
+			OccurredAt:  event.OccurredAt,
+		})
+	}
+
+	return p.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Los consumidores avanzan por secuencia: si una transacción con una
+		// secuencia mayor confirmara antes que otra con una menor, el consumidor
+		// pasaría de largo la menor y ese evento no se entregaría nunca. El lock
+		// serializa las publicaciones (de todos los relays y réplicas) para que
+		// las secuencias se confirmen en orden.
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", publishLockKey).Error; err != nil {
+			return err
+		}
+
+		// Ignorar eventos ya publicados (el relay puede reintentar un lote)
+		return tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "event_id"}}, DoNothing: true}).
+			Create(&rows).Error
+	})
+}
+
+func (p *PostgresTransport) Subscribe(ctx context.Context, consumer string, handler Handler, types ...Type) error {
+	// Registrar el consumidor desde el principio del log si es nuevo
+	if err := p.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&EventConsumer{Name: consumer}).Error; err != nil {
+		return fmt.Errorf("failed to register consumer %s: %w", consumer, err)
+	}
+
+	for {
+		delivered, failed, err := p.deliverBatch(ctx, consumer, handler, types)
+		if err == nil && failed {
+			if !sleep(ctx, retryDelay) {
+				return ctx.Err()
+			}
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Event consumer %s: %v", consumer, err)
+			if !sleep(ctx, retryDelay) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		if delivered < p.BatchSize {
+			if !sleep(ctx, p.PollInterval) {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// deliverBatch procesa el siguiente lote de eventos para el consumidor y
+// avanza su posición hasta el último evento procesado con éxito. failed indica
+// que un handler falló y el evento debe reintentarse más tarde; los eventos con
+// un error permanente se registran y se saltean.
+func (p *PostgresTransport) deliverBatch(ctx context.Context, consumer string, handler Handler, types []Type) (delivered int, failed bool, err error) {
+	err = p.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var state EventConsumer
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("name = ?", consumer).
+			Limit(1).
+			Find(&state)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// Otra réplica está procesando este consumidor
+			return nil
+		}
+
+		var rows []DomainEvent
+		if err := tx.Where("sequence > ?", state.LastSequence).
+			Order("sequence").
+			Limit(p.BatchSize).
+			Find(&rows).Error; err != nil {
+			return err
+		}
+
+		last := state.LastSequence
+		var handlerErr error
+		for _, row := range rows {
+			event := row.toEvent()
+			if matches(types, event.Type) {
+				if err := handler(ctx, event); err != nil {
+					if !IsPermanent(err) {
+						handlerErr = fmt.Errorf("event %s (%s) failed: %w", event.ID, event.Type, err)
+						break
+					}
+					log.Printf("Event consumer %s: skipping event %s (%s): %v", consumer, event.ID, event.Type, err)
+				}
+			}
+			last = row.Sequence
+			delivered++
+		}
+
+		if last != state.LastSequence {
+			if err := tx.Model(&state).Update("last_sequence", last).Error; err != nil {
+				return err
+			}
+		}
+		if handlerErr != nil {
+			// Confirmar el avance parcial; el evento fallido se reintenta
+			log.Printf("Event consumer %s: %v", consumer, handlerErr)
+			failed = true
+		}
+		return nil
+	})
+	return delivered, failed, err
+}
+
+func (row DomainEvent) toEvent() Event {
+	return Event{
+		ID:          row.EventID,
+		Type:        Type(row.Type),
+		Source:      row.Source,
+		AggregateID: row.AggregateID,
+		Payload:     []byte(row.Payload),
+		OccurredAt:  row.OccurredAt,
+		Sequence:    row.Sequence,
+	}
+}
+
+func openPostgres(dbname string) (*gorm.DB, error) {
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		getEnv("DB_HOST", "postgres-service"),
+		getEnv("DB_PORT", "5432"),
+		getEnv("DB_USER", "kickoff_user"),
+		getEnv("DB_PASSWORD", "kickoff_password_123"),
+		dbname)
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func createDatabase(dbname string) error {
+	admin, err := openPostgres("postgres")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	log.Printf("Creating events database %s", dbname)
+	return admin.Exec(fmt.Sprintf("CREATE DATABASE %q", dbname)).Error
+}
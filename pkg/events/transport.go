@@ -0,0 +1,119 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handler procesa un evento entregado a un suscriptor. Si devuelve error el
+// evento se vuelve a entregar más tarde (entrega at-least-once), así que los
+// handlers deben ser idempotentes. Un error permanente (ver IsPermanent) no se
+// reintenta: el evento se registra y se saltea.
+type Handler func(ctx context.Context, event Event) error
+
+// Transport entrega los eventos publicados por el relay a los suscriptores
+type Transport interface {
+	// Publish agrega eventos al log. Publicar dos veces el mismo ID no lo duplica.
+	Publish(ctx context.Context, events ...Event) error
+
+	// Subscribe entrega en orden los eventos de los tipos indicados (todos si
+	// no se indica ninguno) al consumidor con nombre dado, recordando hasta
+	// dónde llegó. Bloquea hasta que se cancela el contexto.
+	Subscribe(ctx context.Context, consumer string, handler Handler, types ...Type) error
+}
+
+// retryDelay es la espera antes de reintentar un evento cuyo handler falló
+var retryDelay = 2 * time.Second
+
+// NewTransportFromEnv crea el transporte configurado en EVENT_TRANSPORT:
+// "postgres" (por defecto, compartido entre servicios) o "memory" (en proceso)
+func NewTransportFromEnv() (Transport, error) {
+	switch kind := getEnv("EVENT_TRANSPORT", "postgres"); kind {
+	case "postgres":
+		return NewPostgresTransport()
+	case "memory":
+		return NewMemoryTransport(), nil
+	default:
+		return nil, fmt.Errorf("unknown EVENT_TRANSPORT %q", kind)
+	}
+}
+
+// Subscribe lanza una suscripción en segundo plano y registra si termina con error
+func Subscribe(ctx context.Context, transport Transport, consumer string, handler Handler, types ...Type) {
+	go func() {
+		if err := transport.Subscribe(ctx, consumer, handler, types...); err != nil && ctx.Err() == nil {
+			log.Printf("Event subscription %s stopped: %v", consumer, err)
+		}
+	}()
+}
+
+// Permanent marca el error de un handler como definitivo: reintentar el evento
+// no lo va a resolver, así que el consumidor lo saltea en lugar de quedarse
+// trabado en él
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// IsPermanent indica si el error de un handler es definitivo: marcado con
+// Permanent, o un error gRPC NotFound o InvalidArgument (el evento apunta a
+// algo que no existe o que el otro servicio rechaza). El resto, como una base
+// o un servicio caído, es transitorio y se reintenta.
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	if errors.As(err, &permanent) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.NotFound, codes.InvalidArgument:
+		return true
+	}
+	return false
+}
+
+func matches(types []Type, t Type) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// getEnv obtiene una variable de entorno o devuelve un valor por defecto
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
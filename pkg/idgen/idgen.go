@@ -0,0 +1,112 @@
+// Package idgen genera identificadores únicos, ordenables por tiempo y con
+// prefijo por entidad (user_, game_, pred_, ...).
+//
+// El cuerpo del ID es un ULID: 48 bits de timestamp en milisegundos seguidos
+// de 80 bits aleatorios, codificados en 26 caracteres Crockford base32. Dentro
+// del mismo milisegundo la parte aleatoria se incrementa, así los IDs generados
+// por un proceso son estrictamente crecientes.
+//
+// Los IDs antiguos basados en un contador (user_1, game_12, pred_7) siguen
+// siendo válidos: las columnas de ID son texto y ningún servicio interpreta su
+// contenido.
+package idgen
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Prefijos por entidad
+const (
+	PrefixUser       = "user"
+	PrefixGame       = "game"
+	PrefixPrediction = "pred"
+	PrefixEvent      = "evt"
+	PrefixSession    = "sess"
+	PrefixLeague     = "league"
+	PrefixBracket    = "bracket"
+	PrefixPlay       = "play"
+	PrefixStatus     = "status"
+)
+
+const (
+	encoding = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	bodyLen  = 26
+)
+
+var (
+	mu       sync.Mutex
+	lastMs   uint64
+	lastRand [10]byte
+)
+
+// New devuelve un nuevo ID con el prefijo indicado, por ejemplo
+// "user_01JBQ6Z3ZK8W9X4T2M5N7P0R1S"
+func New(prefix string) string {
+	return prefix + "_" + newBody(time.Now())
+}
+
+func newBody(now time.Time) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	ms := uint64(now.UnixMilli())
+	if ms <= lastMs {
+		// Mismo milisegundo (o reloj hacia atrás): incrementar la parte aleatoria
+		ms = lastMs
+		if !increment(&lastRand) {
+			ms++
+			fillRandom(&lastRand)
+		}
+	} else {
+		fillRandom(&lastRand)
+	}
+	lastMs = ms
+
+	return encode(ms, lastRand)
+}
+
+func increment(b *[10]byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func fillRandom(b *[10]byte) {
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("idgen: failed to read random bytes: %v", err))
+	}
+}
+
+// encode codifica 48 bits de tiempo + 80 bits aleatorios en 26 caracteres
+func encode(ms uint64, r [10]byte) string {
+	var out [bodyLen]byte
+
+	// Tiempo: 10 caracteres (50 bits, los 2 superiores siempre en cero)
+	for i := 9; i >= 0; i-- {
+		out[i] = encoding[ms&0x1f]
+		ms >>= 5
+	}
+
+	// Aleatorio: 16 caracteres de 5 bits cada uno
+	var acc uint64
+	bits := 0
+	pos := 10
+	for _, b := range r {
+		acc = acc<<8 | uint64(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out[pos] = encoding[(acc>>uint(bits))&0x1f]
+			pos++
+		}
+	}
+
+	return string(out[:])
+}
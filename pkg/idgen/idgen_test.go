@@ -0,0 +1,96 @@
+package idgen
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	prefixes := []string{PrefixUser, PrefixGame, PrefixPrediction, PrefixEvent, PrefixSession, PrefixLeague}
+	for _, prefix := range prefixes {
+		t.Run(prefix, func(t *testing.T) {
+			id := New(prefix)
+			body, ok := strings.CutPrefix(id, prefix+"_")
+			if !ok {
+				t.Fatalf("New(%q) = %q, missing prefix", prefix, id)
+			}
+			if len(body) != bodyLen {
+				t.Errorf("New(%q) body length = %d, want %d", prefix, len(body), bodyLen)
+			}
+			if i := strings.IndexFunc(body, func(r rune) bool { return !strings.ContainsRune(encoding, r) }); i >= 0 {
+				t.Errorf("New(%q) = %q has %q outside the Crockford alphabet", prefix, id, body[i])
+			}
+		})
+	}
+}
+
+func TestNewIsIncreasing(t *testing.T) {
+	prev := New(PrefixEvent)
+	for i := 0; i < 10000; i++ {
+		id := New(PrefixEvent)
+		if id <= prev {
+			t.Fatalf("New() = %q after %q, want strictly increasing", id, prev)
+		}
+		prev = id
+	}
+}
+
+func TestNewBodyClockBackwards(t *testing.T) {
+	now := time.Now()
+	first := newBody(now)
+	second := newBody(now.Add(-time.Hour))
+	if second <= first {
+		t.Errorf("newBody() = %q after %q with the clock going back, want increasing", second, first)
+	}
+	if first[:10] != second[:10] {
+		t.Errorf("newBody() timestamp = %q, want the previous %q", second[:10], first[:10])
+	}
+}
+
+func TestEncode(t *testing.T) {
+	var zero, ones [10]byte
+	for i := range ones {
+		ones[i] = 0xff
+	}
+	tests := []struct {
+		name string
+		ms   uint64
+		r    [10]byte
+		want string
+	}{
+		{"cero", 0, zero, "00000000000000000000000000"},
+		{"un milisegundo", 1, zero, "00000000010000000000000000"},
+		{"aleatorio máximo", 0, ones, "0000000000ZZZZZZZZZZZZZZZZ"},
+		{"timestamp de la spec de ULID", 1469918176385, zero, "01ARYZ6S410000000000000000"},
+		{"timestamp máximo", 1<<48 - 1, ones, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encode(tt.ms, tt.r); got != tt.want {
+				t.Errorf("encode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncrement(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     [10]byte
+		want   [10]byte
+		wantOK bool
+	}{
+		{"último byte", [10]byte{9: 1}, [10]byte{9: 2}, true},
+		{"acarreo", [10]byte{8: 1, 9: 0xff}, [10]byte{8: 2}, true},
+		{"desborde", [10]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, [10]byte{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.in
+			if ok := increment(&b); ok != tt.wantOK || b != tt.want {
+				t.Errorf("increment() = (%v, %v), want (%v, %v)", b, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
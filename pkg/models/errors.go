@@ -1,6 +1,15 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	rpccode "google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 var (
 	// User errors
@@ -32,8 +41,102 @@ var (
 	ErrUnauthorized       = errors.New("unauthorized")
 )
 
+// ErrorResponse es el cuerpo JSON de todos los errores de la API REST. Error
+// es el código gRPC por nombre (NOT_FOUND, INVALID_ARGUMENT...) y Code el
+// status HTTP con el que se respondió.
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
-	Code    int    `json:"code"`
+	Error   string           `json:"error"`
+	Message string           `json:"message"`
+	Code    int              `json:"code"`
+	Details []FieldViolation `json:"details,omitempty"`
+}
+
+// FieldViolation es un campo inválido del request, tomado del detalle
+// google.rpc.BadRequest del error gRPC
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// ========================================
+// gRPC errors
+// ========================================
+
+// InvalidField devuelve un InvalidArgument con el campo inválido como detalle
+// BadRequest, para que el cliente sepa qué corregir sin parsear el mensaje
+func InvalidField(field, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	st := status.New(codes.InvalidArgument, message)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: message}},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// HTTPStatusFromCode traduce un código gRPC al status HTTP de la API REST.
+// FailedPrecondition y Aborted son 409: el request es válido pero choca con
+// el estado actual (un juego que ya empezó, una predicción repetida).
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // Client Closed Request
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// NewErrorResponse arma el cuerpo de error de un status gRPC. Los errores
+// internos no exponen su mensaje (quien responde debe registrarlo) y las
+// field violations de un detalle BadRequest se copian a Details.
+func NewErrorResponse(st *status.Status) ErrorResponse {
+	httpStatus := HTTPStatusFromCode(st.Code())
+	resp := ErrorResponse{
+		Error:   rpccode.Code(st.Code()).String(),
+		Message: st.Message(),
+		Code:    httpStatus,
+	}
+
+	switch st.Code() {
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		resp.Message = ErrInternalServer.Error()
+	case codes.Unavailable:
+		resp.Message = ErrServiceUnavailable.Error()
+	}
+
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			resp.Details = append(resp.Details, FieldViolation{
+				Field:       violation.GetField(),
+				Description: violation.GetDescription(),
+			})
+		}
+	}
+	return resp
 }
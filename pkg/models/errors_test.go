@@ -0,0 +1,82 @@
+package models
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewErrorResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		code codes.Code
+		want ErrorResponse
+	}{
+		{"argumento inválido", codes.InvalidArgument, ErrorResponse{"INVALID_ARGUMENT", "boom", http.StatusBadRequest, nil}},
+		{"fuera de rango", codes.OutOfRange, ErrorResponse{"OUT_OF_RANGE", "boom", http.StatusBadRequest, nil}},
+		{"sin token", codes.Unauthenticated, ErrorResponse{"UNAUTHENTICATED", "boom", http.StatusUnauthorized, nil}},
+		{"sin permiso", codes.PermissionDenied, ErrorResponse{"PERMISSION_DENIED", "boom", http.StatusForbidden, nil}},
+		{"no encontrado", codes.NotFound, ErrorResponse{"NOT_FOUND", "boom", http.StatusNotFound, nil}},
+		{"ya existe", codes.AlreadyExists, ErrorResponse{"ALREADY_EXISTS", "boom", http.StatusConflict, nil}},
+		{"precondición", codes.FailedPrecondition, ErrorResponse{"FAILED_PRECONDITION", "boom", http.StatusConflict, nil}},
+		{"abortado", codes.Aborted, ErrorResponse{"ABORTED", "boom", http.StatusConflict, nil}},
+		{"límite", codes.ResourceExhausted, ErrorResponse{"RESOURCE_EXHAUSTED", "boom", http.StatusTooManyRequests, nil}},
+		{"cancelado", codes.Canceled, ErrorResponse{"CANCELLED", "boom", 499, nil}},
+		{"no implementado", codes.Unimplemented, ErrorResponse{"UNIMPLEMENTED", "boom", http.StatusNotImplemented, nil}},
+		{"timeout", codes.DeadlineExceeded, ErrorResponse{"DEADLINE_EXCEEDED", "boom", http.StatusGatewayTimeout, nil}},
+		// Los errores internos no exponen el mensaje original
+		{"interno", codes.Internal, ErrorResponse{"INTERNAL", ErrInternalServer.Error(), http.StatusInternalServerError, nil}},
+		{"desconocido", codes.Unknown, ErrorResponse{"UNKNOWN", ErrInternalServer.Error(), http.StatusInternalServerError, nil}},
+		{"pérdida de datos", codes.DataLoss, ErrorResponse{"DATA_LOSS", ErrInternalServer.Error(), http.StatusInternalServerError, nil}},
+		{"no disponible", codes.Unavailable, ErrorResponse{"UNAVAILABLE", ErrServiceUnavailable.Error(), http.StatusServiceUnavailable, nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewErrorResponse(status.New(tt.code, "boom"))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewErrorResponse(%v) = %+v, want %+v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidField(t *testing.T) {
+	err := InvalidField("week", "week must be between 1 and %d", 22)
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument || st.Message() != "week must be between 1 and 22" {
+		t.Fatalf("InvalidField() = %v, want InvalidArgument with the formatted message", err)
+	}
+
+	want := ErrorResponse{
+		Error:   "INVALID_ARGUMENT",
+		Message: "week must be between 1 and 22",
+		Code:    http.StatusBadRequest,
+		Details: []FieldViolation{{Field: "week", Description: "week must be between 1 and 22"}},
+	}
+	if got := NewErrorResponse(st); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewErrorResponse(InvalidField()) = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewErrorResponseIgnoresOtherDetails(t *testing.T) {
+	st, err := status.New(codes.FailedPrecondition, "game already started").WithDetails(
+		&errdetails.ErrorInfo{Reason: "GAME_STARTED"},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "game_id", Description: "game already started"},
+		}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewErrorResponse(st)
+	want := []FieldViolation{{Field: "game_id", Description: "game already started"}}
+	if got.Code != http.StatusConflict || !reflect.DeepEqual(got.Details, want) {
+		t.Errorf("NewErrorResponse() = %+v, want 409 with details %+v", got, want)
+	}
+}
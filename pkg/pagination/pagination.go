@@ -0,0 +1,255 @@
+// Package pagination implementa la paginación por cursor de los listados.
+//
+// Cada página se pide con el page_token que devolvió la anterior. El token es
+// opaco para el cliente (JSON en base64) y guarda el orden, los valores de la
+// última fila y una huella de los filtros del request. La consulta sigue desde
+// esa fila (keyset) en lugar de usar OFFSET: una fila creada o borrada entre
+// dos páginas no hace saltear ni repetir resultados. El id de la fila desempata
+// siempre el orden, así que el orden es estable aunque la columna se repita.
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gorm.io/gorm"
+
+	shared "kickoff.com/pkg/models"
+)
+
+const (
+	// DefaultPageSize es el tamaño de página cuando el request no lo indica
+	DefaultPageSize = 50
+	// MaxPageSize es el tope de una página; un page_size mayor se recorta
+	MaxPageSize = 200
+)
+
+// Kind es el tipo de la columna de orden, para guardar su valor en el token
+type Kind int
+
+const (
+	String Kind = iota
+	Int
+	Time
+)
+
+// Order es un orden que acepta un listado de filas T: su nombre en order_by,
+// la columna y cómo leer su valor de una fila para armar el token. ID es la
+// columna que desempata ("id" si se deja vacía), para los listados cuyas
+// filas se identifican por otra columna.
+type Order[T any] struct {
+	Name   string
+	Column string
+	Kind   Kind
+	Value  func(T) interface{}
+	ID     string
+}
+
+// Orders son los órdenes de un listado; el primero es el default
+type Orders[T any] []Order[T]
+
+// token es el contenido del page_token
+type token struct {
+	OrderBy string `json:"o"`
+	Filters string `json:"f"`
+	Value   string `json:"v"`
+	ID      string `json:"id"`
+}
+
+// Page es la página pedida, ya validada
+type Page[T any] struct {
+	Size    int
+	order   Order[T]
+	orderBy string
+	desc    bool
+	filters string
+	after   *token
+	value   interface{}
+}
+
+// New valida page_size, page_token y order_by ("columna" o "-columna" para
+// descendente). filters es la huella de los filtros del request (ver Filters):
+// un token emitido para otros filtros u otro orden se rechaza.
+func New[T any](pageSize int32, pageToken, orderBy string, orders Orders[T], filters string) (Page[T], error) {
+	page := Page[T]{Size: DefaultPageSize, filters: filters}
+	switch {
+	case pageSize < 0:
+		return Page[T]{}, shared.InvalidField("page_size", "page_size cannot be negative")
+	case pageSize > MaxPageSize:
+		page.Size = MaxPageSize
+	case pageSize > 0:
+		page.Size = int(pageSize)
+	}
+
+	page.order = orders[0]
+	if orderBy != "" {
+		name := strings.TrimPrefix(orderBy, "-")
+		found := false
+		for _, order := range orders {
+			if order.Name == name {
+				page.order, found = order, true
+				break
+			}
+		}
+		if !found {
+			return Page[T]{}, shared.InvalidField("order_by", "order_by must be one of %s", orders.names())
+		}
+		page.desc = strings.HasPrefix(orderBy, "-")
+	}
+	page.orderBy = page.order.Name
+	if page.desc {
+		page.orderBy = "-" + page.orderBy
+	}
+
+	if pageToken == "" {
+		return page, nil
+	}
+	after, value, err := page.decode(pageToken)
+	if err != nil {
+		return Page[T]{}, shared.InvalidField("page_token", "invalid page_token")
+	}
+	if after.OrderBy != page.orderBy || after.Filters != filters {
+		return Page[T]{}, shared.InvalidField("page_token", "page_token was issued for other filters or order_by")
+	}
+	page.after, page.value = after, value
+	return page, nil
+}
+
+// LegacyPage valida el page numérico de los listados que paginaban por
+// número: la primera página (0 o 1) es la misma que sin page_token, pero
+// cualquier otra se rechaza para que un cliente viejo no reciba la primera
+// página creyendo que avanzó
+func LegacyPage(page int32) error {
+	if page > 1 {
+		return shared.InvalidField("page", "page is no longer supported, follow next_page_token instead")
+	}
+	if page < 0 {
+		return shared.InvalidField("page", "page cannot be negative")
+	}
+	return nil
+}
+
+// LegacyLimit valida el limit y offset de los listados que paginaban por
+// desplazamiento y devuelve el page_size a usar: limit vale como page_size
+// si este no viene, y offset solo se acepta en 0 por la misma razón que page
+func LegacyLimit(pageSize, limit, offset int32) (int32, error) {
+	if offset != 0 {
+		return 0, shared.InvalidField("offset", "offset is no longer supported, follow next_page_token instead")
+	}
+	if limit < 0 {
+		return 0, shared.InvalidField("limit", "limit cannot be negative")
+	}
+	if pageSize == 0 {
+		return limit, nil
+	}
+	return pageSize, nil
+}
+
+// Filters devuelve la huella de los filtros de un request de listado: el
+// request sin page_size, page_token ni order_by (ni page, limit u offset,
+// deprecados)
+func Filters(req proto.Message) string {
+	filters := proto.Clone(req)
+	fields := filters.ProtoReflect().Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"page", "page_size", "page_token", "order_by", "limit", "offset"} {
+		if field := fields.ByName(name); field != nil {
+			filters.ProtoReflect().Clear(field)
+		}
+	}
+
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(filters)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Find cuenta las filas de query (ya filtrada) y trae la página ordenada,
+// junto con el token de la página siguiente ("" si es la última). id devuelve
+// el id de una fila, que desempata el orden.
+func Find[T any](query *gorm.DB, page Page[T], id func(T) string) ([]T, int64, string, error) {
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Model(new(T)).Count(&total).Error; err != nil {
+		return nil, 0, "", err
+	}
+
+	var rows []T
+	if err := page.apply(query).Find(&rows).Error; err != nil {
+		return nil, 0, "", err
+	}
+	if len(rows) <= page.Size {
+		return rows, total, "", nil
+	}
+
+	rows = rows[:page.Size]
+	last := rows[len(rows)-1]
+	return rows, total, page.encode(page.order.Value(last), id(last)), nil
+}
+
+// apply agrega a la consulta el punto de partida, el orden y el límite; se
+// pide una fila de más para saber si hay otra página
+func (p Page[T]) apply(query *gorm.DB) *gorm.DB {
+	column, id, direction, cmp := p.order.Column, p.order.ID, "ASC", ">"
+	if id == "" {
+		id = "id"
+	}
+	if p.desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if p.after != nil {
+		query = query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", column, cmp, column, id, cmp), p.value, p.value, p.after.ID)
+	}
+	return query.Order(fmt.Sprintf("%s %s, %s %s", column, direction, id, direction)).Limit(p.Size + 1)
+}
+
+func (p Page[T]) encode(value interface{}, id string) string {
+	t := token{OrderBy: p.orderBy, Filters: p.filters, ID: id}
+	switch v := value.(type) {
+	case time.Time:
+		t.Value = v.UTC().Format(time.RFC3339Nano)
+	default:
+		t.Value = fmt.Sprint(v)
+	}
+
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (p Page[T]) decode(pageToken string) (*token, interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var t token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, nil, err
+	}
+
+	switch p.order.Kind {
+	case Int:
+		value, err := strconv.ParseInt(t.Value, 10, 64)
+		return &t, value, err
+	case Time:
+		value, err := time.Parse(time.RFC3339Nano, t.Value)
+		return &t, value, err
+	default:
+		return &t, t.Value, nil
+	}
+}
+
+func (o Orders[T]) names() string {
+	names := make([]string, len(o))
+	for i, order := range o {
+		names[i] = order.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,241 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	pb "kickoff.com/proto"
+)
+
+type row struct {
+	ID        string
+	Name      string
+	Week      int
+	CreatedAt time.Time
+}
+
+var rowOrders = Orders[row]{
+	{Name: "name", Column: "name", Kind: String, Value: func(r row) interface{} { return r.Name }},
+	{Name: "week", Column: "week", Kind: Int, Value: func(r row) interface{} { return r.Week }},
+	{Name: "created_at", Column: "created_at", Kind: Time, Value: func(r row) interface{} { return r.CreatedAt }},
+}
+
+// violatedField devuelve el campo del BadRequest de un error de InvalidField
+func violatedField(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok && len(br.FieldViolations) > 0 {
+			return br.FieldViolations[0].Field
+		}
+	}
+	return ""
+}
+
+func TestNewPageSize(t *testing.T) {
+	tests := []struct {
+		pageSize  int32
+		wantSize  int
+		wantField string
+	}{
+		{0, DefaultPageSize, ""},
+		{10, 10, ""},
+		{MaxPageSize, MaxPageSize, ""},
+		{MaxPageSize + 1, MaxPageSize, ""},
+		{-1, 0, "page_size"},
+	}
+	for _, tt := range tests {
+		page, err := New(tt.pageSize, "", "", rowOrders, "")
+		if field := violatedField(err); field != tt.wantField {
+			t.Errorf("New(page_size=%d) error field = %q, want %q (err %v)", tt.pageSize, field, tt.wantField, err)
+		}
+		if err == nil && page.Size != tt.wantSize {
+			t.Errorf("New(page_size=%d).Size = %d, want %d", tt.pageSize, page.Size, tt.wantSize)
+		}
+	}
+}
+
+func TestNewOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy   string
+		wantName  string
+		wantDesc  bool
+		wantField string
+	}{
+		{"", "name", false, ""},
+		{"week", "week", false, ""},
+		{"-created_at", "created_at", true, ""},
+		{"points", "", false, "order_by"},
+		{"-", "", false, "order_by"},
+	}
+	for _, tt := range tests {
+		page, err := New(0, "", tt.orderBy, rowOrders, "")
+		if field := violatedField(err); field != tt.wantField {
+			t.Errorf("New(order_by=%q) error field = %q, want %q (err %v)", tt.orderBy, field, tt.wantField, err)
+			continue
+		}
+		if err == nil && (page.order.Name != tt.wantName || page.desc != tt.wantDesc) {
+			t.Errorf("New(order_by=%q) = (%s, desc %v), want (%s, desc %v)", tt.orderBy, page.order.Name, page.desc, tt.wantName, tt.wantDesc)
+		}
+	}
+}
+
+func TestTokenRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 9, 7, 17, 25, 0, 123456000, time.FixedZone("ART", -3*3600))
+	tests := []struct {
+		orderBy string
+		value   interface{}
+		want    interface{}
+	}{
+		{"name", "Chiefs", "Chiefs"},
+		{"-week", 7, int64(7)},
+		{"created_at", createdAt, createdAt.UTC()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			first, err := New(5, "", tt.orderBy, rowOrders, "f1")
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			token := first.encode(tt.value, "row_01")
+
+			next, err := New(5, token, tt.orderBy, rowOrders, "f1")
+			if err != nil {
+				t.Fatalf("New(page_token) error = %v", err)
+			}
+			if next.after.ID != "row_01" {
+				t.Errorf("token id = %q, want row_01", next.after.ID)
+			}
+			if got, ok := next.value.(time.Time); ok {
+				if !got.Equal(tt.want.(time.Time)) {
+					t.Errorf("token value = %v, want %v", got, tt.want)
+				}
+			} else if next.value != tt.want {
+				t.Errorf("token value = %#v, want %#v", next.value, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenMismatch(t *testing.T) {
+	page, _ := New(5, "", "week", rowOrders, "f1")
+	token := page.encode(3, "row_01")
+
+	tests := []struct {
+		name    string
+		token   string
+		orderBy string
+		filters string
+	}{
+		{"otros filtros", token, "week", "f2"},
+		{"otro orden", token, "name", "f1"},
+		{"otra dirección", token, "-week", "f1"},
+		{"no es base64", "%%%", "week", "f1"},
+		{"no es JSON", "bm90IGpzb24", "week", "f1"},
+		{"valor inválido para el tipo", page.encode("tres", "row_01"), "week", "f1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(5, tt.token, tt.orderBy, rowOrders, tt.filters)
+			if field := violatedField(err); field != "page_token" {
+				t.Errorf("New() error = %v, want page_token violation", err)
+			}
+		})
+	}
+}
+
+func TestFilters(t *testing.T) {
+	base := Filters(&pb.GetAllGamesRequest{Season: 2025, TeamId: "KC"})
+	tests := []struct {
+		name string
+		req  *pb.GetAllGamesRequest
+		same bool
+	}{
+		{"paginación distinta", &pb.GetAllGamesRequest{Season: 2025, TeamId: "KC", PageSize: 10, PageToken: "x", OrderBy: "-week"}, true},
+		{"otra temporada", &pb.GetAllGamesRequest{Season: 2024, TeamId: "KC"}, false},
+		{"filtro agregado", &pb.GetAllGamesRequest{Season: 2025, TeamId: "KC", WeekFrom: 3}, false},
+	}
+	for _, tt := range tests {
+		if got := Filters(tt.req); (got == base) != tt.same {
+			t.Errorf("%s: Filters() = %s, base %s, want same %v", tt.name, got, base, tt.same)
+		}
+	}
+
+	// limit y offset deprecados tampoco cuentan como filtros
+	if Filters(&pb.GetLeaderboardRequest{Season: 2025, Limit: 10}) != Filters(&pb.GetLeaderboardRequest{Season: 2025}) {
+		t.Error("Filters() should ignore limit")
+	}
+}
+
+func TestLegacyPage(t *testing.T) {
+	tests := []struct {
+		page      int32
+		wantField string
+	}{
+		{0, ""},
+		{1, ""},
+		{2, "page"},
+		{-1, "page"},
+	}
+	for _, tt := range tests {
+		if field := violatedField(LegacyPage(tt.page)); field != tt.wantField {
+			t.Errorf("LegacyPage(%d) field = %q, want %q", tt.page, field, tt.wantField)
+		}
+	}
+}
+
+func TestLegacyLimit(t *testing.T) {
+	tests := []struct {
+		pageSize, limit, offset int32
+		want                    int32
+		wantField               string
+	}{
+		{0, 0, 0, 0, ""},
+		{0, 25, 0, 25, ""},
+		{10, 25, 0, 10, ""},
+		{0, 25, 25, 0, "offset"},
+		{0, -5, 0, 0, "limit"},
+	}
+	for _, tt := range tests {
+		got, err := LegacyLimit(tt.pageSize, tt.limit, tt.offset)
+		if field := violatedField(err); field != tt.wantField || got != tt.want {
+			t.Errorf("LegacyLimit(%d, %d, %d) = (%d, %q), want (%d, %q)", tt.pageSize, tt.limit, tt.offset, got, field, tt.want, tt.wantField)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byRank := Orders[row]{{Name: "rank", Column: "rank", Kind: Int, Value: func(r row) interface{} { return r.Week }, ID: "user_id"}}
+
+	first, _ := New(2, "", "-week", rowOrders, "")
+	next, _ := New(2, first.encode(4, "row_09"), "-week", rowOrders, "")
+	ranked, _ := New(2, "", "", byRank, "")
+	rankedNext, _ := New(2, ranked.encode(3, "user_01"), "", byRank, "")
+
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{"primera página", sqlOf(db, first.apply), `SELECT * FROM "rows" ORDER BY week DESC, id DESC LIMIT $1`},
+		{"desde un token", sqlOf(db, next.apply), `SELECT * FROM "rows" WHERE (week < $1 OR (week = $2 AND id < $3)) ORDER BY week DESC, id DESC LIMIT $4`},
+		{"otro desempate", sqlOf(db, rankedNext.apply), `SELECT * FROM "rows" WHERE (rank > $1 OR (rank = $2 AND user_id > $3)) ORDER BY rank ASC, user_id ASC LIMIT $4`},
+	}
+	for _, tt := range tests {
+		if tt.sql != tt.want {
+			t.Errorf("%s:\n got  %s\n want %s", tt.name, tt.sql, tt.want)
+		}
+	}
+}
+
+func sqlOf(db *gorm.DB, apply func(*gorm.DB) *gorm.DB) string {
+	var rows []row
+	return apply(db.Session(&gorm.Session{})).Find(&rows).Statement.SQL.String()
+}
@@ -0,0 +1,130 @@
+package playoffs
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Bracket es la predicción completa de los playoffs: los equipos que el
+// usuario elige como ganadores de cada ronda
+type Bracket map[Round][]string
+
+// Validate verifica que el bracket sea posible con los sembrados de la
+// temporada: un ganador por cruce en cada ronda, con los cruces que resultan
+// de los ganadores elegidos en la ronda anterior
+func (b Bracket) Validate(season int, seeds []Seed) error {
+	if err := ValidateSeeds(seeds); err != nil {
+		return err
+	}
+	for _, round := range Rounds {
+		if len(b[round]) != round.Games() {
+			return fmt.Errorf("%s needs %d picks, got %d", round, round.Games(), len(b[round]))
+		}
+	}
+
+	champions := make(map[string]Seed)
+	for conference, list := range Conferences(seeds) {
+		alive := list[1:]
+		for _, round := range []Round{RoundWildCard, RoundDivisional, RoundConference} {
+			winners, err := b.winners(round, Pair(alive))
+			if err != nil {
+				return err
+			}
+			if round == RoundWildCard {
+				winners = append(winners, list[0])
+			}
+			alive = winners
+		}
+		champions[conference] = alive[0]
+	}
+
+	_, err := b.winners(RoundSuperBowl, []Matchup{SuperBowl(season, champions["AFC"], champions["NFC"])})
+	return err
+}
+
+// winners devuelve el equipo elegido en cada cruce; exige exactamente uno por cruce
+func (b Bracket) winners(round Round, matchups []Matchup) ([]Seed, error) {
+	picked := make(map[string]bool)
+	for _, teamID := range b[round] {
+		picked[teamID] = true
+	}
+
+	var winners []Seed
+	for _, m := range matchups {
+		switch {
+		case picked[m.Home.TeamID] && picked[m.Away.TeamID]:
+			return nil, fmt.Errorf("%s: both %s and %s are picked to win the same game", round, m.Home.TeamID, m.Away.TeamID)
+		case picked[m.Home.TeamID]:
+			winners = append(winners, m.Home)
+		case picked[m.Away.TeamID]:
+			winners = append(winners, m.Away)
+		default:
+			return nil, fmt.Errorf("%s: pick a winner for %s vs %s", round, m.Home.TeamID, m.Away.TeamID)
+		}
+	}
+	return winners, nil
+}
+
+// Estado de un pick del bracket
+const (
+	PickPending   = "pending"
+	PickCorrect   = "correct"
+	PickIncorrect = "incorrect"
+)
+
+// Result es un juego de playoffs terminado
+type Result struct {
+	Round        Round
+	WinnerTeamID string
+	LoserTeamID  string
+}
+
+// GradedPick es un pick del bracket con su estado y sus puntos
+type GradedPick struct {
+	Round  Round
+	TeamID string
+	Status string
+	Points int
+}
+
+// Grade califica los picks con los juegos terminados hasta el momento. Un pick
+// acierta cuando el equipo gana un juego de esa ronda y falla en cuanto el
+// equipo queda eliminado antes o en esa ronda, así los picks se resuelven
+// ronda por ronda.
+func (b Bracket) Grade(results []Result) []GradedPick {
+	won := make(map[Round]map[string]bool)
+	eliminated := make(map[string]Round)
+	for _, r := range results {
+		if won[r.Round] == nil {
+			won[r.Round] = make(map[string]bool)
+		}
+		won[r.Round][r.WinnerTeamID] = true
+		eliminated[r.LoserTeamID] = r.Round
+	}
+
+	var graded []GradedPick
+	for i, round := range Rounds {
+		teams := append([]string(nil), b[round]...)
+		sort.Strings(teams)
+		for _, teamID := range teams {
+			pick := GradedPick{Round: round, TeamID: teamID, Status: PickPending}
+			if won[round][teamID] {
+				pick.Status = PickCorrect
+				pick.Points = round.Points()
+			} else if lostIn, ok := eliminated[teamID]; ok && roundIndex(lostIn) <= i {
+				pick.Status = PickIncorrect
+			}
+			graded = append(graded, pick)
+		}
+	}
+	return graded
+}
+
+func roundIndex(round Round) int {
+	for i, r := range Rounds {
+		if r == round {
+			return i
+		}
+	}
+	return len(Rounds)
+}
@@ -0,0 +1,96 @@
+package playoffs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// chalk es el bracket en que siempre gana el mejor sembrado
+func chalk() Bracket {
+	return Bracket{
+		RoundWildCard:   {"AFC2", "AFC3", "AFC4", "NFC2", "NFC3", "NFC4"},
+		RoundDivisional: {"AFC1", "AFC2", "NFC1", "NFC2"},
+		RoundConference: {"AFC1", "NFC1"},
+		RoundSuperBowl:  {"NFC1"},
+	}
+}
+
+func TestBracketValidate(t *testing.T) {
+	seeds := append(conference("AFC"), conference("NFC")...)
+	with := func(round Round, picks ...string) Bracket {
+		b := chalk()
+		b[round] = picks
+		return b
+	}
+
+	tests := []struct {
+		name    string
+		bracket Bracket
+		wantErr string
+	}{
+		{"favoritos", chalk(), ""},
+		{"sorpresa que avanza", Bracket{
+			RoundWildCard:   {"AFC7", "AFC3", "AFC4", "NFC2", "NFC3", "NFC4"},
+			RoundDivisional: {"AFC7", "AFC3", "NFC1", "NFC2"},
+			RoundConference: {"AFC7", "NFC1"},
+			RoundSuperBowl:  {"AFC7"},
+		}, ""},
+		{"faltan picks", with(RoundDivisional, "AFC1", "AFC2", "NFC1"), "divisional needs 4 picks, got 3"},
+		{"los dos del mismo cruce", with(RoundWildCard, "AFC2", "AFC7", "AFC4", "NFC2", "NFC3", "NFC4"), "wild_card: both AFC2 and AFC7 are picked to win the same game"},
+		{"un eliminado avanza", with(RoundDivisional, "AFC1", "AFC5", "NFC1", "NFC2"), "divisional: pick a winner for AFC2 vs AFC3"},
+		{"campeón que no llegó", with(RoundSuperBowl, "AFC2"), "super_bowl: pick a winner for NFC1 vs AFC1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bracket.Validate(2024, seeds)
+			if got := fmt.Sprint(err); (tt.wantErr == "" && err != nil) || (tt.wantErr != "" && got != tt.wantErr) {
+				t.Errorf("Validate() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBracketGrade(t *testing.T) {
+	bracket := Bracket{
+		RoundWildCard:   {"AFC2"},
+		RoundDivisional: {"AFC2", "AFC1"},
+		RoundConference: {"AFC1"},
+	}
+	tests := []struct {
+		name    string
+		results []Result
+		want    []GradedPick
+	}{
+		{"sin resultados", nil, []GradedPick{
+			{RoundWildCard, "AFC2", PickPending, 0},
+			{RoundDivisional, "AFC1", PickPending, 0},
+			{RoundDivisional, "AFC2", PickPending, 0},
+			{RoundConference, "AFC1", PickPending, 0},
+		}},
+		{"acierto y eliminación", []Result{
+			{RoundWildCard, "AFC2", "AFC7"},
+			{RoundDivisional, "AFC4", "AFC1"},
+		}, []GradedPick{
+			{RoundWildCard, "AFC2", PickCorrect, 1},
+			{RoundDivisional, "AFC1", PickIncorrect, 0},
+			{RoundDivisional, "AFC2", PickPending, 0},
+			{RoundConference, "AFC1", PickIncorrect, 0},
+		}},
+		{"eliminado antes de la ronda", []Result{
+			{RoundWildCard, "AFC7", "AFC2"},
+		}, []GradedPick{
+			{RoundWildCard, "AFC2", PickIncorrect, 0},
+			{RoundDivisional, "AFC1", PickPending, 0},
+			{RoundDivisional, "AFC2", PickIncorrect, 0},
+			{RoundConference, "AFC1", PickPending, 0},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bracket.Grade(tt.results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grade() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Package playoffs define el formato de los playoffs de la NFL: siete equipos
+// por conferencia, la semana de cada ronda y cómo se arman los cruces. El Game
+// Service lo usa para generar el bracket y el Prediction Service para validar
+// y puntuar los brackets de los usuarios.
+package playoffs
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Round es una ronda de playoffs
+type Round string
+
+const (
+	RoundWildCard   Round = "wild_card"
+	RoundDivisional Round = "divisional"
+	RoundConference Round = "conference"
+	RoundSuperBowl  Round = "super_bowl"
+)
+
+// Rounds son las rondas en el orden en que se juegan
+var Rounds = []Round{RoundWildCard, RoundDivisional, RoundConference, RoundSuperBowl}
+
+const (
+	// FirstWeek es la semana del Wild Card, a continuación de las 18 de temporada regular
+	FirstWeek = 19
+	// LastWeek es la semana del Super Bowl
+	LastWeek = FirstWeek + 3
+	// TeamsPerConference son los equipos que clasifican por conferencia
+	TeamsPerConference = 7
+)
+
+// Week devuelve la semana en que se juega la ronda
+func (r Round) Week() int {
+	for i, round := range Rounds {
+		if round == r {
+			return FirstWeek + i
+		}
+	}
+	return 0
+}
+
+// Games es la cantidad de juegos de la ronda en toda la liga
+func (r Round) Games() int {
+	return map[Round]int{RoundWildCard: 6, RoundDivisional: 4, RoundConference: 2, RoundSuperBowl: 1}[r]
+}
+
+// Points es lo que vale un acierto de la ronda en un bracket: se duplica en cada ronda
+func (r Round) Points() int {
+	return map[Round]int{RoundWildCard: 1, RoundDivisional: 2, RoundConference: 4, RoundSuperBowl: 8}[r]
+}
+
+// Next devuelve la ronda siguiente, o "" después del Super Bowl
+func (r Round) Next() Round {
+	return RoundOfWeek(r.Week() + 1)
+}
+
+// RoundOfWeek devuelve la ronda que se juega en la semana, o "" si no es de playoffs
+func RoundOfWeek(week int) Round {
+	if week < FirstWeek || week > LastWeek {
+		return ""
+	}
+	return Rounds[week-FirstWeek]
+}
+
+// Seed es un equipo clasificado con su sembrado en la conferencia
+type Seed struct {
+	Conference string
+	Seed       int
+	TeamID     string
+}
+
+// Matchup es un cruce de playoffs; el local es el mejor sembrado
+type Matchup struct {
+	Home Seed
+	Away Seed
+}
+
+// Pair arma los cruces de una ronda de conferencia con los equipos que siguen
+// vivos: el mejor sembrado contra el peor, el segundo contra el penúltimo, y
+// así. En el Wild Card son los sembrados 2 a 7 (el 1 descansa); en las rondas
+// siguientes se vuelve a sembrar con los ganadores.
+func Pair(alive []Seed) []Matchup {
+	sorted := append([]Seed(nil), alive...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Seed < sorted[j].Seed })
+
+	var matchups []Matchup
+	for i := 0; i < len(sorted)/2; i++ {
+		matchups = append(matchups, Matchup{Home: sorted[i], Away: sorted[len(sorted)-1-i]})
+	}
+	return matchups
+}
+
+// SuperBowl arma el cruce final. El Super Bowl se juega en cancha neutral y
+// el local designado alterna: la AFC en los de número par (temporada - 1965).
+func SuperBowl(season int, afc, nfc Seed) Matchup {
+	if (season-1965)%2 == 0 {
+		return Matchup{Home: afc, Away: nfc}
+	}
+	return Matchup{Home: nfc, Away: afc}
+}
+
+// Conferences agrupa los sembrados por conferencia, ordenados por sembrado
+func Conferences(seeds []Seed) map[string][]Seed {
+	byConference := make(map[string][]Seed)
+	for _, seed := range seeds {
+		byConference[seed.Conference] = append(byConference[seed.Conference], seed)
+	}
+	for _, list := range byConference {
+		sort.Slice(list, func(i, j int) bool { return list[i].Seed < list[j].Seed })
+	}
+	return byConference
+}
+
+// ValidateSeeds verifica que haya dos conferencias con los sembrados 1 a 7
+func ValidateSeeds(seeds []Seed) error {
+	byConference := Conferences(seeds)
+	if len(byConference) != 2 {
+		return fmt.Errorf("the bracket needs seeds for 2 conferences, got %d", len(byConference))
+	}
+	for conference, list := range byConference {
+		if len(list) != TeamsPerConference {
+			return fmt.Errorf("%s has %d seeds, want %d", conference, len(list), TeamsPerConference)
+		}
+		for i, seed := range list {
+			if seed.Seed != i+1 {
+				return fmt.Errorf("%s is missing seed %d", conference, i+1)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package playoffs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// conference arma los sembrados 1 a 7 de una conferencia con IDs "AFC1".."AFC7"
+func conference(name string) []Seed {
+	seeds := make([]Seed, TeamsPerConference)
+	for i := range seeds {
+		seeds[i] = Seed{Conference: name, Seed: i + 1, TeamID: fmt.Sprintf("%s%d", name, i+1)}
+	}
+	return seeds
+}
+
+func matchupIDs(matchups []Matchup) []string {
+	var ids []string
+	for _, m := range matchups {
+		ids = append(ids, m.Home.TeamID+"-"+m.Away.TeamID)
+	}
+	return ids
+}
+
+func TestRoundWeeks(t *testing.T) {
+	tests := []struct {
+		round  Round
+		week   int
+		next   Round
+		games  int
+		points int
+	}{
+		{RoundWildCard, 19, RoundDivisional, 6, 1},
+		{RoundDivisional, 20, RoundConference, 4, 2},
+		{RoundConference, 21, RoundSuperBowl, 2, 4},
+		{RoundSuperBowl, 22, "", 1, 8},
+	}
+	for _, tt := range tests {
+		if tt.round.Week() != tt.week || tt.round.Next() != tt.next || tt.round.Games() != tt.games || tt.round.Points() != tt.points {
+			t.Errorf("%s = (week %d, next %q, games %d, points %d), want (%d, %q, %d, %d)", tt.round,
+				tt.round.Week(), tt.round.Next(), tt.round.Games(), tt.round.Points(), tt.week, tt.next, tt.games, tt.points)
+		}
+		if got := RoundOfWeek(tt.week); got != tt.round {
+			t.Errorf("RoundOfWeek(%d) = %q, want %q", tt.week, got, tt.round)
+		}
+	}
+	for _, week := range []int{0, 18, 23} {
+		if got := RoundOfWeek(week); got != "" {
+			t.Errorf("RoundOfWeek(%d) = %q, want none", week, got)
+		}
+	}
+}
+
+func TestPair(t *testing.T) {
+	afc := conference("AFC")
+	tests := []struct {
+		name  string
+		alive []Seed
+		want  []string
+	}{
+		{"wild card sin el 1", afc[1:], []string{"AFC2-AFC7", "AFC3-AFC6", "AFC4-AFC5"}},
+		{"divisional resiembra", []Seed{afc[4], afc[0], afc[5], afc[1]}, []string{"AFC1-AFC6", "AFC2-AFC5"}},
+		{"final de conferencia", []Seed{afc[5], afc[2]}, []string{"AFC3-AFC6"}},
+	}
+	for _, tt := range tests {
+		if got := matchupIDs(Pair(tt.alive)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Pair() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSuperBowl(t *testing.T) {
+	afc, nfc := conference("AFC")[0], conference("NFC")[0]
+	tests := []struct {
+		season   int
+		wantHome string
+	}{
+		{2023, "AFC1"}, // Super Bowl 58: par, local la AFC
+		{2024, "NFC1"},
+		{2025, "AFC1"},
+	}
+	for _, tt := range tests {
+		if got := SuperBowl(tt.season, afc, nfc).Home.TeamID; got != tt.wantHome {
+			t.Errorf("SuperBowl(%d) home = %s, want %s", tt.season, got, tt.wantHome)
+		}
+	}
+}
+
+func TestValidateSeeds(t *testing.T) {
+	full := append(conference("AFC"), conference("NFC")...)
+	duplicated := append(conference("AFC"), conference("NFC")...)
+	duplicated[8].Seed = 3
+
+	tests := []struct {
+		name    string
+		seeds   []Seed
+		wantErr string
+	}{
+		{"completos", full, ""},
+		{"una conferencia", conference("AFC"), "the bracket needs seeds for 2 conferences, got 1"},
+		{"faltan sembrados", append(conference("AFC"), conference("NFC")[:6]...), "NFC has 6 seeds, want 7"},
+		{"sembrado repetido", duplicated, "NFC is missing seed 2"},
+	}
+	for _, tt := range tests {
+		err := ValidateSeeds(tt.seeds)
+		if got := fmt.Sprint(err); (tt.wantErr == "" && err != nil) || (tt.wantErr != "" && got != tt.wantErr) {
+			t.Errorf("%s: ValidateSeeds() = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/playoffs"
+	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Playoff Brackets
+// ========================================
+
+// SubmitBracket guarda el bracket de playoffs completo de un usuario. Se
+// valida contra los sembrados del Game Service y se puede reemplazar hasta
+// que empieza el primer juego del Wild Card.
+func (ps *PredictionService) SubmitBracket(ctx context.Context, req *pb.SubmitBracketRequest) (*pb.SubmitBracketResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+	season := int(req.Season)
+
+	playoffBracket, err := ps.fetchPlayoffBracket(ctx, season)
+	if err != nil {
+		return nil, err
+	}
+	if !bracketOpen(playoffBracket) {
+		return nil, status.Error(codes.FailedPrecondition, "brackets are locked once the Wild Card round starts")
+	}
+
+	picks := playoffs.Bracket{
+		playoffs.RoundWildCard:   normalizeTeams(req.WildCardWinners),
+		playoffs.RoundDivisional: normalizeTeams(req.DivisionalWinners),
+		playoffs.RoundConference: normalizeTeams(req.ConferenceChampions),
+		playoffs.RoundSuperBowl:  normalizeTeams([]string{req.SuperBowlChampion}),
+	}
+	if err := picks.Validate(season, playoffSeedsFromProto(playoffBracket)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bracket: %v", err)
+	}
+
+	var bracket models.BracketPrediction
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ? AND season = ?", userID, season).First(&bracket).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			bracket = models.BracketPrediction{
+				ID:     idgen.New(idgen.PrefixBracket),
+				UserID: userID,
+				Season: season,
+			}
+			if err := tx.Create(&bracket).Error; err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		default:
+			if err := tx.Where("bracket_id = ?", bracket.ID).Delete(&models.BracketPick{}).Error; err != nil {
+				return err
+			}
+		}
+
+		bracket.Picks = nil
+		for _, round := range playoffs.Rounds {
+			for _, teamID := range picks[round] {
+				bracket.Picks = append(bracket.Picks, models.BracketPick{
+					BracketID: bracket.ID,
+					Round:     string(round),
+					TeamID:    teamID,
+					Status:    models.PredictionStatusPending,
+				})
+			}
+		}
+		if err := tx.Create(&bracket.Picks).Error; err != nil {
+			return err
+		}
+		return gradeBracket(tx, &bracket, nil)
+	})
+	if err != nil {
+		log.Printf("Error saving bracket: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to save bracket: %v", err)
+	}
+
+	log.Printf("Saved %d playoff bracket %s for user %s", season, bracket.ID, userID)
+
+	return &pb.SubmitBracketResponse{
+		Bracket: modelBracketToProto(bracket),
+		Message: "Bracket saved successfully",
+	}, nil
+}
+
+// GetBracket devuelve el bracket de un usuario con los picks calificados hasta el momento
+func (ps *PredictionService) GetBracket(ctx context.Context, req *pb.GetBracketRequest) (*pb.GetBracketResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+
+	var bracket models.BracketPrediction
+	if err := database.DB.Preload("Picks").
+		Where("user_id = ? AND season = ?", userID, req.Season).
+		First(&bracket).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Bracket not found")
+	}
+
+	return &pb.GetBracketResponse{Bracket: modelBracketToProto(bracket)}, nil
+}
+
+// ========================================
+// Helper Functions - Playoff Brackets
+// ========================================
+
+// scoreBrackets recalifica todos los brackets de la temporada con los juegos
+// de playoffs terminados. Recalcular desde cero hace que reprocesar el mismo
+// juego (o una corrección de marcador) deje el mismo resultado.
+func (ps *PredictionService) scoreBrackets(ctx context.Context, season int) error {
+	playoffBracket, err := ps.fetchPlayoffBracket(ctx, season)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+	results := playoffResults(playoffBracket)
+
+	var brackets []models.BracketPrediction
+	if err := database.DB.Preload("Picks").Where("season = ?", season).Find(&brackets).Error; err != nil {
+		return err
+	}
+	if len(brackets) == 0 {
+		return nil
+	}
+
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		for i := range brackets {
+			if err := gradeBracket(tx, &brackets[i], results); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		log.Printf("Scored %d playoff brackets for %d", len(brackets), season)
+	}
+	return err
+}
+
+// gradeBracket califica los picks del bracket, guarda los totales y registra
+// BracketScored con el puntaje completo
+func gradeBracket(tx *gorm.DB, bracket *models.BracketPrediction, results []playoffs.Result) error {
+	picks := make(playoffs.Bracket)
+	for _, pick := range bracket.Picks {
+		round := playoffs.Round(pick.Round)
+		picks[round] = append(picks[round], pick.TeamID)
+	}
+
+	bracket.Points, bracket.Correct, bracket.Incorrect, bracket.MaxPoints = 0, 0, 0, 0
+	graded := picks.Grade(results)
+	bracket.Picks = bracket.Picks[:0]
+	for _, g := range graded {
+		// Los estados de un pick coinciden con los de una predicción
+		pick := models.BracketPick{
+			BracketID: bracket.ID,
+			Round:     string(g.Round),
+			TeamID:    g.TeamID,
+			Status:    models.PredictionStatus(g.Status),
+			Points:    g.Points,
+		}
+		if err := tx.Model(&pick).Updates(map[string]interface{}{
+			"status": pick.Status,
+			"points": pick.Points,
+		}).Error; err != nil {
+			return err
+		}
+		bracket.Picks = append(bracket.Picks, pick)
+
+		switch pick.Status {
+		case models.PredictionStatusCorrect:
+			bracket.Correct++
+			bracket.Points += pick.Points
+			bracket.MaxPoints += pick.Points
+		case models.PredictionStatusIncorrect:
+			bracket.Incorrect++
+		default:
+			bracket.MaxPoints += g.Round.Points()
+		}
+	}
+
+	if err := tx.Model(&models.BracketPrediction{}).Where("id = ?", bracket.ID).Updates(map[string]interface{}{
+		"points":     bracket.Points,
+		"correct":    bracket.Correct,
+		"incorrect":  bracket.Incorrect,
+		"max_points": bracket.MaxPoints,
+	}).Error; err != nil {
+		return err
+	}
+	return events.Publish(tx, serviceName, events.TypeBracketScored, bracket.ID, events.BracketScored{
+		UserID:    bracket.UserID,
+		Season:    bracket.Season,
+		Points:    bracket.Points,
+		Correct:   bracket.Correct,
+		Incorrect: bracket.Incorrect,
+		MaxPoints: bracket.MaxPoints,
+	})
+}
+
+// fetchPlayoffBracket obtiene los sembrados y juegos de playoffs del Game Service
+func (ps *PredictionService) fetchPlayoffBracket(ctx context.Context, season int) (*pb.PlayoffBracket, error) {
+	resp, err := ps.gameClient.GetPlayoffBracket(ctx, &pb.GetPlayoffBracketRequest{Season: int32(season)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "the %d playoff bracket has not been generated", season)
+		}
+		log.Printf("Error fetching playoff bracket %d: %v", season, err)
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch playoff bracket: %v", err)
+	}
+	return resp.Bracket, nil
+}
+
+// bracketOpen indica si todavía no empezó ningún juego del Wild Card
+func bracketOpen(bracket *pb.PlayoffBracket) bool {
+	for _, game := range bracket.Games {
+		if game.Round != pb.PlayoffRound_PLAYOFF_ROUND_WILD_CARD || !predictionOpen(game.Game) {
+			return false
+		}
+	}
+	return true
+}
+
+func playoffSeedsFromProto(bracket *pb.PlayoffBracket) []playoffs.Seed {
+	var seeds []playoffs.Seed
+	for _, seed := range bracket.Seeds {
+		seeds = append(seeds, playoffs.Seed{
+			Conference: strings.TrimPrefix(seed.Conference.String(), "CONFERENCE_"),
+			Seed:       int(seed.Seed),
+			TeamID:     seed.TeamId,
+		})
+	}
+	return seeds
+}
+
+// playoffResults devuelve el ganador y el perdedor de cada juego de playoffs terminado
+func playoffResults(bracket *pb.PlayoffBracket) []playoffs.Result {
+	rounds := map[pb.PlayoffRound]playoffs.Round{
+		pb.PlayoffRound_PLAYOFF_ROUND_WILD_CARD:  playoffs.RoundWildCard,
+		pb.PlayoffRound_PLAYOFF_ROUND_DIVISIONAL: playoffs.RoundDivisional,
+		pb.PlayoffRound_PLAYOFF_ROUND_CONFERENCE: playoffs.RoundConference,
+		pb.PlayoffRound_PLAYOFF_ROUND_SUPER_BOWL: playoffs.RoundSuperBowl,
+	}
+
+	var results []playoffs.Result
+	for _, pg := range bracket.Games {
+		game := pg.Game
+		// En playoffs no hay empates: el marcador final define al ganador
+		if game.Status != pb.GameStatus_GAME_STATUS_COMPLETED || game.HomeScore == game.AwayScore {
+			continue
+		}
+		winner, loser := game.HomeTeamId, game.AwayTeamId
+		if game.AwayScore > game.HomeScore {
+			winner, loser = loser, winner
+		}
+		results = append(results, playoffs.Result{
+			Round:        rounds[pg.Round],
+			WinnerTeamID: winner,
+			LoserTeamID:  loser,
+		})
+	}
+	return results
+}
+
+func normalizeTeams(teams []string) []string {
+	var out []string
+	for _, team := range teams {
+		if team = strings.ToUpper(strings.TrimSpace(team)); team != "" {
+			out = append(out, team)
+		}
+	}
+	return out
+}
+
+func modelBracketToProto(bracket models.BracketPrediction) *pb.Bracket {
+	picks := append([]models.BracketPick(nil), bracket.Picks...)
+	sort.SliceStable(picks, func(i, j int) bool {
+		wi, wj := playoffs.Round(picks[i].Round).Week(), playoffs.Round(picks[j].Round).Week()
+		if wi != wj {
+			return wi < wj
+		}
+		return picks[i].TeamID < picks[j].TeamID
+	})
+
+	pbBracket := &pb.Bracket{
+		Id:        bracket.ID,
+		UserId:    bracket.UserID,
+		Season:    int32(bracket.Season),
+		Points:    int32(bracket.Points),
+		Correct:   int32(bracket.Correct),
+		Incorrect: int32(bracket.Incorrect),
+		MaxPoints: int32(bracket.MaxPoints),
+		CreatedAt: timestamppb.New(bracket.CreatedAt),
+		UpdatedAt: timestamppb.New(bracket.UpdatedAt),
+	}
+	for _, pick := range picks {
+		pbBracket.Picks = append(pbBracket.Picks, &pb.BracketPick{
+			Round:  pick.Round,
+			TeamId: pick.TeamID,
+			Status: modelStatusToProto(pick.Status),
+			Points: int32(pick.Points),
+		})
+	}
+	return pbBracket
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/grading"
+	"kickoff.com/prediction/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Confidence Pool
+// ========================================
+
+// SubmitConfidencePicks guarda el ranking de un usuario para una semana: cada
+// pick lleva un valor de confianza de 1 a N (N = cantidad de juegos de la
+// semana) usado una sola vez, y un acierto suma ese valor. El ranking puede no
+// incluir todos los juegos; los valores sin usar no suman. Los picks de
+// juegos que ya empezaron deben enviarse sin cambios.
+func (ps *PredictionService) SubmitConfidencePicks(ctx context.Context, req *pb.SubmitConfidencePicksRequest) (*pb.SubmitConfidencePicksResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Season <= 0 || req.Week <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "season and week are required")
+	}
+	if len(req.Picks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one pick is required")
+	}
+
+	picks := make(map[string]*pb.ConfidencePick, len(req.Picks))
+	confidences := make([]int, 0, len(req.Picks))
+	for _, pick := range req.Picks {
+		if pick.GameId == "" || pick.PredictedWinnerId == "" {
+			return nil, status.Error(codes.InvalidArgument, "game_id and predicted_winner_id are required for every pick")
+		}
+		if _, ok := picks[pick.GameId]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "game %s is picked more than once", pick.GameId)
+		}
+		picks[pick.GameId] = pick
+		confidences = append(confidences, int(pick.Confidence))
+	}
+
+	weekGames, err := ps.weekGameCount(ctx, req.Season, req.Week)
+	if err != nil {
+		return nil, err
+	}
+	if err := grading.CheckConfidenceRanking(confidences, weekGames); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Validar cada juego antes de abrir la transacción
+	games := make(map[string]*pb.Game, len(picks))
+	for gameID, pick := range picks {
+		game, err := ps.fetchGame(ctx, gameID)
+		if err != nil {
+			return nil, err
+		}
+		if int(game.Season) != int(req.Season) || int(game.Week) != int(req.Week) {
+			return nil, status.Errorf(codes.InvalidArgument, "game %s is not in week %d of season %d", gameID, req.Week, req.Season)
+		}
+		winner := strings.ToUpper(pick.PredictedWinnerId)
+		if winner != game.HomeTeamId && winner != game.AwayTeamId {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %s", shared.ErrTeamNotInGame.Error(), gameID)
+		}
+		games[gameID] = game
+	}
+
+	var saved []models.Prediction
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		// El lock serializa los envíos concurrentes del mismo usuario. Los picks
+		// anulados que liberaron su confianza ya no son parte del ranking.
+		var existing []models.Prediction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND type = ? AND ((season = ? AND week = ?) OR game_id IN ?)",
+				userID, models.PredictionTypeWinner, req.Season, req.Week, keys(picks)).
+			Where("status <> ? OR confidence > 0", models.PredictionStatusVoid).
+			Find(&existing).Error; err != nil {
+			return err
+		}
+
+		byGame := make(map[string]models.Prediction, len(existing))
+		for _, pred := range existing {
+			if _, ok := picks[pred.GameID]; !ok {
+				return status.Errorf(codes.InvalidArgument, "the ranking must include your pick for game %s", pred.GameID)
+			}
+			byGame[pred.GameID] = pred
+		}
+
+		var changed []models.Prediction
+		var created []models.Prediction
+		for gameID, pick := range picks {
+			winner := strings.ToUpper(pick.PredictedWinnerId)
+			confidence := int(pick.Confidence)
+
+			pred, ok := byGame[gameID]
+			if !ok {
+				if !predictionOpen(games[gameID]) {
+					return status.Errorf(codes.FailedPrecondition, "%s: %s", shared.ErrPredictionTooLate.Error(), gameID)
+				}
+				created = append(created, models.Prediction{
+					ID:                idgen.New(idgen.PrefixPrediction),
+					UserID:            userID,
+					GameID:            gameID,
+					Type:              models.PredictionTypeWinner,
+					PredictedWinnerID: winner,
+					Season:            int(req.Season),
+					Week:              int(req.Week),
+					Confidence:        confidence,
+					Status:            models.PredictionStatusPending,
+				})
+				continue
+			}
+
+			if pred.PredictedWinnerID == winner && pred.Confidence == confidence && pred.Season == int(req.Season) && pred.Week == int(req.Week) {
+				saved = append(saved, pred)
+				continue
+			}
+			if pred.Status != models.PredictionStatusPending || !predictionOpen(games[gameID]) {
+				return status.Errorf(codes.FailedPrecondition, "%s: %s", shared.ErrPredictionTooLate.Error(), gameID)
+			}
+			pred.PredictedWinnerID = winner
+			pred.Confidence = confidence
+			pred.Season = int(req.Season)
+			pred.Week = int(req.Week)
+			changed = append(changed, pred)
+		}
+
+		// Liberar primero los valores que cambian para no chocar con el índice
+		// único mientras se reordena el ranking
+		if len(changed) > 0 {
+			ids := make([]string, 0, len(changed))
+			for _, pred := range changed {
+				ids = append(ids, pred.ID)
+			}
+			if err := tx.Model(&models.Prediction{}).Where("id IN ?", ids).Update("confidence", 0).Error; err != nil {
+				return err
+			}
+		}
+		for _, pred := range changed {
+			if err := tx.Model(&pred).Updates(map[string]interface{}{
+				"predicted_winner_id": pred.PredictedWinnerID,
+				"confidence":          pred.Confidence,
+				"season":              pred.Season,
+				"week":                pred.Week,
+			}).Error; err != nil {
+				return err
+			}
+			saved = append(saved, pred)
+		}
+
+		for _, pred := range created {
+			if err := tx.Create(&pred).Error; err != nil {
+				return err
+			}
+			if err := events.Publish(tx, serviceName, events.TypePredictionCreated, pred.ID, events.PredictionCreated{
+				PredictionID:      pred.ID,
+				UserID:            pred.UserID,
+				GameID:            pred.GameID,
+				PredictedWinnerID: pred.PredictedWinnerID,
+			}); err != nil {
+				return err
+			}
+			saved = append(saved, pred)
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error saving confidence picks: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to save confidence picks: %v", err)
+	}
+
+	sort.Slice(saved, func(i, j int) bool {
+		return saved[i].Confidence > saved[j].Confidence
+	})
+
+	resp := &pb.SubmitConfidencePicksResponse{
+		Message: fmt.Sprintf("Saved %d confidence picks for week %d", len(saved), req.Week),
+	}
+	for _, pred := range saved {
+		resp.Predictions = append(resp.Predictions, modelPredictionToProto(pred))
+	}
+
+	log.Printf("Saved %d confidence picks for user %s (season %d, week %d)", len(saved), userID, req.Season, req.Week)
+
+	return resp, nil
+}
+
+// ========================================
+// Helper Functions - Confidence Pool
+// ========================================
+
+// hasConfidencePicks indica si el usuario ya jugó la semana como pool de confianza
+func hasConfidencePicks(userID string, season, week int) bool {
+	var count int64
+	database.DB.Model(&models.Prediction{}).
+		Where("user_id = ? AND season = ? AND week = ? AND confidence > 0", userID, season, week).
+		Count(&count)
+	return count > 0
+}
+
+// weekGameCount consulta al Game Service cuántos juegos tiene la semana, que
+// es el valor de confianza más alto permitido. Alcanza con el total de la
+// primera página.
+func (ps *PredictionService) weekGameCount(ctx context.Context, season, week int32) (int, error) {
+	resp, err := ps.gameClient.GetAllGames(ctx, &pb.GetAllGamesRequest{
+		Season:   season,
+		WeekFrom: week,
+		WeekTo:   week,
+		PageSize: 1,
+	})
+	if err != nil {
+		log.Printf("Error fetching games for week %d: %v", week, err)
+		return 0, status.Errorf(codes.Unavailable, "failed to fetch games: %v", err)
+	}
+	return int(resp.Total), nil
+}
+
+func keys(picks map[string]*pb.ConfidencePick) []string {
+	ids := make([]string, 0, len(picks))
+	for id := range picks {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// useTestDB apunta database.DB a una base SQLite en memoria con las tablas del servicio
+func useTestDB(t *testing.T) {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		sqlDB.Close()
+	})
+	if err := database.AutoMigrate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// asUser devuelve un contexto autenticado como userID, como lo deja el interceptor
+func asUser(userID string) context.Context {
+	return auth.NewContext(context.Background(), auth.Claims{Subject: userID})
+}
+
+// weekGames arma n juegos por empezar de la semana 1 de 2026, más uno de otra temporada
+func weekGames(n int) map[string]*pb.Game {
+	games := make(map[string]*pb.Game)
+	for _, id := range []string{"game_1", "game_2", "game_3", "game_4"}[:n] {
+		game := testGame(id, pb.GameStatus_GAME_STATUS_SCHEDULED, time.Now().Add(time.Hour))
+		game.Season, game.Week = 2026, 1
+		games[id] = game
+	}
+	old := testGame("game_2025", pb.GameStatus_GAME_STATUS_SCHEDULED, time.Now().Add(time.Hour))
+	old.Season, old.Week = 2025, 1
+	games[old.Id] = old
+	return games
+}
+
+func confidenceRequest(picks ...*pb.ConfidencePick) *pb.SubmitConfidencePicksRequest {
+	return &pb.SubmitConfidencePicksRequest{Season: 2026, Week: 1, Picks: picks}
+}
+
+func pick(gameID string, confidence int32) *pb.ConfidencePick {
+	return &pb.ConfidencePick{GameId: gameID, PredictedWinnerId: "kc", Confidence: confidence}
+}
+
+func TestSubmitConfidencePicksValidation(t *testing.T) {
+	useTestDB(t)
+	ps := &PredictionService{gameClient: &fakeGameClient{games: weekGames(3)}}
+	ctx := asUser("user_1")
+
+	tests := []struct {
+		name string
+		req  *pb.SubmitConfidencePicksRequest
+		want codes.Code
+	}{
+		// La semana tiene 3 juegos de 2026: el juego de 2025 no cuenta para N
+		{"valor mayor que los juegos de la semana", confidenceRequest(pick("game_1", 4)), codes.InvalidArgument},
+		{"valor repetido", confidenceRequest(pick("game_1", 2), pick("game_2", 2)), codes.InvalidArgument},
+		{"juego repetido", confidenceRequest(pick("game_1", 1), pick("game_1", 2)), codes.InvalidArgument},
+		{"juego de otra temporada", confidenceRequest(pick("game_2025", 1)), codes.InvalidArgument},
+		{"juego inexistente", confidenceRequest(pick("game_9", 1)), codes.NotFound},
+		{"sin picks", confidenceRequest(), codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		_, err := ps.SubmitConfidencePicks(ctx, tt.req)
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("%s: code = %v (%v), want %v", tt.name, got, err, tt.want)
+		}
+	}
+
+	ps.gameClient = &fakeGameClient{err: status.Error(codes.Unavailable, "down")}
+	_, err := ps.SubmitConfidencePicks(ctx, confidenceRequest(pick("game_1", 1)))
+	if got := status.Code(err); got != codes.Unavailable {
+		t.Errorf("Game Service caído: code = %v, want Unavailable", got)
+	}
+}
+
+func TestSubmitConfidencePicksRerank(t *testing.T) {
+	useTestDB(t)
+	ps := &PredictionService{gameClient: &fakeGameClient{games: weekGames(3)}}
+	ctx := asUser("user_1")
+
+	// Un ranking parcial deja sin usar el valor 2
+	resp, err := ps.SubmitConfidencePicks(ctx, confidenceRequest(pick("game_1", 3), pick("game_2", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Predictions) != 2 || resp.Predictions[0].Confidence != 3 || resp.Predictions[0].PredictedWinnerId != "KC" {
+		t.Fatalf("SubmitConfidencePicks() = %v", resp.Predictions)
+	}
+
+	// Reordenar intercambia valores sin chocar con el índice único
+	if _, err := ps.SubmitConfidencePicks(ctx, confidenceRequest(pick("game_1", 1), pick("game_2", 3), pick("game_3", 2))); err != nil {
+		t.Fatal(err)
+	}
+	var saved []models.Prediction
+	database.DB.Where("user_id = ?", "user_1").Order("game_id").Find(&saved)
+	want := map[string]int{"game_1": 1, "game_2": 3, "game_3": 2}
+	if len(saved) != len(want) {
+		t.Fatalf("saved %d predictions, want %d", len(saved), len(want))
+	}
+	for _, pred := range saved {
+		if pred.Confidence != want[pred.GameID] {
+			t.Errorf("%s confidence = %d, want %d", pred.GameID, pred.Confidence, want[pred.GameID])
+		}
+	}
+
+	// Un ranking nuevo tiene que incluir los picks que ya tenía en la semana
+	_, err = ps.SubmitConfidencePicks(ctx, confidenceRequest(pick("game_1", 1)))
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Errorf("ranking sin un pick existente: code = %v (%v), want InvalidArgument", got, err)
+	}
+}
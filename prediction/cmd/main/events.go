@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"gorm.io/gorm"
+
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/playoffs"
+	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/grading"
+	"kickoff.com/prediction/internal/models"
+)
+
+// ========================================
+// Event Subscriptions
+// ========================================
+
+func (ps *PredictionService) subscribeEvents(ctx context.Context, transport events.Transport) {
+	events.Subscribe(ctx, transport, "prediction.settlement", ps.handleGameEvent,
+		events.TypeGameStatusChanged, events.TypeScoreUpdated)
+	events.Subscribe(ctx, transport, "prediction.reschedule", ps.handleGameRescheduled,
+		events.TypeGameRescheduled)
+}
+
+// handleGameEvent califica las predicciones cuando un juego termina o se
+// cancela, y las recalifica si se corrige el marcador de un juego ya terminado.
+// Un juego cancelado anula todas sus predicciones, también las ya calificadas.
+// Un juego que el Game Service ya no encuentra se saltea (error permanente);
+// si el servicio no responde, el evento se reintenta.
+func (ps *PredictionService) handleGameEvent(ctx context.Context, event events.Event) error {
+	regrade := false
+	switch event.Type {
+	case events.TypeGameStatusChanged:
+		var payload events.GameStatusChanged
+		if err := event.Decode(&payload); err != nil {
+			log.Printf("Skipping malformed event %s: %v", event.ID, err)
+			return nil
+		}
+		if payload.Status != "completed" && payload.Status != "canceled" {
+			return nil
+		}
+		regrade = payload.Status == "canceled"
+	case events.TypeScoreUpdated:
+		regrade = true
+	}
+	if event.AggregateID == "" {
+		log.Printf("Skipping event %s without game id", event.ID)
+		return nil
+	}
+
+	game, err := ps.fetchGame(ctx, event.AggregateID)
+	if err != nil {
+		return err
+	}
+	if !grading.IsSettled(game) {
+		return nil
+	}
+
+	graded, err := gradeGame(game, regrade)
+	if err != nil {
+		return err
+	}
+	if len(graded) > 0 {
+		log.Printf("Graded %d predictions for game %s (%s)", len(graded), game.Id, event.Type)
+	}
+
+	// Los juegos de playoffs también definen los brackets de la temporada
+	if game.Week >= playoffs.FirstWeek {
+		return ps.scoreBrackets(ctx, int(game.Season))
+	}
+	return nil
+}
+
+// handleGameRescheduled aplica la política de un juego reprogramado a sus
+// predicciones pendientes. Con "void" se anulan y el usuario puede volver a
+// elegir; con "keep" se conservan y pasan a la nueva semana, salvo los picks de
+// confianza y de survivor, que pertenecen a la semana original y se anulan.
+func (ps *PredictionService) handleGameRescheduled(ctx context.Context, event events.Event) error {
+	var payload events.GameRescheduled
+	if err := event.Decode(&payload); err != nil {
+		log.Printf("Skipping malformed event %s: %v", event.ID, err)
+		return nil
+	}
+
+	var voided, moved int
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var predictions []models.Prediction
+		if err := tx.Where("game_id = ? AND status = ?", payload.GameID, models.PredictionStatusPending).
+			Find(&predictions).Error; err != nil {
+			return err
+		}
+
+		weekChanged := payload.Week != payload.PreviousWeek
+		for _, pred := range predictions {
+			if payload.PredictionPolicy == events.PredictionPolicyVoid ||
+				(weekChanged && (pred.IsConfidencePick() || pred.IsSurvivorPick())) {
+				pred.Season = payload.Season
+				pred.Week = payload.Week
+				if err := voidPrediction(tx, &pred); err != nil {
+					return err
+				}
+				voided++
+				continue
+			}
+			if pred.Season == payload.Season && pred.Week == payload.Week {
+				continue
+			}
+			if err := tx.Model(&pred).Updates(map[string]interface{}{
+				"season": payload.Season,
+				"week":   payload.Week,
+			}).Error; err != nil {
+				return err
+			}
+			moved++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if voided > 0 || moved > 0 {
+		log.Printf("Game %s rescheduled (%s): %d predictions voided, %d moved to week %d",
+			payload.GameID, payload.PredictionPolicy, voided, moved, payload.Week)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"kickoff.com/pkg/events"
+	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/models"
+)
+
+func statusEvent(gameID, gameStatus string) events.Event {
+	payload, _ := json.Marshal(events.GameStatusChanged{GameID: gameID, Status: gameStatus})
+	return events.Event{ID: "evt_1", Type: events.TypeGameStatusChanged, AggregateID: gameID, Payload: payload}
+}
+
+func scoreEvent(gameID string) events.Event {
+	payload, _ := json.Marshal(events.ScoreUpdated{GameID: gameID, HomeScore: 24, AwayScore: 20})
+	return events.Event{ID: "evt_2", Type: events.TypeScoreUpdated, AggregateID: gameID, Payload: payload}
+}
+
+func TestHandleGameEventErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		client        *fakeGameClient
+		event         events.Event
+		wantErr       bool
+		wantPermanent bool
+	}{
+		{"juego que no terminó", &fakeGameClient{err: status.Error(codes.Unavailable, "down")}, statusEvent("game_1", "in_progress"), false, false},
+		{"payload mal formado", &fakeGameClient{}, events.Event{ID: "evt_1", Type: events.TypeGameStatusChanged, AggregateID: "game_1", Payload: []byte("{")}, false, false},
+		{"sin id de juego", &fakeGameClient{}, statusEvent("", "completed"), false, false},
+		{"juego inexistente se saltea", &fakeGameClient{}, statusEvent("game_1", "completed"), true, true},
+		{"Game Service caído se reintenta", &fakeGameClient{err: status.Error(codes.Unavailable, "down")}, statusEvent("game_1", "completed"), true, false},
+		{"corrección de marcador", &fakeGameClient{err: status.Error(codes.Unavailable, "down")}, scoreEvent("game_1"), true, false},
+		{"corrección sin id de juego", &fakeGameClient{}, scoreEvent(""), false, false},
+	}
+	for _, tt := range tests {
+		ps := &PredictionService{gameClient: tt.client}
+		err := ps.handleGameEvent(context.Background(), tt.event)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: handleGameEvent() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil && events.IsPermanent(err) != tt.wantPermanent {
+			t.Errorf("%s: IsPermanent(%v) = %v, want %v", tt.name, err, events.IsPermanent(err), tt.wantPermanent)
+		}
+	}
+}
+
+func rescheduleEvent(week int, policy string) events.Event {
+	payload, _ := json.Marshal(events.GameRescheduled{
+		GameID: "game_1", Season: 2026, PreviousWeek: 1, Week: week, PredictionPolicy: policy,
+	})
+	return events.Event{ID: "evt_3", Type: events.TypeGameRescheduled, AggregateID: "game_1", Payload: payload}
+}
+
+func TestHandleGameRescheduled(t *testing.T) {
+	type want struct {
+		status models.PredictionStatus
+		week   int
+	}
+	tests := []struct {
+		name  string
+		event events.Event
+		want  map[string]want
+	}{
+		{"keep en la misma semana no cambia nada", rescheduleEvent(1, events.PredictionPolicyKeep), map[string]want{
+			"winner":     {models.PredictionStatusPending, 1},
+			"confidence": {models.PredictionStatusPending, 1},
+			"survivor":   {models.PredictionStatusPending, 1},
+			"graded":     {models.PredictionStatusCorrect, 1},
+		}},
+		{"keep mueve los picks y anula los de la semana original", rescheduleEvent(3, events.PredictionPolicyKeep), map[string]want{
+			"winner":     {models.PredictionStatusPending, 3},
+			"confidence": {models.PredictionStatusVoid, 3},
+			"survivor":   {models.PredictionStatusVoid, 3},
+			"graded":     {models.PredictionStatusCorrect, 1},
+		}},
+		{"void anula todas las pendientes", rescheduleEvent(1, events.PredictionPolicyVoid), map[string]want{
+			"winner":     {models.PredictionStatusVoid, 1},
+			"confidence": {models.PredictionStatusVoid, 1},
+			"survivor":   {models.PredictionStatusVoid, 1},
+			"graded":     {models.PredictionStatusCorrect, 1},
+		}},
+	}
+	for _, tt := range tests {
+		useTestDB(t)
+		database.DB.Create(&[]models.Prediction{
+			{ID: "winner", UserID: "user_1", GameID: "game_1", Type: models.PredictionTypeWinner, PredictedWinnerID: "KC", Season: 2026, Week: 1},
+			{ID: "confidence", UserID: "user_2", GameID: "game_1", Type: models.PredictionTypeWinner, PredictedWinnerID: "KC", Season: 2026, Week: 1, Confidence: 3},
+			{ID: "survivor", UserID: "user_3", GameID: "game_1", Type: models.PredictionTypeSurvivor, PredictedWinnerID: "KC", Season: 2026, Week: 1},
+			{ID: "graded", UserID: "user_4", GameID: "game_1", Type: models.PredictionTypeWinner, PredictedWinnerID: "KC", Season: 2026, Week: 1, Status: models.PredictionStatusCorrect, Points: 1},
+		})
+
+		ps := &PredictionService{}
+		if err := ps.handleGameRescheduled(context.Background(), tt.event); err != nil {
+			t.Fatalf("%s: handleGameRescheduled() error = %v", tt.name, err)
+		}
+		for id, w := range tt.want {
+			var pred models.Prediction
+			database.DB.First(&pred, "id = ?", id)
+			if pred.Status != w.status || pred.Week != w.week {
+				t.Errorf("%s: %s = %s semana %d, want %s semana %d", tt.name, id, pred.Status, pred.Week, w.status, w.week)
+			}
+			if pred.Status == models.PredictionStatusVoid && pred.Confidence != 0 {
+				t.Errorf("%s: %s keeps confidence %d after being voided", tt.name, id, pred.Confidence)
+			}
+		}
+	}
+}
@@ -8,24 +8,43 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
-
+	"gorm.io/gorm"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	"kickoff.com/pkg/playoffs"
 	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/grading"
 	"kickoff.com/prediction/internal/models"
 	pb "kickoff.com/proto"
 )
 
 const serviceName = "prediction"
 
+// privilegedMethods son los métodos del Prediction Service que exigen un rol
+var privilegedMethods = auth.Methods{
+	pb.PredictionService_UpdatePredictionStatus_FullMethodName: {auth.RoleSiteAdmin},
+	pb.PredictionService_GradeGamePredictions_FullMethodName:   {auth.RoleSiteAdmin},
+}
+
 type PredictionService struct {
 	pb.UnimplementedPredictionServiceServer
+	gameClient pb.GameServiceClient
 }
 
 func main() {
@@ -43,14 +62,49 @@ func main() {
 	defer database.Close()
 	log.Println("✅ Connected to PostgreSQL database")
 
-	predictionService := &PredictionService{}
+	// Conectar al Game Service para validar juegos al crear predicciones
+	gameConn, err := grpc.NewClient("game-service:9082", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to game service: %v", err)
+	}
+	defer gameConn.Close()
+	log.Println("✅ Connected to Game Service gRPC (game-service:9082)")
+
+	predictionService := &PredictionService{
+		gameClient: pb.NewGameServiceClient(gameConn),
+	}
+
+	// Eventos: publicar el outbox y calificar predicciones cuando termina un juego
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transport, err := events.NewTransportFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize event transport: %v", err)
+	}
+	go events.NewRelay(database.DB, transport).Run(ctx)
+	predictionService.subscribeEvents(ctx, transport)
+
+	// Verificador de los access tokens propagados (mismo secreto que el Gateway)
+	tokens, err := auth.NewSignerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize token verifier: %v", err)
+	}
+
+	// Conectar al User Service para rechazar los tokens de usuarios desactivados
+	userConn, err := grpc.NewClient("user-service:9081", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to user service: %v", err)
+	}
+	defer userConn.Close()
+	log.Println("✅ Connected to User Service gRPC (user-service:9081)")
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor(tokens, privilegedMethods, auth.UserServiceActive(pb.NewUserServiceClient(userConn)))))
 	pb.RegisterPredictionServiceServer(grpcServer, predictionService)
 
 	healthServer := health.NewServer()
@@ -69,8 +123,9 @@ func main() {
 
 	<-sigChan
 	log.Println("Shutting down gracefully...")
-	database.Close()
+	cancel()
 	grpcServer.GracefulStop()
+	database.Close()
 }
 
 // ========================================
@@ -78,79 +133,194 @@ func main() {
 // ========================================
 
 func (ps *PredictionService) CreatePrediction(ctx context.Context, req *pb.CreatePredictionRequest) (*pb.CreatePredictionResponse, error) {
-	if req.UserId == "" || req.GameId == "" || req.PredictedWinnerId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id, game_id, and predicted_winner_id are required")
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
 
-	// Verificar que no exista predicción para este usuario y juego
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+
+	predictionType := protoTypeToModel(req.Type)
+	predictedWinnerID := strings.ToUpper(req.PredictedWinnerId)
+	totalPick := strings.ToLower(strings.TrimSpace(req.TotalPick))
+
+	switch predictionType {
+	case models.PredictionTypeWinner:
+		if predictedWinnerID == "" {
+			return nil, shared.InvalidField("predicted_winner_id", "predicted_winner_id is required")
+		}
+	case models.PredictionTypeTotal:
+		if totalPick != models.TotalPickOver && totalPick != models.TotalPickUnder {
+			return nil, shared.InvalidField("total_pick", "total_pick must be \"over\" or \"under\"")
+		}
+		if req.AgainstSpread || predictedWinnerID != "" {
+			return nil, status.Error(codes.InvalidArgument, "total predictions don't take a team or a spread")
+		}
+	case models.PredictionTypeSurvivor:
+		if predictedWinnerID == "" {
+			return nil, shared.InvalidField("predicted_winner_id", "predicted_winner_id is required")
+		}
+		if req.AgainstSpread || totalPick != "" {
+			return nil, status.Error(codes.InvalidArgument, "survivor picks are straight up and don't take a spread or a total")
+		}
+	}
+
+	// Verificar que el juego acepte predicciones y que el equipo participe
+	game, err := ps.checkPredictionWindow(ctx, req.GameId, predictedWinnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// El survivor tiene sus propias reglas: un pick por semana y sin repetir equipo
+	if predictionType == models.PredictionTypeSurvivor {
+		return createSurvivorPick(userID, game, predictedWinnerID)
+	}
+
+	// Verificar que no exista predicción del mismo tipo para este usuario y juego
 	var existing models.Prediction
-	result := database.DB.Where("user_id = ? AND game_id = ?", req.UserId, req.GameId).First(&existing)
+	// Las anuladas (juego reprogramado) no cuentan: el usuario puede volver a elegir
+	result := database.DB.Where("user_id = ? AND game_id = ? AND type = ? AND status <> ?",
+		userID, req.GameId, predictionType, models.PredictionStatusVoid).First(&existing)
 	if result.Error == nil {
-		return nil, status.Error(codes.AlreadyExists, "Prediction already exists for this game")
+		return nil, status.Errorf(codes.AlreadyExists, "A %s prediction already exists for this game", predictionType)
+	}
+
+	// Los picks ATS y over/under guardan la línea vigente: cambios posteriores no los afectan
+	var spread, total float64
+	if req.AgainstSpread {
+		if game.Spread == nil {
+			return nil, status.Error(codes.FailedPrecondition, "game has no point spread")
+		}
+		spread = *game.Spread
+	}
+	if predictionType == models.PredictionTypeTotal {
+		if game.Total == nil {
+			return nil, status.Error(codes.FailedPrecondition, "game has no over/under total")
+		}
+		total = *game.Total
+	}
+
+	// Una semana jugada como pool de confianza solo se modifica con el ranking completo
+	if predictionType == models.PredictionTypeWinner && hasConfidencePicks(userID, int(game.Season), int(game.Week)) {
+		return nil, status.Error(codes.FailedPrecondition, "this week is a confidence pool; submit the full ranking with SubmitConfidencePicks")
 	}
 
-	predictionID := generatePredictionID()
 	prediction := models.Prediction{
-		ID:                predictionID,
-		UserID:            req.UserId,
+		ID:                idgen.New(idgen.PrefixPrediction),
+		UserID:            userID,
 		GameID:            req.GameId,
-		PredictedWinnerID: req.PredictedWinnerId,
+		Type:              predictionType,
+		PredictedWinnerID: predictedWinnerID,
+		TotalPick:         totalPick,
+		Total:             total,
+		Season:            int(game.Season),
+		Week:              int(game.Week),
+		AgainstSpread:     req.AgainstSpread,
+		Spread:            spread,
 		Status:            models.PredictionStatusPending,
 		Points:            0,
 	}
 
-	if err := database.DB.Create(&prediction).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&prediction).Error; err != nil {
+			return err
+		}
+		return events.Publish(tx, serviceName, events.TypePredictionCreated, prediction.ID, events.PredictionCreated{
+			PredictionID:      prediction.ID,
+			UserID:            prediction.UserID,
+			GameID:            prediction.GameID,
+			PredictedWinnerID: prediction.PredictedWinnerID,
+		})
+	})
+	if err != nil {
 		log.Printf("Error creating prediction: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to create prediction: %v", err)
 	}
 
-	log.Printf("Created prediction: %s for user %s on game %s", prediction.ID, req.UserId, req.GameId)
+	log.Printf("Created prediction: %s for user %s on game %s", prediction.ID, userID, req.GameId)
 
 	return &pb.CreatePredictionResponse{
-		Prediction: &pb.Prediction{
-			Id:                prediction.ID,
-			UserId:            prediction.UserID,
-			GameId:            prediction.GameID,
-			PredictedWinnerId: prediction.PredictedWinnerID,
-			Status:            modelStatusToProto(prediction.Status),
-			Points:            int32(prediction.Points),
-			CreatedAt:         timestamppb.New(prediction.CreatedAt),
-			UpdatedAt:         timestamppb.New(prediction.UpdatedAt),
-		},
-		Message: "Prediction created successfully",
+		Prediction: modelPredictionToProto(prediction),
+		Message:    "Prediction created successfully",
 	}, nil
 }
 
+// predictionOrders son los órdenes de los listados de predicciones
+var predictionOrders = pagination.Orders[models.Prediction]{
+	{Name: "created_at", Column: "created_at", Kind: pagination.Time, Value: func(p models.Prediction) interface{} { return p.CreatedAt }},
+	{Name: "week", Column: "week", Kind: pagination.Int, Value: func(p models.Prediction) interface{} { return p.Week }},
+}
+
 func (ps *PredictionService) GetAllPredictions(ctx context.Context, req *pb.GetAllPredictionsRequest) (*pb.GetAllPredictionsResponse, error) {
-	var predictions []models.Prediction
-	if err := database.DB.Find(&predictions).Error; err != nil {
+	if err := pagination.LegacyPage(req.Page); err != nil {
+		return nil, err
+	}
+	if req.Season < 0 {
+		return nil, shared.InvalidField("season", "season cannot be negative")
+	}
+	if req.WeekFrom < 0 || req.WeekTo < 0 {
+		return nil, shared.InvalidField("week_from", "week_from and week_to cannot be negative")
+	}
+	if req.WeekTo > 0 && req.WeekFrom > req.WeekTo {
+		return nil, shared.InvalidField("week_to", "week_to must not be before week_from")
+	}
+	page, err := pagination.New(req.PageSize, req.PageToken, req.OrderBy, predictionOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.Prediction{})
+	if req.UserId != "" {
+		query = query.Where("user_id = ?", req.UserId)
+	}
+	if req.GameId != "" {
+		query = query.Where("game_id = ?", req.GameId)
+	}
+	if req.Season > 0 {
+		query = query.Where("season = ?", req.Season)
+	}
+	if req.WeekFrom > 0 {
+		query = query.Where("week >= ?", req.WeekFrom)
+	}
+	if req.WeekTo > 0 {
+		query = query.Where("week <= ?", req.WeekTo)
+	}
+	if req.Status != pb.PredictionStatus_PREDICTION_STATUS_UNSPECIFIED {
+		query = query.Where("status = ?", protoStatusToModel(req.Status))
+	}
+	if req.Type != pb.PredictionType_PREDICTION_TYPE_UNSPECIFIED {
+		query = query.Where("type = ?", protoTypeToModel(req.Type))
+	}
+	if req.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", req.CreatedAfter.AsTime())
+	}
+	if req.CreatedBefore != nil {
+		query = query.Where("created_at < ?", req.CreatedBefore.AsTime())
+	}
+
+	predictions, total, next, err := pagination.Find(query, page, func(p models.Prediction) string { return p.ID })
+	if err != nil {
 		log.Printf("Error fetching predictions: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch predictions: %v", err)
 	}
 
 	var pbPredictions []*pb.Prediction
 	for _, pred := range predictions {
-		pbPredictions = append(pbPredictions, &pb.Prediction{
-			Id:                pred.ID,
-			UserId:            pred.UserID,
-			GameId:            pred.GameID,
-			PredictedWinnerId: pred.PredictedWinnerID,
-			Status:            modelStatusToProto(pred.Status),
-			Points:            int32(pred.Points),
-			CreatedAt:         timestamppb.New(pred.CreatedAt),
-			UpdatedAt:         timestamppb.New(pred.UpdatedAt),
-		})
+		pbPredictions = append(pbPredictions, modelPredictionToProto(pred))
 	}
 
 	return &pb.GetAllPredictionsResponse{
-		Predictions: pbPredictions,
-		Total:       int32(len(pbPredictions)),
+		Predictions:   pbPredictions,
+		Total:         int32(total),
+		NextPageToken: next,
 	}, nil
 }
 
 func (ps *PredictionService) GetPredictionByID(ctx context.Context, req *pb.GetPredictionByIDRequest) (*pb.GetPredictionByIDResponse, error) {
 	if req.PredictionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "prediction_id is required")
+		return nil, shared.InvalidField("prediction_id", "prediction_id is required")
 	}
 
 	var prediction models.Prediction
@@ -159,135 +329,139 @@ func (ps *PredictionService) GetPredictionByID(ctx context.Context, req *pb.GetP
 	}
 
 	return &pb.GetPredictionByIDResponse{
-		Prediction: &pb.Prediction{
-			Id:                prediction.ID,
-			UserId:            prediction.UserID,
-			GameId:            prediction.GameID,
-			PredictedWinnerId: prediction.PredictedWinnerID,
-			Status:            modelStatusToProto(prediction.Status),
-			Points:            int32(prediction.Points),
-			CreatedAt:         timestamppb.New(prediction.CreatedAt),
-			UpdatedAt:         timestamppb.New(prediction.UpdatedAt),
-		},
+		Prediction: modelPredictionToProto(prediction),
 	}, nil
 }
 
 func (ps *PredictionService) GetUserPredictions(ctx context.Context, req *pb.GetUserPredictionsRequest) (*pb.GetUserPredictionsResponse, error) {
 	if req.UserId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		return nil, shared.InvalidField("user_id", "user_id is required")
+	}
+
+	page, err := pagination.New(req.PageSize, req.PageToken, "", predictionOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
 	}
 
-	var predictions []models.Prediction
-	if err := database.DB.Where("user_id = ?", req.UserId).Find(&predictions).Error; err != nil {
+	query := database.DB.Model(&models.Prediction{}).Where("user_id = ?", req.UserId)
+	predictions, total, next, err := pagination.Find(query, page, func(p models.Prediction) string { return p.ID })
+	if err != nil {
 		log.Printf("Error fetching user predictions: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch user predictions: %v", err)
 	}
 
-	var pbPredictions []*pb.Prediction
-	var correct, incorrect, pending int32
-	for _, pred := range predictions {
-		pbPredictions = append(pbPredictions, &pb.Prediction{
-			Id:                pred.ID,
-			UserId:            pred.UserID,
-			GameId:            pred.GameID,
-			PredictedWinnerId: pred.PredictedWinnerID,
-			Status:            modelStatusToProto(pred.Status),
-			Points:            int32(pred.Points),
-			CreatedAt:         timestamppb.New(pred.CreatedAt),
-			UpdatedAt:         timestamppb.New(pred.UpdatedAt),
-		})
+	// Los contadores son de todas las predicciones del usuario, no de la página
+	var counts []struct {
+		Status models.PredictionStatus
+		Count  int32
+	}
+	if err := query.Session(&gorm.Session{}).Select("status, COUNT(*) AS count").Group("status").Scan(&counts).Error; err != nil {
+		log.Printf("Error counting user predictions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch user predictions: %v", err)
+	}
 
-		switch pred.Status {
+	var correct, incorrect, pending int32
+	for _, count := range counts {
+		switch count.Status {
 		case models.PredictionStatusCorrect:
-			correct++
+			correct = count.Count
 		case models.PredictionStatusIncorrect:
-			incorrect++
+			incorrect = count.Count
 		case models.PredictionStatusPending:
-			pending++
+			pending = count.Count
 		}
 	}
 
+	var pbPredictions []*pb.Prediction
+	for _, pred := range predictions {
+		pbPredictions = append(pbPredictions, modelPredictionToProto(pred))
+	}
+
 	percentage := 0.0
 	if correct+incorrect > 0 {
 		percentage = float64(correct) / float64(correct+incorrect) * 100
 	}
 
 	return &pb.GetUserPredictionsResponse{
-		UserId:      req.UserId,
-		Predictions: pbPredictions,
-		Total:       int32(len(pbPredictions)),
-		Correct:     correct,
-		Incorrect:   incorrect,
-		Pending:     pending,
-		Percentage:  percentage,
+		UserId:        req.UserId,
+		Predictions:   pbPredictions,
+		Total:         int32(total),
+		Correct:       correct,
+		Incorrect:     incorrect,
+		Pending:       pending,
+		Percentage:    percentage,
+		NextPageToken: next,
 	}, nil
 }
 
 func (ps *PredictionService) GetGamePredictions(ctx context.Context, req *pb.GetGamePredictionsRequest) (*pb.GetGamePredictionsResponse, error) {
 	if req.GameId == "" {
-		return nil, status.Error(codes.InvalidArgument, "game_id is required")
+		return nil, shared.InvalidField("game_id", "game_id is required")
 	}
 
-	var predictions []models.Prediction
-	if err := database.DB.Where("game_id = ?", req.GameId).Find(&predictions).Error; err != nil {
+	page, err := pagination.New(req.PageSize, req.PageToken, "", predictionOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	query := database.DB.Model(&models.Prediction{}).Where("game_id = ?", req.GameId)
+	predictions, total, next, err := pagination.Find(query, page, func(p models.Prediction) string { return p.ID })
+	if err != nil {
 		log.Printf("Error fetching game predictions: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch game predictions: %v", err)
 	}
 
 	var pbPredictions []*pb.Prediction
 	for _, pred := range predictions {
-		pbPredictions = append(pbPredictions, &pb.Prediction{
-			Id:                pred.ID,
-			UserId:            pred.UserID,
-			GameId:            pred.GameID,
-			PredictedWinnerId: pred.PredictedWinnerID,
-			Status:            modelStatusToProto(pred.Status),
-			Points:            int32(pred.Points),
-			CreatedAt:         timestamppb.New(pred.CreatedAt),
-			UpdatedAt:         timestamppb.New(pred.UpdatedAt),
-		})
+		pbPredictions = append(pbPredictions, modelPredictionToProto(pred))
 	}
 
 	return &pb.GetGamePredictionsResponse{
-		GameId:      req.GameId,
-		Predictions: pbPredictions,
-		Total:       int32(len(pbPredictions)),
+		GameId:        req.GameId,
+		Predictions:   pbPredictions,
+		Total:         int32(total),
+		NextPageToken: next,
 	}, nil
 }
 
 func (ps *PredictionService) GetWeekPredictions(ctx context.Context, req *pb.GetWeekPredictionsRequest) (*pb.GetWeekPredictionsResponse, error) {
-	// Esta funcionalidad requeriría join con la tabla de games
-	// Por ahora retornamos todas las predicciones
-	var predictions []models.Prediction
-	if err := database.DB.Find(&predictions).Error; err != nil {
+	week, err := strconv.Atoi(strings.TrimSpace(req.Week))
+	if err != nil || week < 1 {
+		return nil, shared.InvalidField("week", "week must be a positive number")
+	}
+	page, err := pagination.New(req.PageSize, req.PageToken, "", predictionOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
+	// La semana y temporada se copian del juego al crear (o calificar) la predicción
+	query := database.DB.Model(&models.Prediction{}).Where("week = ?", week)
+	if req.Season > 0 {
+		query = query.Where("season = ?", req.Season)
+	}
+
+	predictions, total, next, err := pagination.Find(query, page, func(p models.Prediction) string { return p.ID })
+	if err != nil {
 		log.Printf("Error fetching week predictions: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch week predictions: %v", err)
 	}
 
 	var pbPredictions []*pb.Prediction
 	for _, pred := range predictions {
-		pbPredictions = append(pbPredictions, &pb.Prediction{
-			Id:                pred.ID,
-			UserId:            pred.UserID,
-			GameId:            pred.GameID,
-			PredictedWinnerId: pred.PredictedWinnerID,
-			Status:            modelStatusToProto(pred.Status),
-			Points:            int32(pred.Points),
-			CreatedAt:         timestamppb.New(pred.CreatedAt),
-			UpdatedAt:         timestamppb.New(pred.UpdatedAt),
-		})
+		pbPredictions = append(pbPredictions, modelPredictionToProto(pred))
 	}
 
 	return &pb.GetWeekPredictionsResponse{
-		Week:        req.Week,
-		Predictions: pbPredictions,
-		Total:       int32(len(pbPredictions)),
+		Week:          req.Week,
+		Predictions:   pbPredictions,
+		Total:         int32(total),
+		NextPageToken: next,
 	}, nil
 }
 
 func (ps *PredictionService) DeletePrediction(ctx context.Context, req *pb.DeletePredictionRequest) (*pb.DeletePredictionResponse, error) {
 	if req.PredictionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "prediction_id is required")
+		return nil, shared.InvalidField("prediction_id", "prediction_id is required")
 	}
 
 	var prediction models.Prediction
@@ -295,12 +469,31 @@ func (ps *PredictionService) DeletePrediction(ctx context.Context, req *pb.Delet
 		return nil, status.Error(codes.NotFound, "Prediction not found")
 	}
 
+	// Solo su dueño o un site_admin la pueden borrar
+	if _, err := auth.ActingUser(ctx, prediction.UserID); err != nil {
+		return nil, err
+	}
+
 	// Solo permitir eliminar predicciones pendientes
 	if prediction.Status != models.PredictionStatusPending {
 		return nil, status.Error(codes.FailedPrecondition, "Can only delete pending predictions")
 	}
 
-	if err := database.DB.Delete(&prediction).Error; err != nil {
+	// Borrar un pick de confianza dejaría un hueco en el ranking de la semana
+	if prediction.IsConfidencePick() {
+		return nil, status.Error(codes.FailedPrecondition, "confidence picks can only be changed with SubmitConfidencePicks")
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&prediction).Error; err != nil {
+			return err
+		}
+		if prediction.IsSurvivorPick() {
+			return refreshSurvivorEntry(tx, prediction.UserID, prediction.Season)
+		}
+		return nil
+	})
+	if err != nil {
 		log.Printf("Error deleting prediction: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to delete prediction: %v", err)
 	}
@@ -315,7 +508,7 @@ func (ps *PredictionService) DeletePrediction(ctx context.Context, req *pb.Delet
 
 func (ps *PredictionService) UpdatePredictionStatus(ctx context.Context, req *pb.UpdatePredictionStatusRequest) (*pb.UpdatePredictionStatusResponse, error) {
 	if req.PredictionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "prediction_id is required")
+		return nil, shared.InvalidField("prediction_id", "prediction_id is required")
 	}
 
 	var prediction models.Prediction
@@ -329,39 +522,232 @@ func (ps *PredictionService) UpdatePredictionStatus(ctx context.Context, req *pb
 		"points": int(req.Points),
 	}
 
-	if err := database.DB.Model(&prediction).Updates(updates).Error; err != nil {
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&prediction).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", req.PredictionId).First(&prediction).Error; err != nil {
+			return err
+		}
+		return publishGraded(tx, prediction, false)
+	})
+	if err != nil {
 		log.Printf("Error updating prediction status: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to update prediction status: %v", err)
 	}
 
-	// Recargar
-	database.DB.Where("id = ?", req.PredictionId).First(&prediction)
-
 	log.Printf("Updated prediction %s status to %v", req.PredictionId, req.Status)
 
 	return &pb.UpdatePredictionStatusResponse{
-		Prediction: &pb.Prediction{
-			Id:                prediction.ID,
-			UserId:            prediction.UserID,
-			GameId:            prediction.GameID,
-			PredictedWinnerId: prediction.PredictedWinnerID,
-			Status:            modelStatusToProto(prediction.Status),
-			Points:            int32(prediction.Points),
-			CreatedAt:         timestamppb.New(prediction.CreatedAt),
-			UpdatedAt:         timestamppb.New(prediction.UpdatedAt),
-		},
-		Message: "Prediction status updated successfully",
+		Prediction: modelPredictionToProto(prediction),
+		Message:    "Prediction status updated successfully",
 	}, nil
 }
 
+func (ps *PredictionService) GradeGamePredictions(ctx context.Context, req *pb.GradeGamePredictionsRequest) (*pb.GradeGamePredictionsResponse, error) {
+	if req.GameId == "" {
+		return nil, shared.InvalidField("game_id", "game_id is required")
+	}
+
+	game, err := ps.fetchGame(ctx, req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !grading.IsSettled(game) {
+		return nil, status.Error(codes.FailedPrecondition, "game is not completed or canceled")
+	}
+
+	graded, err := gradeGame(game, req.Regrade)
+	if err != nil {
+		log.Printf("Error grading predictions for game %s: %v", req.GameId, err)
+		return nil, status.Errorf(codes.Internal, "failed to grade predictions: %v", err)
+	}
+
+	if game.Week >= playoffs.FirstWeek {
+		if err := ps.scoreBrackets(ctx, int(game.Season)); err != nil {
+			log.Printf("Error scoring brackets for game %s: %v", req.GameId, err)
+			return nil, status.Errorf(codes.Internal, "failed to score brackets: %v", err)
+		}
+	}
+
+	resp := &pb.GradeGamePredictionsResponse{
+		GameId: req.GameId,
+		Graded: int32(len(graded)),
+	}
+	for _, pred := range graded {
+		resp.Predictions = append(resp.Predictions, modelPredictionToProto(pred))
+		switch pred.Status {
+		case models.PredictionStatusCorrect:
+			resp.Correct++
+		case models.PredictionStatusIncorrect:
+			resp.Incorrect++
+		case models.PredictionStatusVoid:
+			resp.Voided++
+		}
+	}
+
+	log.Printf("Graded %d predictions for game %s (%d correct, %d incorrect, %d void)",
+		resp.Graded, req.GameId, resp.Correct, resp.Incorrect, resp.Voided)
+
+	return resp, nil
+}
+
 // ========================================
 // Helper Functions
 // ========================================
 
-func generatePredictionID() string {
-	var count int64
-	database.DB.Model(&models.Prediction{}).Count(&count)
-	return fmt.Sprintf("pred_%d", count+1)
+// gradeGame califica las predicciones del juego en una sola transacción y
+// registra un evento PredictionGraded por cada una, que el Leaderboard Service
+// aplica a las estadísticas. Con regrade también recalifica las ya resueltas.
+func gradeGame(game *pb.Game, regrade bool) ([]models.Prediction, error) {
+	var graded []models.Prediction
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("game_id = ?", game.Id)
+		if !regrade {
+			query = query.Where("status = ?", models.PredictionStatusPending)
+		}
+
+		var predictions []models.Prediction
+		if err := query.Find(&predictions).Error; err != nil {
+			return err
+		}
+
+		for _, pred := range predictions {
+			if game.Status == pb.GameStatus_GAME_STATUS_CANCELED {
+				pred.Season = int(game.Season)
+				pred.Week = int(game.Week)
+				if err := voidPrediction(tx, &pred); err != nil {
+					return err
+				}
+				graded = append(graded, pred)
+				continue
+			}
+
+			newStatus, points := grading.Grade(game, pred)
+			// Semana y temporada se actualizan también para las predicciones
+			// creadas antes de que se guardaran
+			if err := tx.Model(&pred).Updates(map[string]interface{}{
+				"status": newStatus,
+				"points": points,
+				"season": int(game.Season),
+				"week":   int(game.Week),
+			}).Error; err != nil {
+				return err
+			}
+			pred.Status = newStatus
+			pred.Points = points
+			pred.Season = int(game.Season)
+			pred.Week = int(game.Week)
+			if err := publishGraded(tx, pred, grading.IsPush(game, pred)); err != nil {
+				return err
+			}
+			graded = append(graded, pred)
+		}
+		return nil
+	})
+	return graded, err
+}
+
+// voidPrediction anula una predicción sin importar el resultado del juego
+// (cancelado o reprogramado) y libera su valor de confianza, así el usuario
+// puede volver a armar el ranking de la semana
+func voidPrediction(tx *gorm.DB, pred *models.Prediction) error {
+	pred.Status = models.PredictionStatusVoid
+	pred.Points = 0
+	pred.Confidence = 0
+	if err := tx.Model(pred).Updates(map[string]interface{}{
+		"status":     pred.Status,
+		"points":     pred.Points,
+		"confidence": pred.Confidence,
+		"season":     pred.Season,
+		"week":       pred.Week,
+	}).Error; err != nil {
+		return err
+	}
+	return publishGraded(tx, *pred, false)
+}
+
+// publishGraded registra en el outbox el resultado de una predicción. Los picks
+// de survivor no cuentan para la tabla de puntos: actualizan la participación
+// del usuario en el survivor pool.
+func publishGraded(tx *gorm.DB, pred models.Prediction, push bool) error {
+	if pred.IsSurvivorPick() {
+		return refreshSurvivorEntry(tx, pred.UserID, pred.Season)
+	}
+	return events.Publish(tx, serviceName, events.TypePredictionGraded, pred.ID, events.PredictionGraded{
+		PredictionID:  pred.ID,
+		UserID:        pred.UserID,
+		GameID:        pred.GameID,
+		Result:        string(pred.Status),
+		Points:        pred.Points,
+		Season:        pred.Season,
+		Week:          pred.Week,
+		AgainstSpread: pred.AgainstSpread,
+		Push:          push,
+	})
+}
+
+// fetchGame obtiene un juego del Game Service traduciendo los errores a códigos gRPC
+func (ps *PredictionService) fetchGame(ctx context.Context, gameID string) (*pb.Game, error) {
+	resp, err := ps.gameClient.GetGameByID(ctx, &pb.GetGameByIDRequest{GameId: gameID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "game not found: %s", gameID)
+		}
+		log.Printf("Error fetching game %s: %v", gameID, err)
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch game: %v", err)
+	}
+	return resp.Game, nil
+}
+
+func modelPredictionToProto(pred models.Prediction) *pb.Prediction {
+	return &pb.Prediction{
+		Id:                pred.ID,
+		UserId:            pred.UserID,
+		GameId:            pred.GameID,
+		Type:              modelTypeToProto(pred.Type),
+		PredictedWinnerId: pred.PredictedWinnerID,
+		TotalPick:         pred.TotalPick,
+		Total:             pred.Total,
+		Status:            modelStatusToProto(pred.Status),
+		Points:            int32(pred.Points),
+		Season:            int32(pred.Season),
+		Week:              int32(pred.Week),
+		Confidence:        int32(pred.Confidence),
+		AgainstSpread:     pred.AgainstSpread,
+		Spread:            pred.Spread,
+		CreatedAt:         timestamppb.New(pred.CreatedAt),
+		UpdatedAt:         timestamppb.New(pred.UpdatedAt),
+	}
+}
+
+// checkPredictionWindow consulta el Game Service y verifica que el juego siga
+// abierto a predicciones (programado y antes de la hora de inicio) y, si se
+// elige un equipo, que sea uno de los dos que juegan.
+func (ps *PredictionService) checkPredictionWindow(ctx context.Context, gameID, teamID string) (*pb.Game, error) {
+	game, err := ps.fetchGame(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !predictionOpen(game) {
+		return nil, status.Error(codes.FailedPrecondition, shared.ErrPredictionTooLate.Error())
+	}
+
+	if teamID != "" && teamID != game.HomeTeamId && teamID != game.AwayTeamId {
+		return nil, status.Error(codes.InvalidArgument, shared.ErrTeamNotInGame.Error())
+	}
+
+	return game, nil
+}
+
+// predictionOpen indica si el juego sigue programado y todavía no empezó
+func predictionOpen(game *pb.Game) bool {
+	if game.Status != pb.GameStatus_GAME_STATUS_SCHEDULED {
+		return false
+	}
+	return game.ScheduledAt == nil || time.Now().Before(game.ScheduledAt.AsTime())
 }
 
 func modelStatusToProto(status models.PredictionStatus) pb.PredictionStatus {
@@ -393,3 +779,27 @@ func protoStatusToModel(status pb.PredictionStatus) models.PredictionStatus {
 		return models.PredictionStatusPending
 	}
 }
+
+func modelTypeToProto(predictionType models.PredictionType) pb.PredictionType {
+	switch predictionType {
+	case models.PredictionTypeWinner:
+		return pb.PredictionType_PREDICTION_TYPE_WINNER
+	case models.PredictionTypeTotal:
+		return pb.PredictionType_PREDICTION_TYPE_TOTAL
+	case models.PredictionTypeSurvivor:
+		return pb.PredictionType_PREDICTION_TYPE_SURVIVOR
+	default:
+		return pb.PredictionType_PREDICTION_TYPE_UNSPECIFIED
+	}
+}
+
+func protoTypeToModel(predictionType pb.PredictionType) models.PredictionType {
+	switch predictionType {
+	case pb.PredictionType_PREDICTION_TYPE_TOTAL:
+		return models.PredictionTypeTotal
+	case pb.PredictionType_PREDICTION_TYPE_SURVIVOR:
+		return models.PredictionTypeSurvivor
+	default:
+		return models.PredictionTypeWinner
+	}
+}
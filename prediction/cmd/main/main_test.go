@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "kickoff.com/proto"
+)
+
+// fakeGameClient responde GetGameByID y GetAllGames con los juegos
+// cargados; err simula una falla del Game Service
+type fakeGameClient struct {
+	pb.GameServiceClient
+	games map[string]*pb.Game
+	err   error
+}
+
+func (f *fakeGameClient) GetGameByID(ctx context.Context, req *pb.GetGameByIDRequest, opts ...grpc.CallOption) (*pb.GetGameByIDResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	game, ok := f.games[req.GameId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "game not found")
+	}
+	return &pb.GetGameByIDResponse{Game: game}, nil
+}
+
+// GetAllGames solo filtra por temporada y rango de semanas; devuelve el
+// total sin paginar
+func (f *fakeGameClient) GetAllGames(ctx context.Context, req *pb.GetAllGamesRequest, opts ...grpc.CallOption) (*pb.GetAllGamesResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := &pb.GetAllGamesResponse{}
+	for _, game := range f.games {
+		if game.Season == req.Season && game.Week >= req.WeekFrom && game.Week <= req.WeekTo {
+			resp.Games = append(resp.Games, game)
+		}
+	}
+	resp.Total = int32(len(resp.Games))
+	return resp, nil
+}
+
+func testGame(id string, gameStatus pb.GameStatus, kickoff time.Time) *pb.Game {
+	return &pb.Game{
+		Id:          id,
+		HomeTeamId:  "KC",
+		AwayTeamId:  "BUF",
+		Status:      gameStatus,
+		ScheduledAt: timestamppb.New(kickoff),
+	}
+}
+
+func TestCheckPredictionWindow(t *testing.T) {
+	now := time.Now()
+	client := &fakeGameClient{games: map[string]*pb.Game{
+		"upcoming":  testGame("upcoming", pb.GameStatus_GAME_STATUS_SCHEDULED, now.Add(time.Hour)),
+		"kicked":    testGame("kicked", pb.GameStatus_GAME_STATUS_SCHEDULED, now.Add(-time.Minute)),
+		"live":      testGame("live", pb.GameStatus_GAME_STATUS_IN_PROGRESS, now.Add(-time.Hour)),
+		"completed": testGame("completed", pb.GameStatus_GAME_STATUS_COMPLETED, now.Add(-4*time.Hour)),
+		"postponed": testGame("postponed", pb.GameStatus_GAME_STATUS_POSTPONED, now.Add(time.Hour)),
+	}}
+	ps := &PredictionService{gameClient: client}
+
+	tests := []struct {
+		name     string
+		gameID   string
+		teamID   string
+		wantCode codes.Code
+	}{
+		{"local antes del inicio", "upcoming", "KC", codes.OK},
+		{"visitante antes del inicio", "upcoming", "BUF", codes.OK},
+		{"equipo que no juega", "upcoming", "NYJ", codes.InvalidArgument},
+		{"pasó la hora de inicio", "kicked", "KC", codes.FailedPrecondition},
+		{"juego en vivo", "live", "KC", codes.FailedPrecondition},
+		{"juego terminado", "completed", "KC", codes.FailedPrecondition},
+		{"juego postergado", "postponed", "KC", codes.FailedPrecondition},
+		{"juego inexistente", "missing", "KC", codes.NotFound},
+	}
+	for _, tt := range tests {
+		game, err := ps.checkPredictionWindow(context.Background(), tt.gameID, tt.teamID)
+		if code := status.Code(err); code != tt.wantCode {
+			t.Errorf("%s: code = %v, want %v (%v)", tt.name, code, tt.wantCode, err)
+			continue
+		}
+		if err == nil && game.Id != tt.gameID {
+			t.Errorf("%s: game = %s, want %s", tt.name, game.Id, tt.gameID)
+		}
+	}
+}
+
+func TestCheckPredictionWindowUnavailable(t *testing.T) {
+	ps := &PredictionService{gameClient: &fakeGameClient{err: status.Error(codes.Unavailable, "connection refused")}}
+	_, err := ps.checkPredictionWindow(context.Background(), "upcoming", "KC")
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("code = %v, want Unavailable (%v)", status.Code(err), err)
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/prediction/internal/database"
+	"kickoff.com/prediction/internal/grading"
+	"kickoff.com/prediction/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// ========================================
+// gRPC Handlers - Survivor Pool
+// ========================================
+
+// GetSurvivorEntry devuelve la participación de un usuario en el survivor pool
+// de una temporada junto con sus picks y los equipos que ya usó
+func (ps *PredictionService) GetSurvivorEntry(ctx context.Context, req *pb.GetSurvivorEntryRequest) (*pb.GetSurvivorEntryResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Season <= 0 {
+		return nil, shared.InvalidField("season", "season is required")
+	}
+
+	var entry models.SurvivorEntry
+	if err := database.DB.Where("user_id = ? AND season = ?", userID, req.Season).First(&entry).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Survivor entry not found")
+	}
+
+	picks, err := survivorPicks(database.DB, userID, int(req.Season))
+	if err != nil {
+		log.Printf("Error fetching survivor picks: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch survivor picks: %v", err)
+	}
+
+	pbEntry := &pb.SurvivorEntry{
+		UserId:         entry.UserID,
+		Season:         int32(entry.Season),
+		Alive:          entry.Alive,
+		EliminatedWeek: int32(entry.EliminatedWeek),
+		WeeksSurvived:  int32(entry.WeeksSurvived),
+	}
+	for _, pick := range picks {
+		if pick.Status != models.PredictionStatusVoid {
+			pbEntry.UsedTeamIds = append(pbEntry.UsedTeamIds, pick.PredictedWinnerID)
+		}
+		pbEntry.Picks = append(pbEntry.Picks, modelPredictionToProto(pick))
+	}
+
+	return &pb.GetSurvivorEntryResponse{Entry: pbEntry}, nil
+}
+
+// createSurvivorPick guarda el pick semanal de survivor de un usuario. La
+// participación se crea con el primer pick; después cada semana admite un solo
+// pick, un equipo no se repite en la temporada y un eliminado ya no elige.
+func createSurvivorPick(userID string, game *pb.Game, teamID string) (*pb.CreatePredictionResponse, error) {
+	prediction := models.Prediction{
+		ID:                idgen.New(idgen.PrefixPrediction),
+		UserID:            userID,
+		GameID:            game.Id,
+		Type:              models.PredictionTypeSurvivor,
+		PredictedWinnerID: teamID,
+		Season:            int(game.Season),
+		Week:              int(game.Week),
+		Status:            models.PredictionStatusPending,
+	}
+
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		entry, err := lockSurvivorEntry(tx, userID, prediction.Season)
+		if err != nil {
+			return err
+		}
+		if !entry.Alive {
+			return status.Errorf(codes.FailedPrecondition, "eliminated from the %d survivor pool in week %d", entry.Season, entry.EliminatedWeek)
+		}
+
+		picks, err := survivorPicks(tx, userID, prediction.Season)
+		if err != nil {
+			return err
+		}
+		for _, pick := range picks {
+			// Un pick anulado (juego cancelado o reprogramado) no gasta la semana ni el equipo
+			if pick.Status == models.PredictionStatusVoid {
+				continue
+			}
+			if pick.Week == prediction.Week {
+				return status.Errorf(codes.AlreadyExists, "A survivor pick already exists for week %d", pick.Week)
+			}
+			if pick.PredictedWinnerID == teamID {
+				return status.Errorf(codes.FailedPrecondition, "team %s was already used in week %d", teamID, pick.Week)
+			}
+		}
+
+		if err := tx.Create(&prediction).Error; err != nil {
+			return err
+		}
+		if err := events.Publish(tx, serviceName, events.TypePredictionCreated, prediction.ID, events.PredictionCreated{
+			PredictionID:      prediction.ID,
+			UserID:            prediction.UserID,
+			GameID:            prediction.GameID,
+			PredictedWinnerID: prediction.PredictedWinnerID,
+		}); err != nil {
+			return err
+		}
+		return refreshSurvivorEntry(tx, userID, prediction.Season)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error creating survivor pick: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create survivor pick: %v", err)
+	}
+
+	log.Printf("Created survivor pick: %s for user %s (season %d, week %d, team %s)",
+		prediction.ID, userID, prediction.Season, prediction.Week, teamID)
+
+	return &pb.CreatePredictionResponse{
+		Prediction: modelPredictionToProto(prediction),
+		Message:    "Survivor pick created successfully",
+	}, nil
+}
+
+// ========================================
+// Helper Functions - Survivor Pool
+// ========================================
+
+// lockSurvivorEntry crea la participación si no existe y la bloquea hasta el
+// fin de la transacción, serializando los picks concurrentes del usuario
+func lockSurvivorEntry(tx *gorm.DB, userID string, season int) (models.SurvivorEntry, error) {
+	entry := models.SurvivorEntry{UserID: userID, Season: season, Alive: true}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entry).Error; err != nil {
+		return entry, err
+	}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND season = ?", userID, season).
+		First(&entry).Error
+	return entry, err
+}
+
+// refreshSurvivorEntry recalcula la participación a partir de los picks de la
+// temporada y registra el nuevo estado en el outbox. Recalcular en lugar de
+// aplicar la diferencia permite que una corrección de marcador reviva al usuario.
+func refreshSurvivorEntry(tx *gorm.DB, userID string, season int) error {
+	picks, err := survivorPicks(tx, userID, season)
+	if err != nil {
+		return err
+	}
+
+	payload := events.SurvivorEntryUpdated{
+		UserID: userID,
+		Season: season,
+		Picks:  len(picks),
+	}
+
+	// Sin picks no hay participación: el usuario borró su único pick pendiente
+	if len(picks) == 0 {
+		if err := tx.Where("user_id = ? AND season = ?", userID, season).Delete(&models.SurvivorEntry{}).Error; err != nil {
+			return err
+		}
+		return events.Publish(tx, serviceName, events.TypeSurvivorEntryUpdated, userID, payload)
+	}
+
+	alive, eliminatedWeek, weeksSurvived := grading.SurvivorState(picks)
+	entry := models.SurvivorEntry{UserID: userID, Season: season, Alive: true}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entry).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.SurvivorEntry{}).
+		Where("user_id = ? AND season = ?", userID, season).
+		Updates(map[string]interface{}{
+			"alive":           alive,
+			"eliminated_week": eliminatedWeek,
+			"weeks_survived":  weeksSurvived,
+		}).Error; err != nil {
+		return err
+	}
+
+	payload.Alive = alive
+	payload.EliminatedWeek = eliminatedWeek
+	payload.WeeksSurvived = weeksSurvived
+	return events.Publish(tx, serviceName, events.TypeSurvivorEntryUpdated, userID, payload)
+}
+
+// survivorPicks devuelve los picks de survivor de un usuario en una temporada, por semana
+func survivorPicks(db *gorm.DB, userID string, season int) ([]models.Prediction, error) {
+	var picks []models.Prediction
+	err := db.Where("user_id = ? AND season = ? AND type = ?", userID, season, models.PredictionTypeSurvivor).
+		Order("week ASC").
+		Find(&picks).Error
+	return picks, err
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"kickoff.com/pkg/events"
 	"kickoff.com/prediction/internal/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -63,6 +64,10 @@ func AutoMigrate() error {
 	log.Println("Running auto-migration for Prediction service...")
 	return DB.AutoMigrate(
 		&models.Prediction{},
+		&models.SurvivorEntry{},
+		&models.BracketPrediction{},
+		&models.BracketPick{},
+		&events.OutboxEvent{},
 	)
 }
 
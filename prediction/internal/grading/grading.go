@@ -0,0 +1,157 @@
+package grading
+
+import (
+	"fmt"
+
+	"kickoff.com/prediction/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// PointsPerCorrectPick son los puntos que otorga una predicción acertada
+const PointsPerCorrectPick = 1
+
+// IsSettled indica si el juego ya tiene un resultado definitivo para calificar
+func IsSettled(game *pb.Game) bool {
+	return game.Status == pb.GameStatus_GAME_STATUS_COMPLETED ||
+		game.Status == pb.GameStatus_GAME_STATUS_CANCELED
+}
+
+// Grade califica una predicción contra el resultado final del juego.
+// Los juegos cancelados y los empates anulan la predicción (void, 0 puntos).
+// Los picks ATS y over/under se califican contra la línea guardada al hacer el
+// pick, y un push (resultado igual a la línea) también los anula. En el
+// survivor un empate cuenta como derrota.
+func Grade(game *pb.Game, prediction models.Prediction) (models.PredictionStatus, int) {
+	if game.Status == pb.GameStatus_GAME_STATUS_CANCELED {
+		return models.PredictionStatusVoid, 0
+	}
+	if game.Status != pb.GameStatus_GAME_STATUS_COMPLETED {
+		return models.PredictionStatusPending, 0
+	}
+
+	if prediction.Type == models.PredictionTypeTotal {
+		return gradeTotal(game, prediction)
+	}
+
+	winner := WinnerTeamID(game)
+	if prediction.AgainstSpread {
+		winner = CoveringTeamID(game, prediction.Spread)
+	}
+	if winner == "" {
+		if prediction.IsSurvivorPick() {
+			return models.PredictionStatusIncorrect, 0
+		}
+		return models.PredictionStatusVoid, 0
+	}
+
+	if prediction.PredictedWinnerID == winner {
+		return models.PredictionStatusCorrect, PointsFor(prediction)
+	}
+	return models.PredictionStatusIncorrect, 0
+}
+
+func gradeTotal(game *pb.Game, prediction models.Prediction) (models.PredictionStatus, int) {
+	result := TotalResult(game, prediction.Total)
+	if result == "" {
+		return models.PredictionStatusVoid, 0
+	}
+	if prediction.TotalPick == result {
+		return models.PredictionStatusCorrect, PointsFor(prediction)
+	}
+	return models.PredictionStatusIncorrect, 0
+}
+
+// TotalResult devuelve "over" o "under" según los puntos combinados del juego
+// contra la línea, o "" si la igualan (push)
+func TotalResult(game *pb.Game, line float64) string {
+	combined := float64(game.HomeScore + game.AwayScore)
+	switch {
+	case combined > line:
+		return models.TotalPickOver
+	case combined < line:
+		return models.TotalPickUnder
+	default:
+		return ""
+	}
+}
+
+// PointsFor devuelve los puntos de una predicción acertada: su valor de
+// confianza en un pool de confianza, o PointsPerCorrectPick en una normal.
+// Los picks de survivor no suman puntos: solo deciden si el usuario sigue vivo.
+func PointsFor(prediction models.Prediction) int {
+	if prediction.IsSurvivorPick() {
+		return 0
+	}
+	if prediction.IsConfidencePick() {
+		return prediction.Confidence
+	}
+	return PointsPerCorrectPick
+}
+
+// SurvivorState resume los picks de survivor de una temporada: el usuario queda
+// eliminado en la semana de su primera derrota, y weeksSurvived cuenta las
+// victorias anteriores. Los picks anulados (juego cancelado) no eliminan.
+func SurvivorState(picks []models.Prediction) (alive bool, eliminatedWeek, weeksSurvived int) {
+	for _, pick := range picks {
+		if pick.Status == models.PredictionStatusIncorrect && (eliminatedWeek == 0 || pick.Week < eliminatedWeek) {
+			eliminatedWeek = pick.Week
+		}
+	}
+	for _, pick := range picks {
+		if pick.Status == models.PredictionStatusCorrect && (eliminatedWeek == 0 || pick.Week < eliminatedWeek) {
+			weeksSurvived++
+		}
+	}
+	return eliminatedWeek == 0, eliminatedWeek, weeksSurvived
+}
+
+// CheckConfidenceRanking verifica los valores de confianza de una semana con
+// games juegos: cada valor va de 1 a games y se usa una sola vez. El ranking
+// puede ser parcial a propósito: si el usuario no elige todos los juegos, los
+// valores que no usa se pierden, igual que en un pool de confianza en papel.
+func CheckConfidenceRanking(confidences []int, games int) error {
+	seen := make(map[int]bool, len(confidences))
+	for _, c := range confidences {
+		if c < 1 || c > games {
+			return fmt.Errorf("confidence %d is out of range 1..%d", c, games)
+		}
+		if seen[c] {
+			return fmt.Errorf("confidence %d is used more than once", c)
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// IsPush indica si un pick ATS quedó anulado porque el margen final igualó la línea
+func IsPush(game *pb.Game, prediction models.Prediction) bool {
+	return prediction.AgainstSpread &&
+		game.Status == pb.GameStatus_GAME_STATUS_COMPLETED &&
+		CoveringTeamID(game, prediction.Spread) == ""
+}
+
+// CoveringTeamID devuelve el equipo que cubrió la línea del local (homeSpread,
+// negativa si el local es favorito), o "" si hubo push
+func CoveringTeamID(game *pb.Game, homeSpread float64) string {
+	margin := float64(game.HomeScore-game.AwayScore) + homeSpread
+	switch {
+	case margin > 0:
+		return game.HomeTeamId
+	case margin < 0:
+		return game.AwayTeamId
+	default:
+		return ""
+	}
+}
+
+// WinnerTeamID devuelve el equipo ganador según el marcador final, o "" si hubo empate
+func WinnerTeamID(game *pb.Game) string {
+	switch {
+	case game.HomeScore > game.AwayScore:
+		return game.HomeTeamId
+	case game.AwayScore > game.HomeScore:
+		return game.AwayTeamId
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,271 @@
+package grading
+
+import (
+	"testing"
+
+	"kickoff.com/prediction/internal/models"
+	pb "kickoff.com/proto"
+)
+
+// finalGame arma un juego completado KC (local) vs BUF con el marcador dado
+func finalGame(home, away int32) *pb.Game {
+	return &pb.Game{
+		HomeTeamId: "KC",
+		AwayTeamId: "BUF",
+		HomeScore:  home,
+		AwayScore:  away,
+		Status:     pb.GameStatus_GAME_STATUS_COMPLETED,
+	}
+}
+
+func TestGrade(t *testing.T) {
+	canceled := finalGame(0, 0)
+	canceled.Status = pb.GameStatus_GAME_STATUS_CANCELED
+	live := finalGame(14, 7)
+	live.Status = pb.GameStatus_GAME_STATUS_IN_PROGRESS
+
+	tests := []struct {
+		name       string
+		game       *pb.Game
+		prediction models.Prediction
+		wantStatus models.PredictionStatus
+		wantPoints int
+	}{
+		{"acierto del local", finalGame(27, 20), models.Prediction{PredictedWinnerID: "KC"}, models.PredictionStatusCorrect, PointsPerCorrectPick},
+		{"acierto del visitante", finalGame(17, 24), models.Prediction{PredictedWinnerID: "BUF"}, models.PredictionStatusCorrect, PointsPerCorrectPick},
+		{"error", finalGame(17, 24), models.Prediction{PredictedWinnerID: "KC"}, models.PredictionStatusIncorrect, 0},
+		{"empate anula", finalGame(20, 20), models.Prediction{PredictedWinnerID: "KC"}, models.PredictionStatusVoid, 0},
+		{"cancelado anula", canceled, models.Prediction{PredictedWinnerID: "KC"}, models.PredictionStatusVoid, 0},
+		{"en juego sigue pendiente", live, models.Prediction{PredictedWinnerID: "KC"}, models.PredictionStatusPending, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, points := Grade(tt.game, tt.prediction)
+			if status != tt.wantStatus || points != tt.wantPoints {
+				t.Errorf("Grade() = (%s, %d), want (%s, %d)", status, points, tt.wantStatus, tt.wantPoints)
+			}
+		})
+	}
+}
+
+func TestIsSettled(t *testing.T) {
+	tests := []struct {
+		status pb.GameStatus
+		want   bool
+	}{
+		{pb.GameStatus_GAME_STATUS_SCHEDULED, false},
+		{pb.GameStatus_GAME_STATUS_IN_PROGRESS, false},
+		{pb.GameStatus_GAME_STATUS_COMPLETED, true},
+		{pb.GameStatus_GAME_STATUS_POSTPONED, false},
+		{pb.GameStatus_GAME_STATUS_CANCELED, true},
+	}
+	for _, tt := range tests {
+		if got := IsSettled(&pb.Game{Status: tt.status}); got != tt.want {
+			t.Errorf("IsSettled(%s) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestPointsFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		prediction models.Prediction
+		want       int
+	}{
+		{"pick normal", models.Prediction{}, PointsPerCorrectPick},
+		{"pick de confianza", models.Prediction{Confidence: 12}, 12},
+		{"confianza 1", models.Prediction{Confidence: 1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PointsFor(tt.prediction); got != tt.want {
+				t.Errorf("PointsFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGradeConfidence(t *testing.T) {
+	status, points := Grade(finalGame(30, 10), models.Prediction{PredictedWinnerID: "KC", Confidence: 9})
+	if status != models.PredictionStatusCorrect || points != 9 {
+		t.Errorf("Grade() = (%s, %d), want (correct, 9)", status, points)
+	}
+	status, points = Grade(finalGame(10, 30), models.Prediction{PredictedWinnerID: "KC", Confidence: 9})
+	if status != models.PredictionStatusIncorrect || points != 0 {
+		t.Errorf("Grade() = (%s, %d), want (incorrect, 0)", status, points)
+	}
+}
+
+func TestCheckConfidenceRanking(t *testing.T) {
+	tests := []struct {
+		name        string
+		confidences []int
+		games       int
+		wantErr     bool
+	}{
+		{"vacío", nil, 4, false},
+		{"uno", []int{1}, 1, false},
+		{"completo desordenado", []int{3, 1, 4, 2}, 4, false},
+		{"parcial", []int{16, 2, 9}, 16, false},
+		{"repetido", []int{1, 2, 2}, 4, true},
+		{"cero", []int{0, 1, 2}, 4, true},
+		{"mayor que los juegos de la semana", []int{1, 2, 5}, 4, true},
+		{"negativo", []int{-1, 1}, 4, true},
+		{"semana sin juegos", []int{1}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckConfidenceRanking(tt.confidences, tt.games)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckConfidenceRanking(%v, %d) error = %v, wantErr %v", tt.confidences, tt.games, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCoveringTeamID(t *testing.T) {
+	tests := []struct {
+		name       string
+		home, away int32
+		homeSpread float64
+		want       string
+	}{
+		{"favorito local cubre", 27, 20, -3.5, "KC"},
+		{"favorito local no cubre", 23, 20, -3.5, "BUF"},
+		{"underdog local pierde por menos", 20, 23, 3.5, "KC"},
+		{"underdog local pierde por más", 17, 24, 3.5, "BUF"},
+		{"push", 24, 21, -3, ""},
+		{"pick'em", 21, 20, 0, "KC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CoveringTeamID(finalGame(tt.home, tt.away), tt.homeSpread); got != tt.want {
+				t.Errorf("CoveringTeamID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGradeAgainstSpread(t *testing.T) {
+	tests := []struct {
+		name       string
+		game       *pb.Game
+		prediction models.Prediction
+		wantStatus models.PredictionStatus
+		wantPush   bool
+	}{
+		{"cubre", finalGame(27, 20), models.Prediction{PredictedWinnerID: "KC", AgainstSpread: true, Spread: -3.5}, models.PredictionStatusCorrect, false},
+		{"gana sin cubrir", finalGame(23, 20), models.Prediction{PredictedWinnerID: "KC", AgainstSpread: true, Spread: -3.5}, models.PredictionStatusIncorrect, false},
+		{"pierde y cubre", finalGame(20, 23), models.Prediction{PredictedWinnerID: "KC", AgainstSpread: true, Spread: 3.5}, models.PredictionStatusCorrect, false},
+		{"push anula", finalGame(24, 21), models.Prediction{PredictedWinnerID: "KC", AgainstSpread: true, Spread: -3}, models.PredictionStatusVoid, true},
+		{"sin ATS ignora la línea", finalGame(23, 20), models.Prediction{PredictedWinnerID: "KC", Spread: -3.5}, models.PredictionStatusCorrect, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status, _ := Grade(tt.game, tt.prediction); status != tt.wantStatus {
+				t.Errorf("Grade() status = %s, want %s", status, tt.wantStatus)
+			}
+			if push := IsPush(tt.game, tt.prediction); push != tt.wantPush {
+				t.Errorf("IsPush() = %v, want %v", push, tt.wantPush)
+			}
+		})
+	}
+}
+
+func TestGradeTotal(t *testing.T) {
+	total := func(pick string, line float64) models.Prediction {
+		return models.Prediction{Type: models.PredictionTypeTotal, TotalPick: pick, Total: line}
+	}
+	tests := []struct {
+		name       string
+		game       *pb.Game
+		prediction models.Prediction
+		wantStatus models.PredictionStatus
+		wantPoints int
+	}{
+		{"over acertado", finalGame(28, 24), total(models.TotalPickOver, 47.5), models.PredictionStatusCorrect, PointsPerCorrectPick},
+		{"over errado", finalGame(17, 13), total(models.TotalPickOver, 47.5), models.PredictionStatusIncorrect, 0},
+		{"under acertado", finalGame(17, 13), total(models.TotalPickUnder, 47.5), models.PredictionStatusCorrect, PointsPerCorrectPick},
+		{"push anula", finalGame(24, 23), total(models.TotalPickUnder, 47), models.PredictionStatusVoid, 0},
+		{"empate no anula un total", finalGame(24, 24), total(models.TotalPickOver, 44.5), models.PredictionStatusCorrect, PointsPerCorrectPick},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, points := Grade(tt.game, tt.prediction)
+			if status != tt.wantStatus || points != tt.wantPoints {
+				t.Errorf("Grade() = (%s, %d), want (%s, %d)", status, points, tt.wantStatus, tt.wantPoints)
+			}
+		})
+	}
+}
+
+func TestTotalResult(t *testing.T) {
+	tests := []struct {
+		home, away int32
+		line       float64
+		want       string
+	}{
+		{30, 20, 44.5, models.TotalPickOver},
+		{10, 20, 44.5, models.TotalPickUnder},
+		{24, 20, 44, ""},
+	}
+	for _, tt := range tests {
+		if got := TotalResult(finalGame(tt.home, tt.away), tt.line); got != tt.want {
+			t.Errorf("TotalResult(%d-%d, %.1f) = %q, want %q", tt.home, tt.away, tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestSurvivorState(t *testing.T) {
+	pick := func(week int, status models.PredictionStatus) models.Prediction {
+		return models.Prediction{Type: models.PredictionTypeSurvivor, Week: week, Status: status}
+	}
+	tests := []struct {
+		name              string
+		picks             []models.Prediction
+		wantAlive         bool
+		wantEliminated    int
+		wantWeeksSurvived int
+	}{
+		{"sin picks", nil, true, 0, 0},
+		{"todas ganadas", []models.Prediction{pick(1, models.PredictionStatusCorrect), pick(2, models.PredictionStatusCorrect)}, true, 0, 2},
+		{"pendiente no cuenta", []models.Prediction{pick(1, models.PredictionStatusCorrect), pick(2, models.PredictionStatusPending)}, true, 0, 1},
+		{"anulado no elimina", []models.Prediction{pick(1, models.PredictionStatusVoid), pick(2, models.PredictionStatusCorrect)}, true, 0, 1},
+		{"eliminado en la semana 3", []models.Prediction{
+			pick(1, models.PredictionStatusCorrect), pick(2, models.PredictionStatusCorrect),
+			pick(3, models.PredictionStatusIncorrect), pick(4, models.PredictionStatusCorrect),
+		}, false, 3, 2},
+		{"la primera derrota manda aunque llegue desordenada", []models.Prediction{
+			pick(5, models.PredictionStatusIncorrect), pick(1, models.PredictionStatusCorrect), pick(2, models.PredictionStatusIncorrect),
+		}, false, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alive, eliminated, survived := SurvivorState(tt.picks)
+			if alive != tt.wantAlive || eliminated != tt.wantEliminated || survived != tt.wantWeeksSurvived {
+				t.Errorf("SurvivorState() = (%v, %d, %d), want (%v, %d, %d)",
+					alive, eliminated, survived, tt.wantAlive, tt.wantEliminated, tt.wantWeeksSurvived)
+			}
+		})
+	}
+}
+
+func TestGradeSurvivor(t *testing.T) {
+	tests := []struct {
+		name       string
+		game       *pb.Game
+		wantStatus models.PredictionStatus
+	}{
+		{"gana", finalGame(24, 17), models.PredictionStatusCorrect},
+		{"pierde", finalGame(17, 24), models.PredictionStatusIncorrect},
+		{"empate cuenta como derrota", finalGame(20, 20), models.PredictionStatusIncorrect},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, points := Grade(tt.game, models.Prediction{Type: models.PredictionTypeSurvivor, PredictedWinnerID: "KC"})
+			if status != tt.wantStatus || points != 0 {
+				t.Errorf("Grade() = (%s, %d), want (%s, 0)", status, points, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -14,12 +14,36 @@ const (
 	PredictionStatusVoid      PredictionStatus = "void"
 )
 
+// PredictionType distingue los mercados de un juego: un usuario puede tener
+// una predicción de cada tipo por juego
+type PredictionType string
+
+const (
+	PredictionTypeWinner   PredictionType = "winner"
+	PredictionTypeTotal    PredictionType = "total"
+	PredictionTypeSurvivor PredictionType = "survivor" // Un equipo por semana, sin repetir en la temporada
+)
+
+// Elecciones de una predicción de tipo total
+const (
+	TotalPickOver  = "over"
+	TotalPickUnder = "under"
+)
+
 // Prediction representa una predicción de un usuario sobre un juego
 type Prediction struct {
 	ID                string           `gorm:"primaryKey;type:varchar(50)" json:"id"`
-	UserID            string           `gorm:"not null;type:varchar(50);index" json:"userId"`
+	UserID            string           `gorm:"not null;type:varchar(50);index;uniqueIndex:idx_predictions_confidence" json:"userId"`
 	GameID            string           `gorm:"not null;type:varchar(50);index" json:"gameId"`
-	PredictedWinnerID string           `gorm:"not null;type:varchar(10)" json:"predictedWinnerId"`
+	Type              PredictionType   `gorm:"type:varchar(20);not null;default:'winner'" json:"type"`
+	PredictedWinnerID string           `gorm:"not null;type:varchar(10)" json:"predictedWinnerId"` // Vacío en las de tipo total
+	TotalPick         string           `gorm:"type:varchar(10)" json:"totalPick,omitempty"`        // "over" o "under"
+	Total             float64          `gorm:"default:0" json:"total"`                             // Línea over/under al hacer el pick
+	Season            int              `gorm:"default:0;index:idx_predictions_period;uniqueIndex:idx_predictions_confidence" json:"season"`
+	Week              int              `gorm:"default:0;index:idx_predictions_period;uniqueIndex:idx_predictions_confidence" json:"week"`
+	Confidence        int              `gorm:"default:0;uniqueIndex:idx_predictions_confidence,where:confidence > 0 AND deleted_at IS NULL" json:"confidence"`
+	AgainstSpread     bool             `gorm:"default:false" json:"againstSpread"`
+	Spread            float64          `gorm:"default:0" json:"spread"` // Línea del local al hacer un pick ATS
 	Status            PredictionStatus `gorm:"type:varchar(20);default:'pending'" json:"status"`
 	Points            int              `gorm:"default:0" json:"points"`
 	CreatedAt         time.Time        `gorm:"autoCreateTime" json:"createdAt"`
@@ -27,7 +51,71 @@ type Prediction struct {
 	DeletedAt         gorm.DeletedAt   `gorm:"index" json:"-"`
 }
 
+// IsSurvivorPick indica si la predicción es el pick semanal de un survivor pool
+func (p Prediction) IsSurvivorPick() bool {
+	return p.Type == PredictionTypeSurvivor
+}
+
+// IsConfidencePick indica si la predicción es parte de un ranking de confianza
+func (p Prediction) IsConfidencePick() bool {
+	return p.Confidence > 0
+}
+
 // TableName especifica el nombre de la tabla
 func (Prediction) TableName() string {
 	return "predictions"
 }
+
+// SurvivorEntry es la participación de un usuario en el survivor pool de una
+// temporada. Se crea con su primer pick y se recalcula a partir de sus picks
+// cada vez que uno se califica: la primera derrota lo elimina en esa semana.
+// Su fila también sirve de lock para validar los picks del usuario.
+type SurvivorEntry struct {
+	UserID         string    `gorm:"primaryKey;type:varchar(50)" json:"userId"`
+	Season         int       `gorm:"primaryKey" json:"season"`
+	Alive          bool      `gorm:"not null;default:true" json:"alive"`
+	EliminatedWeek int       `gorm:"default:0" json:"eliminatedWeek"` // 0 mientras sigue vivo
+	WeeksSurvived  int       `gorm:"default:0" json:"weeksSurvived"`
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (SurvivorEntry) TableName() string {
+	return "survivor_entries"
+}
+
+// BracketPrediction es el bracket de playoffs de un usuario en una temporada.
+// Se puede reemplazar hasta el primer juego del Wild Card; los totales se
+// recalculan a partir de sus picks cada vez que termina un juego de playoffs.
+type BracketPrediction struct {
+	ID        string        `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	UserID    string        `gorm:"not null;type:varchar(50);uniqueIndex:idx_brackets_user_season" json:"userId"`
+	Season    int           `gorm:"not null;uniqueIndex:idx_brackets_user_season" json:"season"`
+	Points    int           `gorm:"default:0" json:"points"`
+	Correct   int           `gorm:"default:0" json:"correct"`
+	Incorrect int           `gorm:"default:0" json:"incorrect"`
+	MaxPoints int           `gorm:"default:0" json:"maxPoints"` // Puntos más los picks pendientes
+	Picks     []BracketPick `gorm:"foreignKey:BracketID;constraint:OnDelete:CASCADE" json:"picks"`
+	CreatedAt time.Time     `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt time.Time     `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (BracketPrediction) TableName() string {
+	return "bracket_predictions"
+}
+
+// BracketPick es un equipo elegido para ganar un juego de una ronda de playoffs
+type BracketPick struct {
+	BracketID string           `gorm:"primaryKey;type:varchar(50)" json:"bracketId"`
+	Round     string           `gorm:"primaryKey;type:varchar(20)" json:"round"` // wild_card, divisional, conference, super_bowl
+	TeamID    string           `gorm:"primaryKey;type:varchar(10)" json:"teamId"`
+	Status    PredictionStatus `gorm:"type:varchar(20);default:'pending'" json:"status"`
+	Points    int              `gorm:"default:0" json:"points"`
+}
+
+// TableName especifica el nombre de la tabla
+func (BracketPick) TableName() string {
+	return "bracket_picks"
+}
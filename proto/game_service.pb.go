@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -196,6 +197,276 @@ func (GameStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_game_service_proto_rawDescGZIP(), []int{2}
 }
 
+// What happens to a game's pending predictions when it is rescheduled
+type ReschedulePredictionPolicy int32
+
+const (
+	ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED ReschedulePredictionPolicy = 0 // Use the service default (RESCHEDULE_PREDICTION_POLICY)
+	ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_KEEP        ReschedulePredictionPolicy = 1 // Keep them; confidence and survivor picks are voided if the week changes
+	ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_VOID        ReschedulePredictionPolicy = 2 // Void them so users can pick again
+)
+
+// Enum value maps for ReschedulePredictionPolicy.
+var (
+	ReschedulePredictionPolicy_name = map[int32]string{
+		0: "RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED",
+		1: "RESCHEDULE_PREDICTION_POLICY_KEEP",
+		2: "RESCHEDULE_PREDICTION_POLICY_VOID",
+	}
+	ReschedulePredictionPolicy_value = map[string]int32{
+		"RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED": 0,
+		"RESCHEDULE_PREDICTION_POLICY_KEEP":        1,
+		"RESCHEDULE_PREDICTION_POLICY_VOID":        2,
+	}
+)
+
+func (x ReschedulePredictionPolicy) Enum() *ReschedulePredictionPolicy {
+	p := new(ReschedulePredictionPolicy)
+	*p = x
+	return p
+}
+
+func (x ReschedulePredictionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReschedulePredictionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ReschedulePredictionPolicy) Type() protoreflect.EnumType {
+	return &file_proto_game_service_proto_enumTypes[3]
+}
+
+func (x ReschedulePredictionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReschedulePredictionPolicy.Descriptor instead.
+func (ReschedulePredictionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ScheduleFormat int32
+
+const (
+	ScheduleFormat_SCHEDULE_FORMAT_UNSPECIFIED ScheduleFormat = 0 // Detected from the content
+	ScheduleFormat_SCHEDULE_FORMAT_CSV         ScheduleFormat = 1
+	ScheduleFormat_SCHEDULE_FORMAT_JSON        ScheduleFormat = 2
+	ScheduleFormat_SCHEDULE_FORMAT_ICS         ScheduleFormat = 3 // iCalendar feed
+)
+
+// Enum value maps for ScheduleFormat.
+var (
+	ScheduleFormat_name = map[int32]string{
+		0: "SCHEDULE_FORMAT_UNSPECIFIED",
+		1: "SCHEDULE_FORMAT_CSV",
+		2: "SCHEDULE_FORMAT_JSON",
+		3: "SCHEDULE_FORMAT_ICS",
+	}
+	ScheduleFormat_value = map[string]int32{
+		"SCHEDULE_FORMAT_UNSPECIFIED": 0,
+		"SCHEDULE_FORMAT_CSV":         1,
+		"SCHEDULE_FORMAT_JSON":        2,
+		"SCHEDULE_FORMAT_ICS":         3,
+	}
+)
+
+func (x ScheduleFormat) Enum() *ScheduleFormat {
+	p := new(ScheduleFormat)
+	*p = x
+	return p
+}
+
+func (x ScheduleFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ScheduleFormat) Type() protoreflect.EnumType {
+	return &file_proto_game_service_proto_enumTypes[4]
+}
+
+func (x ScheduleFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleFormat.Descriptor instead.
+func (ScheduleFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{4}
+}
+
+type PlayoffRound int32
+
+const (
+	PlayoffRound_PLAYOFF_ROUND_UNSPECIFIED PlayoffRound = 0
+	PlayoffRound_PLAYOFF_ROUND_WILD_CARD   PlayoffRound = 1 // Week 19
+	PlayoffRound_PLAYOFF_ROUND_DIVISIONAL  PlayoffRound = 2 // Week 20
+	PlayoffRound_PLAYOFF_ROUND_CONFERENCE  PlayoffRound = 3 // Week 21
+	PlayoffRound_PLAYOFF_ROUND_SUPER_BOWL  PlayoffRound = 4 // Week 22
+)
+
+// Enum value maps for PlayoffRound.
+var (
+	PlayoffRound_name = map[int32]string{
+		0: "PLAYOFF_ROUND_UNSPECIFIED",
+		1: "PLAYOFF_ROUND_WILD_CARD",
+		2: "PLAYOFF_ROUND_DIVISIONAL",
+		3: "PLAYOFF_ROUND_CONFERENCE",
+		4: "PLAYOFF_ROUND_SUPER_BOWL",
+	}
+	PlayoffRound_value = map[string]int32{
+		"PLAYOFF_ROUND_UNSPECIFIED": 0,
+		"PLAYOFF_ROUND_WILD_CARD":   1,
+		"PLAYOFF_ROUND_DIVISIONAL":  2,
+		"PLAYOFF_ROUND_CONFERENCE":  3,
+		"PLAYOFF_ROUND_SUPER_BOWL":  4,
+	}
+)
+
+func (x PlayoffRound) Enum() *PlayoffRound {
+	p := new(PlayoffRound)
+	*p = x
+	return p
+}
+
+func (x PlayoffRound) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayoffRound) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PlayoffRound) Type() protoreflect.EnumType {
+	return &file_proto_game_service_proto_enumTypes[5]
+}
+
+func (x PlayoffRound) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayoffRound.Descriptor instead.
+func (PlayoffRound) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{5}
+}
+
+type ScoringPlayType int32
+
+const (
+	ScoringPlayType_SCORING_PLAY_TYPE_UNSPECIFIED ScoringPlayType = 0
+	ScoringPlayType_SCORING_PLAY_TYPE_TOUCHDOWN   ScoringPlayType = 1 // 6 points
+	ScoringPlayType_SCORING_PLAY_TYPE_FIELD_GOAL  ScoringPlayType = 2 // 3 points
+	ScoringPlayType_SCORING_PLAY_TYPE_SAFETY      ScoringPlayType = 3 // 2 points
+	ScoringPlayType_SCORING_PLAY_TYPE_EXTRA_POINT ScoringPlayType = 4 // 1 point
+	ScoringPlayType_SCORING_PLAY_TYPE_TWO_POINT   ScoringPlayType = 5 // 2 points, also a defensive conversion
+	ScoringPlayType_SCORING_PLAY_TYPE_ADJUSTMENT  ScoringPlayType = 6 // Score set through UpdateGameScore
+)
+
+// Enum value maps for ScoringPlayType.
+var (
+	ScoringPlayType_name = map[int32]string{
+		0: "SCORING_PLAY_TYPE_UNSPECIFIED",
+		1: "SCORING_PLAY_TYPE_TOUCHDOWN",
+		2: "SCORING_PLAY_TYPE_FIELD_GOAL",
+		3: "SCORING_PLAY_TYPE_SAFETY",
+		4: "SCORING_PLAY_TYPE_EXTRA_POINT",
+		5: "SCORING_PLAY_TYPE_TWO_POINT",
+		6: "SCORING_PLAY_TYPE_ADJUSTMENT",
+	}
+	ScoringPlayType_value = map[string]int32{
+		"SCORING_PLAY_TYPE_UNSPECIFIED": 0,
+		"SCORING_PLAY_TYPE_TOUCHDOWN":   1,
+		"SCORING_PLAY_TYPE_FIELD_GOAL":  2,
+		"SCORING_PLAY_TYPE_SAFETY":      3,
+		"SCORING_PLAY_TYPE_EXTRA_POINT": 4,
+		"SCORING_PLAY_TYPE_TWO_POINT":   5,
+		"SCORING_PLAY_TYPE_ADJUSTMENT":  6,
+	}
+)
+
+func (x ScoringPlayType) Enum() *ScoringPlayType {
+	p := new(ScoringPlayType)
+	*p = x
+	return p
+}
+
+func (x ScoringPlayType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoringPlayType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ScoringPlayType) Type() protoreflect.EnumType {
+	return &file_proto_game_service_proto_enumTypes[6]
+}
+
+func (x ScoringPlayType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoringPlayType.Descriptor instead.
+func (ScoringPlayType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ScoringPlayAction int32
+
+const (
+	ScoringPlayAction_SCORING_PLAY_ACTION_UNSPECIFIED ScoringPlayAction = 0
+	ScoringPlayAction_SCORING_PLAY_ACTION_RECORD      ScoringPlayAction = 1
+	ScoringPlayAction_SCORING_PLAY_ACTION_CORRECT     ScoringPlayAction = 2 // Replaces amends_play_id
+	ScoringPlayAction_SCORING_PLAY_ACTION_VOID        ScoringPlayAction = 3 // Voids amends_play_id
+)
+
+// Enum value maps for ScoringPlayAction.
+var (
+	ScoringPlayAction_name = map[int32]string{
+		0: "SCORING_PLAY_ACTION_UNSPECIFIED",
+		1: "SCORING_PLAY_ACTION_RECORD",
+		2: "SCORING_PLAY_ACTION_CORRECT",
+		3: "SCORING_PLAY_ACTION_VOID",
+	}
+	ScoringPlayAction_value = map[string]int32{
+		"SCORING_PLAY_ACTION_UNSPECIFIED": 0,
+		"SCORING_PLAY_ACTION_RECORD":      1,
+		"SCORING_PLAY_ACTION_CORRECT":     2,
+		"SCORING_PLAY_ACTION_VOID":        3,
+	}
+)
+
+func (x ScoringPlayAction) Enum() *ScoringPlayAction {
+	p := new(ScoringPlayAction)
+	*p = x
+	return p
+}
+
+func (x ScoringPlayAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScoringPlayAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ScoringPlayAction) Type() protoreflect.EnumType {
+	return &file_proto_game_service_proto_enumTypes[7]
+}
+
+func (x ScoringPlayAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScoringPlayAction.Descriptor instead.
+func (ScoringPlayAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{7}
+}
+
 type Team struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -297,19 +568,27 @@ func (x *Team) GetStadium() string {
 }
 
 type Game struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	HomeTeamId    string                 `protobuf:"bytes,2,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
-	AwayTeamId    string                 `protobuf:"bytes,3,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
-	Week          int32                  `protobuf:"varint,4,opt,name=week,proto3" json:"week,omitempty"`
-	Status        GameStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
-	HomeScore     int32                  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
-	AwayScore     int32                  `protobuf:"varint,7,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
-	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
-	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HomeTeamId       string                 `protobuf:"bytes,2,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId       string                 `protobuf:"bytes,3,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	Week             int32                  `protobuf:"varint,4,opt,name=week,proto3" json:"week,omitempty"`
+	Status           GameStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	HomeScore        int32                  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore        int32                  `protobuf:"varint,7,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	ScheduledAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Season           int32                  `protobuf:"varint,11,opt,name=season,proto3" json:"season,omitempty"`
+	Spread           *float64               `protobuf:"fixed64,12,opt,name=spread,proto3,oneof" json:"spread,omitempty"`                        // Home team line: -3.5 = home favored by 3.5
+	LinesLockAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=lines_lock_at,json=linesLockAt,proto3" json:"lines_lock_at,omitempty"` // Lines can't change after this time
+	Total            *float64               `protobuf:"fixed64,14,opt,name=total,proto3,oneof" json:"total,omitempty"`                          // Over/under line on the combined score
+	Quarter          int32                  `protobuf:"varint,15,opt,name=quarter,proto3" json:"quarter,omitempty"`                             // 0 before kickoff, 5+ overtime
+	Clock            string                 `protobuf:"bytes,16,opt,name=clock,proto3" json:"clock,omitempty"`                                  // Time left in the quarter, "MM:SS"
+	PossessionTeamId string                 `protobuf:"bytes,17,opt,name=possession_team_id,json=possessionTeamId,proto3" json:"possession_team_id,omitempty"`
+	Version          int64                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"` // Bumped on every change; resume token for WatchGames
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Game) Reset() {
@@ -412,66 +691,100 @@ func (x *Game) GetCompletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// GetAllTeams
-type GetAllTeamsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Game) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
 }
 
-func (x *GetAllTeamsRequest) Reset() {
-	*x = GetAllTeamsRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Game) GetSpread() float64 {
+	if x != nil && x.Spread != nil {
+		return *x.Spread
+	}
+	return 0
 }
 
-func (x *GetAllTeamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Game) GetLinesLockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinesLockAt
+	}
+	return nil
 }
 
-func (*GetAllTeamsRequest) ProtoMessage() {}
+func (x *Game) GetTotal() float64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
 
-func (x *GetAllTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[2]
+func (x *Game) GetQuarter() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Quarter
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetAllTeamsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{2}
+func (x *Game) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
 }
 
-type GetAllTeamsResponse struct {
+func (x *Game) GetPossessionTeamId() string {
+	if x != nil {
+		return x.PossessionTeamId
+	}
+	return ""
+}
+
+func (x *Game) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Entry of the append-only scoring log. The game score is the sum of the
+// effective plays: corrections and voids are new entries that amend an
+// earlier one.
+type ScoringPlay struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Sequence      int32                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Action        ScoringPlayAction      `protobuf:"varint,4,opt,name=action,proto3,enum=proto.ScoringPlayAction" json:"action,omitempty"`
+	AmendsPlayId  string                 `protobuf:"bytes,5,opt,name=amends_play_id,json=amendsPlayId,proto3" json:"amends_play_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,6,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Type          ScoringPlayType        `protobuf:"varint,7,opt,name=type,proto3,enum=proto.ScoringPlayType" json:"type,omitempty"`
+	Points        int32                  `protobuf:"varint,8,opt,name=points,proto3" json:"points,omitempty"`
+	Quarter       int32                  `protobuf:"varint,9,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	Clock         string                 `protobuf:"bytes,10,opt,name=clock,proto3" json:"clock,omitempty"`
+	Description   string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	Reason        string                 `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`        // Why the amendment was made
+	Effective     bool                   `protobuf:"varint,13,opt,name=effective,proto3" json:"effective,omitempty"` // Counts towards the score
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllTeamsResponse) Reset() {
-	*x = GetAllTeamsResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[3]
+func (x *ScoringPlay) Reset() {
+	*x = ScoringPlay{}
+	mi := &file_proto_game_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllTeamsResponse) String() string {
+func (x *ScoringPlay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTeamsResponse) ProtoMessage() {}
+func (*ScoringPlay) ProtoMessage() {}
 
-func (x *GetAllTeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[3]
+func (x *ScoringPlay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,91 +795,302 @@ func (x *GetAllTeamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTeamsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllTeamsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ScoringPlay.ProtoReflect.Descriptor instead.
+func (*ScoringPlay) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetAllTeamsResponse) GetTeams() []*Team {
+func (x *ScoringPlay) GetId() string {
 	if x != nil {
-		return x.Teams
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllTeamsResponse) GetTotal() int32 {
+func (x *ScoringPlay) GetGameId() string {
 	if x != nil {
-		return x.Total
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ScoringPlay) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
 	}
 	return 0
 }
 
-// GetTeamByID
-type GetTeamByIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScoringPlay) GetAction() ScoringPlayAction {
+	if x != nil {
+		return x.Action
+	}
+	return ScoringPlayAction_SCORING_PLAY_ACTION_UNSPECIFIED
 }
 
-func (x *GetTeamByIDRequest) Reset() {
-	*x = GetTeamByIDRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScoringPlay) GetAmendsPlayId() string {
+	if x != nil {
+		return x.AmendsPlayId
+	}
+	return ""
 }
 
-func (x *GetTeamByIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScoringPlay) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
 }
 
-func (*GetTeamByIDRequest) ProtoMessage() {}
+func (x *ScoringPlay) GetType() ScoringPlayType {
+	if x != nil {
+		return x.Type
+	}
+	return ScoringPlayType_SCORING_PLAY_TYPE_UNSPECIFIED
+}
 
-func (x *GetTeamByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[4]
+func (x *ScoringPlay) GetPoints() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Points
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTeamByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{4}
+func (x *ScoringPlay) GetQuarter() int32 {
+	if x != nil {
+		return x.Quarter
+	}
+	return 0
 }
 
-func (x *GetTeamByIDRequest) GetTeamId() string {
+func (x *ScoringPlay) GetClock() string {
 	if x != nil {
-		return x.TeamId
+		return x.Clock
 	}
 	return ""
 }
 
-type GetTeamByIDResponse struct {
+func (x *ScoringPlay) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScoringPlay) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScoringPlay) GetEffective() bool {
+	if x != nil {
+		return x.Effective
+	}
+	return false
+}
+
+func (x *ScoringPlay) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Status history entry, one per transition
+type GameStatusChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	FromStatus    GameStatus             `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=proto.GameStatus" json:"from_status,omitempty"`
+	ToStatus      GameStatus             `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=proto.GameStatus" json:"to_status,omitempty"`
+	Override      bool                   `protobuf:"varint,5,opt,name=override,proto3" json:"override,omitempty"` // Made outside the allowed transitions
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamByIDResponse) Reset() {
-	*x = GetTeamByIDResponse{}
+func (x *GameStatusChange) Reset() {
+	*x = GameStatusChange{}
+	mi := &file_proto_game_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStatusChange) ProtoMessage() {}
+
+func (x *GameStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStatusChange.ProtoReflect.Descriptor instead.
+func (*GameStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameStatusChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameStatusChange) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameStatusChange) GetFromStatus() GameStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *GameStatusChange) GetToStatus() GameStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *GameStatusChange) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *GameStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GameStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// GetAllTeams
+type GetAllTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // "id" (default) or "name"; "-" prefix for descending
+	Conference    Conference             `protobuf:"varint,4,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	Division      Division               `protobuf:"varint,5,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTeamsRequest) Reset() {
+	*x = GetAllTeamsRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTeamsRequest) ProtoMessage() {}
+
+func (x *GetAllTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTeamsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAllTeamsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllTeamsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllTeamsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllTeamsRequest) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *GetAllTeamsRequest) GetDivision() Division {
+	if x != nil {
+		return x.Division
+	}
+	return Division_DIVISION_UNSPECIFIED
+}
+
+type GetAllTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // Teams matching the filters, across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTeamsResponse) Reset() {
+	*x = GetAllTeamsResponse{}
 	mi := &file_proto_game_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamByIDResponse) String() string {
+func (x *GetAllTeamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamByIDResponse) ProtoMessage() {}
+func (*GetAllTeamsResponse) ProtoMessage() {}
 
-func (x *GetTeamByIDResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAllTeamsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_game_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,40 +1102,54 @@ func (x *GetTeamByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetTeamByIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllTeamsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTeamsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_game_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetTeamByIDResponse) GetTeam() *Team {
+func (x *GetAllTeamsResponse) GetTeams() []*Team {
 	if x != nil {
-		return x.Team
+		return x.Teams
 	}
 	return nil
 }
 
-// GetTeamsByConference
-type GetTeamsByConferenceRequest struct {
+func (x *GetAllTeamsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetAllTeamsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetTeamByID
+type GetTeamByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conference    Conference             `protobuf:"varint,1,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamsByConferenceRequest) Reset() {
-	*x = GetTeamsByConferenceRequest{}
+func (x *GetTeamByIDRequest) Reset() {
+	*x = GetTeamByIDRequest{}
 	mi := &file_proto_game_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamsByConferenceRequest) String() string {
+func (x *GetTeamByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsByConferenceRequest) ProtoMessage() {}
+func (*GetTeamByIDRequest) ProtoMessage() {}
 
-func (x *GetTeamsByConferenceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTeamByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_game_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -623,41 +1161,39 @@ func (x *GetTeamsByConferenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsByConferenceRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamsByConferenceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTeamByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamByIDRequest) Descriptor() ([]byte, []int) {
 	return file_proto_game_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetTeamsByConferenceRequest) GetConference() Conference {
+func (x *GetTeamByIDRequest) GetTeamId() string {
 	if x != nil {
-		return x.Conference
+		return x.TeamId
 	}
-	return Conference_CONFERENCE_UNSPECIFIED
+	return ""
 }
 
-type GetTeamsByConferenceResponse struct {
+type GetTeamByIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Conference    Conference             `protobuf:"varint,3,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamsByConferenceResponse) Reset() {
-	*x = GetTeamsByConferenceResponse{}
+func (x *GetTeamByIDResponse) Reset() {
+	*x = GetTeamByIDResponse{}
 	mi := &file_proto_game_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamsByConferenceResponse) String() string {
+func (x *GetTeamByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsByConferenceResponse) ProtoMessage() {}
+func (*GetTeamByIDResponse) ProtoMessage() {}
 
-func (x *GetTeamsByConferenceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTeamByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_game_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -669,55 +1205,2685 @@ func (x *GetTeamsByConferenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsByConferenceResponse.ProtoReflect.Descriptor instead.
-func (*GetTeamsByConferenceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetTeamByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTeamByIDResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+// GetTeamsByConference
+type GetTeamsByConferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conference    Conference             `protobuf:"varint,1,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsByConferenceRequest) Reset() {
+	*x = GetTeamsByConferenceRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsByConferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsByConferenceRequest) ProtoMessage() {}
+
+func (x *GetTeamsByConferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsByConferenceRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamsByConferenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTeamsByConferenceRequest) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *GetTeamsByConferenceRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTeamsByConferenceRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetTeamsByConferenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Across all pages
+	Conference    Conference             `protobuf:"varint,3,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsByConferenceResponse) Reset() {
+	*x = GetTeamsByConferenceResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsByConferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsByConferenceResponse) ProtoMessage() {}
+
+func (x *GetTeamsByConferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsByConferenceResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamsByConferenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTeamsByConferenceResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GetTeamsByConferenceResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTeamsByConferenceResponse) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *GetTeamsByConferenceResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetTeamsByDivision
+type GetTeamsByDivisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Division      Division               `protobuf:"varint,1,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsByDivisionRequest) Reset() {
+	*x = GetTeamsByDivisionRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsByDivisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsByDivisionRequest) ProtoMessage() {}
+
+func (x *GetTeamsByDivisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsByDivisionRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamsByDivisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTeamsByDivisionRequest) GetDivision() Division {
+	if x != nil {
+		return x.Division
+	}
+	return Division_DIVISION_UNSPECIFIED
+}
+
+func (x *GetTeamsByDivisionRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTeamsByDivisionRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetTeamsByDivisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Across all pages
+	Division      Division               `protobuf:"varint,3,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamsByDivisionResponse) Reset() {
+	*x = GetTeamsByDivisionResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamsByDivisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamsByDivisionResponse) ProtoMessage() {}
+
+func (x *GetTeamsByDivisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamsByDivisionResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamsByDivisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeamsByDivisionResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GetTeamsByDivisionResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetTeamsByDivisionResponse) GetDivision() Division {
+	if x != nil {
+		return x.Division
+	}
+	return Division_DIVISION_UNSPECIFIED
+}
+
+func (x *GetTeamsByDivisionResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetAllGames
+type GetAllGamesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	OrderBy         string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // "scheduled_at" (default) or "week"; "-" prefix for descending
+	Season          int32                  `protobuf:"varint,4,opt,name=season,proto3" json:"season,omitempty"`
+	WeekFrom        int32                  `protobuf:"varint,5,opt,name=week_from,json=weekFrom,proto3" json:"week_from,omitempty"` // Inclusive
+	WeekTo          int32                  `protobuf:"varint,6,opt,name=week_to,json=weekTo,proto3" json:"week_to,omitempty"`       // Inclusive
+	TeamId          string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`        // Home or away
+	Status          GameStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	ScheduledAfter  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=scheduled_after,json=scheduledAfter,proto3" json:"scheduled_after,omitempty"`     // Inclusive
+	ScheduledBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scheduled_before,json=scheduledBefore,proto3" json:"scheduled_before,omitempty"` // Exclusive
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAllGamesRequest) Reset() {
+	*x = GetAllGamesRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllGamesRequest) ProtoMessage() {}
+
+func (x *GetAllGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllGamesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAllGamesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllGamesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllGamesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllGamesRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetAllGamesRequest) GetWeekFrom() int32 {
+	if x != nil {
+		return x.WeekFrom
+	}
+	return 0
+}
+
+func (x *GetAllGamesRequest) GetWeekTo() int32 {
+	if x != nil {
+		return x.WeekTo
+	}
+	return 0
+}
+
+func (x *GetAllGamesRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetAllGamesRequest) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *GetAllGamesRequest) GetScheduledAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAfter
+	}
+	return nil
+}
+
+func (x *GetAllGamesRequest) GetScheduledBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledBefore
+	}
+	return nil
+}
+
+type GetAllGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // Games matching the filters, across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllGamesResponse) Reset() {
+	*x = GetAllGamesResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllGamesResponse) ProtoMessage() {}
+
+func (x *GetAllGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllGamesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllGamesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAllGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetAllGamesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetAllGamesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetGameByID
+type GetGameByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameByIDRequest) Reset() {
+	*x = GetGameByIDRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameByIDRequest) ProtoMessage() {}
+
+func (x *GetGameByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetGameByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetGameByIDRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameByIDResponse) Reset() {
+	*x = GetGameByIDResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameByIDResponse) ProtoMessage() {}
+
+func (x *GetGameByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetGameByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetGameByIDResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+// GetGamesByWeek
+type GetGamesByWeekRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Week          int32                  `protobuf:"varint,1,opt,name=week,proto3" json:"week,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesByWeekRequest) Reset() {
+	*x = GetGamesByWeekRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesByWeekRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesByWeekRequest) ProtoMessage() {}
+
+func (x *GetGamesByWeekRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesByWeekRequest.ProtoReflect.Descriptor instead.
+func (*GetGamesByWeekRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGamesByWeekRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetGamesByWeekRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGamesByWeekRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetGamesByWeekResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Across all pages
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesByWeekResponse) Reset() {
+	*x = GetGamesByWeekResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesByWeekResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesByWeekResponse) ProtoMessage() {}
+
+func (x *GetGamesByWeekResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesByWeekResponse.ProtoReflect.Descriptor instead.
+func (*GetGamesByWeekResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGamesByWeekResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetGamesByWeekResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGamesByWeekResponse) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetGamesByWeekResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetGamesByTeam
+type GetGamesByTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesByTeamRequest) Reset() {
+	*x = GetGamesByTeamRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesByTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesByTeamRequest) ProtoMessage() {}
+
+func (x *GetGamesByTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesByTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetGamesByTeamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetGamesByTeamRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetGamesByTeamRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGamesByTeamRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetGamesByTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Across all pages
+	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesByTeamResponse) Reset() {
+	*x = GetGamesByTeamResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesByTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesByTeamResponse) ProtoMessage() {}
+
+func (x *GetGamesByTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesByTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetGamesByTeamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGamesByTeamResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetGamesByTeamResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGamesByTeamResponse) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *GetGamesByTeamResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetGamesByStatus
+type GetGamesByStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        GameStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesByStatusRequest) Reset() {
+	*x = GetGamesByStatusRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesByStatusRequest) ProtoMessage() {}
+
+func (x *GetGamesByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesByStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetGamesByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetGamesByStatusRequest) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *GetGamesByStatusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGamesByStatusRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetGamesByStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Across all pages
+	Status        GameStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesByStatusResponse) Reset() {
+	*x = GetGamesByStatusResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesByStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesByStatusResponse) ProtoMessage() {}
+
+func (x *GetGamesByStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesByStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetGamesByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetGamesByStatusResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetGamesByStatusResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetGamesByStatusResponse) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *GetGamesByStatusResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateGame (for admin/testing)
+type CreateGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HomeTeamId    string                 `protobuf:"bytes,1,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId    string                 `protobuf:"bytes,2,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Season        int32                  `protobuf:"varint,5,opt,name=season,proto3" json:"season,omitempty"` // 0 = season of scheduled_at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateGameRequest) GetHomeTeamId() string {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetAwayTeamId() string {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+type CreateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameResponse) Reset() {
+	*x = CreateGameResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameResponse) ProtoMessage() {}
+
+func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *CreateGameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateGameScore
+type UpdateGameScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	HomeScore     int32                  `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore     int32                  `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Override      bool                   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"` // Admin correction of a completed game
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`      // Required with override
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameScoreRequest) Reset() {
+	*x = UpdateGameScoreRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameScoreRequest) ProtoMessage() {}
+
+func (x *UpdateGameScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameScoreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameScoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateGameScoreRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *UpdateGameScoreRequest) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+func (x *UpdateGameScoreRequest) GetAwayScore() int32 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *UpdateGameScoreRequest) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *UpdateGameScoreRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateGameScoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameScoreResponse) Reset() {
+	*x = UpdateGameScoreResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameScoreResponse) ProtoMessage() {}
+
+func (x *UpdateGameScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameScoreResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameScoreResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateGameScoreResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *UpdateGameScoreResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateGameStatus
+type UpdateGameStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Status        GameStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	Override      bool                   `protobuf:"varint,3,opt,name=override,proto3" json:"override,omitempty"` // Admin override of the allowed transitions
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`      // Required with override, optional otherwise
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameStatusRequest) Reset() {
+	*x = UpdateGameStatusRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameStatusRequest) ProtoMessage() {}
+
+func (x *UpdateGameStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateGameStatusRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *UpdateGameStatusRequest) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateGameStatusRequest) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *UpdateGameStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateGameStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Change        *GameStatusChange      `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameStatusResponse) Reset() {
+	*x = UpdateGameStatusResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameStatusResponse) ProtoMessage() {}
+
+func (x *UpdateGameStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateGameStatusResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *UpdateGameStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateGameStatusResponse) GetChange() *GameStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// RescheduleGame
+type RescheduleGameRequest struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	GameId           string                     `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ScheduledAt      *timestamppb.Timestamp     `protobuf:"bytes,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // New kickoff time
+	Week             int32                      `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`                                 // 0 = keep the current week
+	PredictionPolicy ReschedulePredictionPolicy `protobuf:"varint,4,opt,name=prediction_policy,json=predictionPolicy,proto3,enum=proto.ReschedulePredictionPolicy" json:"prediction_policy,omitempty"`
+	Reason           string                     `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RescheduleGameRequest) Reset() {
+	*x = RescheduleGameRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleGameRequest) ProtoMessage() {}
+
+func (x *RescheduleGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleGameRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RescheduleGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RescheduleGameRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *RescheduleGameRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *RescheduleGameRequest) GetPredictionPolicy() ReschedulePredictionPolicy {
+	if x != nil {
+		return x.PredictionPolicy
+	}
+	return ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED
+}
+
+func (x *RescheduleGameRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RescheduleGameResponse struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Game             *Game                      `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Message          string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PredictionPolicy ReschedulePredictionPolicy `protobuf:"varint,3,opt,name=prediction_policy,json=predictionPolicy,proto3,enum=proto.ReschedulePredictionPolicy" json:"prediction_policy,omitempty"` // Policy applied
+	Change           *GameStatusChange          `protobuf:"bytes,4,opt,name=change,proto3" json:"change,omitempty"`                                                                                    // Set when a postponed game goes back to scheduled
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RescheduleGameResponse) Reset() {
+	*x = RescheduleGameResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleGameResponse) ProtoMessage() {}
+
+func (x *RescheduleGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleGameResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleGameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RescheduleGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *RescheduleGameResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RescheduleGameResponse) GetPredictionPolicy() ReschedulePredictionPolicy {
+	if x != nil {
+		return x.PredictionPolicy
+	}
+	return ReschedulePredictionPolicy_RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED
+}
+
+func (x *RescheduleGameResponse) GetChange() *GameStatusChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// GetGameStatusHistory
+type GetGameStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatusHistoryRequest) Reset() {
+	*x = GetGameStatusHistoryRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetGameStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetGameStatusHistoryRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameStatusHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Changes       []*GameStatusChange    `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameStatusHistoryResponse) Reset() {
+	*x = GetGameStatusHistoryResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetGameStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGameStatusHistoryResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GetGameStatusHistoryResponse) GetChanges() []*GameStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// UpdateGameLines
+type UpdateGameLinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Spread        *float64               `protobuf:"fixed64,2,opt,name=spread,proto3,oneof" json:"spread,omitempty"`                        // Unset = keep the current spread
+	LinesLockAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lines_lock_at,json=linesLockAt,proto3" json:"lines_lock_at,omitempty"` // Unset = keep the current lock time (default: kickoff)
+	Total         *float64               `protobuf:"fixed64,4,opt,name=total,proto3,oneof" json:"total,omitempty"`                          // Unset = keep the current total
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameLinesRequest) Reset() {
+	*x = UpdateGameLinesRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameLinesRequest) ProtoMessage() {}
+
+func (x *UpdateGameLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameLinesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameLinesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateGameLinesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *UpdateGameLinesRequest) GetSpread() float64 {
+	if x != nil && x.Spread != nil {
+		return *x.Spread
+	}
+	return 0
+}
+
+func (x *UpdateGameLinesRequest) GetLinesLockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinesLockAt
+	}
+	return nil
+}
+
+func (x *UpdateGameLinesRequest) GetTotal() float64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+type UpdateGameLinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameLinesResponse) Reset() {
+	*x = UpdateGameLinesResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameLinesResponse) ProtoMessage() {}
+
+func (x *UpdateGameLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameLinesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameLinesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateGameLinesResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *UpdateGameLinesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportSchedule: bulk upsert of a season schedule. Games are matched by
+// external_id when the source has one, otherwise by season + week + teams.
+type ImportScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ScheduleFormat         `protobuf:"varint,1,opt,name=format,proto3,enum=proto.ScheduleFormat" json:"format,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Season        int32                  `protobuf:"varint,3,opt,name=season,proto3" json:"season,omitempty"`               // Default for rows without a season; 0 = season of each kickoff
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only report the changes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportScheduleRequest) Reset() {
+	*x = ImportScheduleRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportScheduleRequest) ProtoMessage() {}
+
+func (x *ImportScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ImportScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportScheduleRequest) GetFormat() ScheduleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ScheduleFormat_SCHEDULE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportScheduleRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportScheduleRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *ImportScheduleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ScheduleFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleFieldChange) Reset() {
+	*x = ScheduleFieldChange{}
+	mi := &file_proto_game_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleFieldChange) ProtoMessage() {}
+
+func (x *ScheduleFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleFieldChange.ProtoReflect.Descriptor instead.
+func (*ScheduleFieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ScheduleFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ScheduleFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ScheduleFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type ScheduleChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`               // "create", "update", "unchanged"
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // Empty for creates in a dry run
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Season        int32                  `protobuf:"varint,4,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,5,opt,name=week,proto3" json:"week,omitempty"`
+	HomeTeamId    string                 `protobuf:"bytes,6,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId    string                 `protobuf:"bytes,7,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Changes       []*ScheduleFieldChange `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"` // Only for updates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleChange) Reset() {
+	*x = ScheduleChange{}
+	mi := &file_proto_game_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleChange) ProtoMessage() {}
+
+func (x *ScheduleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleChange.ProtoReflect.Descriptor instead.
+func (*ScheduleChange) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScheduleChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *ScheduleChange) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *ScheduleChange) GetHomeTeamId() string {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetAwayTeamId() string {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return ""
+}
+
+func (x *ScheduleChange) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduleChange) GetChanges() []*ScheduleFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ScheduleIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // Line (CSV/ICS) or 1-based entry (JSON); 0 = not tied to an entry
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleIssue) Reset() {
+	*x = ScheduleIssue{}
+	mi := &file_proto_game_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleIssue) ProtoMessage() {}
+
+func (x *ScheduleIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleIssue.ProtoReflect.Descriptor instead.
+func (*ScheduleIssue) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ScheduleIssue) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ScheduleIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"` // False for dry runs and when there are issues
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Changes       []*ScheduleChange      `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	Issues        []*ScheduleIssue       `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"` // Any issue rejects the whole import
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportScheduleResponse) Reset() {
+	*x = ImportScheduleResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportScheduleResponse) ProtoMessage() {}
+
+func (x *ImportScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ImportScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportScheduleResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ImportScheduleResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportScheduleResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportScheduleResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportScheduleResponse) GetChanges() []*ScheduleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ImportScheduleResponse) GetIssues() []*ScheduleIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ImportScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetStandings: division and conference standings computed from completed
+// regular-season games, with the NFL tiebreakers applied.
+type GetStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,2,opt,name=week,proto3" json:"week,omitempty"`                                   // Standings as of this week; 0 = all games played
+	Conference    Conference             `protobuf:"varint,3,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"` // Optional filter
+	Division      Division               `protobuf:"varint,4,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`       // Optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStandingsRequest) Reset() {
+	*x = GetStandingsRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsRequest) ProtoMessage() {}
+
+func (x *GetStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetStandingsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetStandingsRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetStandingsRequest) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *GetStandingsRequest) GetDivision() Division {
+	if x != nil {
+		return x.Division
+	}
+	return Division_DIVISION_UNSPECIFIED
+}
+
+type Record struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wins          int32                  `protobuf:"varint,1,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int32                  `protobuf:"varint,2,opt,name=losses,proto3" json:"losses,omitempty"`
+	Ties          int32                  `protobuf:"varint,3,opt,name=ties,proto3" json:"ties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Record) Reset() {
+	*x = Record{}
+	mi := &file_proto_game_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Record) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Record) ProtoMessage() {}
+
+func (x *Record) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Record) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *Record) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *Record) GetTies() int32 {
+	if x != nil {
+		return x.Ties
+	}
+	return 0
+}
+
+type TeamStanding struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TeamId               string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Conference           Conference             `protobuf:"varint,2,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	Division             Division               `protobuf:"varint,3,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`
+	Wins                 int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses               int32                  `protobuf:"varint,5,opt,name=losses,proto3" json:"losses,omitempty"`
+	Ties                 int32                  `protobuf:"varint,6,opt,name=ties,proto3" json:"ties,omitempty"`
+	WinPercentage        float64                `protobuf:"fixed64,7,opt,name=win_percentage,json=winPercentage,proto3" json:"win_percentage,omitempty"` // Ties count as half a win
+	DivisionRecord       *Record                `protobuf:"bytes,8,opt,name=division_record,json=divisionRecord,proto3" json:"division_record,omitempty"`
+	ConferenceRecord     *Record                `protobuf:"bytes,9,opt,name=conference_record,json=conferenceRecord,proto3" json:"conference_record,omitempty"`
+	HomeRecord           *Record                `protobuf:"bytes,10,opt,name=home_record,json=homeRecord,proto3" json:"home_record,omitempty"`
+	AwayRecord           *Record                `protobuf:"bytes,11,opt,name=away_record,json=awayRecord,proto3" json:"away_record,omitempty"`
+	PointsFor            int32                  `protobuf:"varint,12,opt,name=points_for,json=pointsFor,proto3" json:"points_for,omitempty"`
+	PointsAgainst        int32                  `protobuf:"varint,13,opt,name=points_against,json=pointsAgainst,proto3" json:"points_against,omitempty"`
+	PointDifferential    int32                  `protobuf:"varint,14,opt,name=point_differential,json=pointDifferential,proto3" json:"point_differential,omitempty"`
+	Streak               string                 `protobuf:"bytes,15,opt,name=streak,proto3" json:"streak,omitempty"` // "W3", "L1", "T1"; empty before the first game
+	StrengthOfVictory    float64                `protobuf:"fixed64,16,opt,name=strength_of_victory,json=strengthOfVictory,proto3" json:"strength_of_victory,omitempty"`
+	StrengthOfSchedule   float64                `protobuf:"fixed64,17,opt,name=strength_of_schedule,json=strengthOfSchedule,proto3" json:"strength_of_schedule,omitempty"`
+	DivisionRank         int32                  `protobuf:"varint,18,opt,name=division_rank,json=divisionRank,proto3" json:"division_rank,omitempty"`
+	ConferenceRank       int32                  `protobuf:"varint,19,opt,name=conference_rank,json=conferenceRank,proto3" json:"conference_rank,omitempty"`            // Seed: 1-4 division winners, 5+ the rest
+	DivisionTiebreaker   string                 `protobuf:"bytes,20,opt,name=division_tiebreaker,json=divisionTiebreaker,proto3" json:"division_tiebreaker,omitempty"` // Rule that broke a tie on win percentage, if any
+	ConferenceTiebreaker string                 `protobuf:"bytes,21,opt,name=conference_tiebreaker,json=conferenceTiebreaker,proto3" json:"conference_tiebreaker,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TeamStanding) Reset() {
+	*x = TeamStanding{}
+	mi := &file_proto_game_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStanding) ProtoMessage() {}
+
+func (x *TeamStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStanding.ProtoReflect.Descriptor instead.
+func (*TeamStanding) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TeamStanding) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TeamStanding) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *TeamStanding) GetDivision() Division {
+	if x != nil {
+		return x.Division
+	}
+	return Division_DIVISION_UNSPECIFIED
+}
+
+func (x *TeamStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetTies() int32 {
+	if x != nil {
+		return x.Ties
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetWinPercentage() float64 {
+	if x != nil {
+		return x.WinPercentage
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetDivisionRecord() *Record {
+	if x != nil {
+		return x.DivisionRecord
+	}
+	return nil
+}
+
+func (x *TeamStanding) GetConferenceRecord() *Record {
+	if x != nil {
+		return x.ConferenceRecord
+	}
+	return nil
+}
+
+func (x *TeamStanding) GetHomeRecord() *Record {
+	if x != nil {
+		return x.HomeRecord
+	}
+	return nil
+}
+
+func (x *TeamStanding) GetAwayRecord() *Record {
+	if x != nil {
+		return x.AwayRecord
+	}
+	return nil
+}
+
+func (x *TeamStanding) GetPointsFor() int32 {
+	if x != nil {
+		return x.PointsFor
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetPointsAgainst() int32 {
+	if x != nil {
+		return x.PointsAgainst
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetPointDifferential() int32 {
+	if x != nil {
+		return x.PointDifferential
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetStreak() string {
+	if x != nil {
+		return x.Streak
+	}
+	return ""
+}
+
+func (x *TeamStanding) GetStrengthOfVictory() float64 {
+	if x != nil {
+		return x.StrengthOfVictory
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetStrengthOfSchedule() float64 {
+	if x != nil {
+		return x.StrengthOfSchedule
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetDivisionRank() int32 {
+	if x != nil {
+		return x.DivisionRank
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetConferenceRank() int32 {
+	if x != nil {
+		return x.ConferenceRank
+	}
+	return 0
+}
+
+func (x *TeamStanding) GetDivisionTiebreaker() string {
+	if x != nil {
+		return x.DivisionTiebreaker
+	}
+	return ""
+}
+
+func (x *TeamStanding) GetConferenceTiebreaker() string {
+	if x != nil {
+		return x.ConferenceTiebreaker
+	}
+	return ""
+}
+
+type GetStandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*TeamStanding        `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"` // Ordered by conference, division and division rank
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStandingsResponse) Reset() {
+	*x = GetStandingsResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStandingsResponse) ProtoMessage() {}
+
+func (x *GetStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStandingsResponse) GetStandings() []*TeamStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *GetStandingsResponse) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetStandingsResponse) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+// Playoffs: 7 seeds per conference taken from the final standings. Week 19 is
+// the Wild Card round and week 22 the Super Bowl; each round is created when
+// the previous one is final.
+type PlayoffSeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conference    Conference             `protobuf:"varint,1,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"`
+	Seed          int32                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayoffSeed) Reset() {
+	*x = PlayoffSeed{}
+	mi := &file_proto_game_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayoffSeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayoffSeed) ProtoMessage() {}
+
+func (x *PlayoffSeed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayoffSeed.ProtoReflect.Descriptor instead.
+func (*PlayoffSeed) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PlayoffSeed) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *PlayoffSeed) GetSeed() int32 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *PlayoffSeed) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type PlayoffGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         PlayoffRound           `protobuf:"varint,1,opt,name=round,proto3,enum=proto.PlayoffRound" json:"round,omitempty"`
+	Conference    Conference             `protobuf:"varint,2,opt,name=conference,proto3,enum=proto.Conference" json:"conference,omitempty"` // UNSPECIFIED for the Super Bowl
+	HomeSeed      int32                  `protobuf:"varint,3,opt,name=home_seed,json=homeSeed,proto3" json:"home_seed,omitempty"`
+	AwaySeed      int32                  `protobuf:"varint,4,opt,name=away_seed,json=awaySeed,proto3" json:"away_seed,omitempty"`
+	Game          *Game                  `protobuf:"bytes,5,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayoffGame) Reset() {
+	*x = PlayoffGame{}
+	mi := &file_proto_game_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayoffGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayoffGame) ProtoMessage() {}
+
+func (x *PlayoffGame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayoffGame.ProtoReflect.Descriptor instead.
+func (*PlayoffGame) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PlayoffGame) GetRound() PlayoffRound {
+	if x != nil {
+		return x.Round
+	}
+	return PlayoffRound_PLAYOFF_ROUND_UNSPECIFIED
+}
+
+func (x *PlayoffGame) GetConference() Conference {
+	if x != nil {
+		return x.Conference
+	}
+	return Conference_CONFERENCE_UNSPECIFIED
+}
+
+func (x *PlayoffGame) GetHomeSeed() int32 {
+	if x != nil {
+		return x.HomeSeed
+	}
+	return 0
+}
+
+func (x *PlayoffGame) GetAwaySeed() int32 {
+	if x != nil {
+		return x.AwaySeed
+	}
+	return 0
+}
+
+func (x *PlayoffGame) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type PlayoffBracket struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Season         int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Seeds          []*PlayoffSeed         `protobuf:"bytes,2,rep,name=seeds,proto3" json:"seeds,omitempty"`
+	Games          []*PlayoffGame         `protobuf:"bytes,3,rep,name=games,proto3" json:"games,omitempty"`                                           // Ordered by round
+	ChampionTeamId string                 `protobuf:"bytes,4,opt,name=champion_team_id,json=championTeamId,proto3" json:"champion_team_id,omitempty"` // Set once the Super Bowl is final
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayoffBracket) Reset() {
+	*x = PlayoffBracket{}
+	mi := &file_proto_game_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayoffBracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayoffBracket) ProtoMessage() {}
+
+func (x *PlayoffBracket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayoffBracket.ProtoReflect.Descriptor instead.
+func (*PlayoffBracket) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetTeamsByConferenceResponse) GetTeams() []*Team {
+func (x *PlayoffBracket) GetSeason() int32 {
 	if x != nil {
-		return x.Teams
+		return x.Season
+	}
+	return 0
+}
+
+func (x *PlayoffBracket) GetSeeds() []*PlayoffSeed {
+	if x != nil {
+		return x.Seeds
 	}
 	return nil
 }
 
-func (x *GetTeamsByConferenceResponse) GetTotal() int32 {
+func (x *PlayoffBracket) GetGames() []*PlayoffGame {
 	if x != nil {
-		return x.Total
+		return x.Games
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTeamsByConferenceResponse) GetConference() Conference {
+func (x *PlayoffBracket) GetChampionTeamId() string {
 	if x != nil {
-		return x.Conference
+		return x.ChampionTeamId
 	}
-	return Conference_CONFERENCE_UNSPECIFIED
+	return ""
 }
 
-// GetTeamsByDivision
-type GetTeamsByDivisionRequest struct {
+type GeneratePlayoffBracketRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Division      Division               `protobuf:"varint,1,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	WildCardStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=wild_card_start,json=wildCardStart,proto3" json:"wild_card_start,omitempty"` // Optional; default one week after the last regular-season game
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamsByDivisionRequest) Reset() {
-	*x = GetTeamsByDivisionRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[8]
+func (x *GeneratePlayoffBracketRequest) Reset() {
+	*x = GeneratePlayoffBracketRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamsByDivisionRequest) String() string {
+func (x *GeneratePlayoffBracketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsByDivisionRequest) ProtoMessage() {}
+func (*GeneratePlayoffBracketRequest) ProtoMessage() {}
 
-func (x *GetTeamsByDivisionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[8]
+func (x *GeneratePlayoffBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,42 +3894,48 @@ func (x *GetTeamsByDivisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsByDivisionRequest.ProtoReflect.Descriptor instead.
-func (*GetTeamsByDivisionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GeneratePlayoffBracketRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePlayoffBracketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetTeamsByDivisionRequest) GetDivision() Division {
+func (x *GeneratePlayoffBracketRequest) GetSeason() int32 {
 	if x != nil {
-		return x.Division
+		return x.Season
 	}
-	return Division_DIVISION_UNSPECIFIED
+	return 0
 }
 
-type GetTeamsByDivisionResponse struct {
+func (x *GeneratePlayoffBracketRequest) GetWildCardStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WildCardStart
+	}
+	return nil
+}
+
+type GeneratePlayoffBracketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Division      Division               `protobuf:"varint,3,opt,name=division,proto3,enum=proto.Division" json:"division,omitempty"`
+	Bracket       *PlayoffBracket        `protobuf:"bytes,1,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeamsByDivisionResponse) Reset() {
-	*x = GetTeamsByDivisionResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[9]
+func (x *GeneratePlayoffBracketResponse) Reset() {
+	*x = GeneratePlayoffBracketResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeamsByDivisionResponse) String() string {
+func (x *GeneratePlayoffBracketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeamsByDivisionResponse) ProtoMessage() {}
+func (*GeneratePlayoffBracketResponse) ProtoMessage() {}
 
-func (x *GetTeamsByDivisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[9]
+func (x *GeneratePlayoffBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,54 +3946,47 @@ func (x *GetTeamsByDivisionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeamsByDivisionResponse.ProtoReflect.Descriptor instead.
-func (*GetTeamsByDivisionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GeneratePlayoffBracketResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePlayoffBracketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetTeamsByDivisionResponse) GetTeams() []*Team {
+func (x *GeneratePlayoffBracketResponse) GetBracket() *PlayoffBracket {
 	if x != nil {
-		return x.Teams
+		return x.Bracket
 	}
 	return nil
 }
 
-func (x *GetTeamsByDivisionResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetTeamsByDivisionResponse) GetDivision() Division {
+func (x *GeneratePlayoffBracketResponse) GetMessage() string {
 	if x != nil {
-		return x.Division
+		return x.Message
 	}
-	return Division_DIVISION_UNSPECIFIED
+	return ""
 }
 
-// GetAllGames
-type GetAllGamesRequest struct {
+type GetPlayoffBracketRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllGamesRequest) Reset() {
-	*x = GetAllGamesRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[10]
+func (x *GetPlayoffBracketRequest) Reset() {
+	*x = GetPlayoffBracketRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllGamesRequest) String() string {
+func (x *GetPlayoffBracketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllGamesRequest) ProtoMessage() {}
+func (*GetPlayoffBracketRequest) ProtoMessage() {}
 
-func (x *GetAllGamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[10]
+func (x *GetPlayoffBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,34 +3997,40 @@ func (x *GetAllGamesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllGamesRequest.ProtoReflect.Descriptor instead.
-func (*GetAllGamesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetPlayoffBracketRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayoffBracketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{48}
 }
 
-type GetAllGamesResponse struct {
+func (x *GetPlayoffBracketRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+type GetPlayoffBracketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Bracket       *PlayoffBracket        `protobuf:"bytes,1,opt,name=bracket,proto3" json:"bracket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllGamesResponse) Reset() {
-	*x = GetAllGamesResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[11]
+func (x *GetPlayoffBracketResponse) Reset() {
+	*x = GetPlayoffBracketResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllGamesResponse) String() string {
+func (x *GetPlayoffBracketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllGamesResponse) ProtoMessage() {}
+func (*GetPlayoffBracketResponse) ProtoMessage() {}
 
-func (x *GetAllGamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[11]
+func (x *GetPlayoffBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,48 +4041,46 @@ func (x *GetAllGamesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllGamesResponse.ProtoReflect.Descriptor instead.
-func (*GetAllGamesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetPlayoffBracketResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayoffBracketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetAllGamesResponse) GetGames() []*Game {
+func (x *GetPlayoffBracketResponse) GetBracket() *PlayoffBracket {
 	if x != nil {
-		return x.Games
+		return x.Bracket
 	}
 	return nil
 }
 
-func (x *GetAllGamesResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// GetGameByID
-type GetGameByIDRequest struct {
+// Play-by-play
+type RecordScoringPlayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Type          ScoringPlayType        `protobuf:"varint,3,opt,name=type,proto3,enum=proto.ScoringPlayType" json:"type,omitempty"` // ADJUSTMENT is not allowed
+	Quarter       int32                  `protobuf:"varint,4,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	Clock         string                 `protobuf:"bytes,5,opt,name=clock,proto3" json:"clock,omitempty"` // "MM:SS"
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameByIDRequest) Reset() {
-	*x = GetGameByIDRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[12]
+func (x *RecordScoringPlayRequest) Reset() {
+	*x = RecordScoringPlayRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameByIDRequest) String() string {
+func (x *RecordScoringPlayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameByIDRequest) ProtoMessage() {}
+func (*RecordScoringPlayRequest) ProtoMessage() {}
 
-func (x *GetGameByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[12]
+func (x *RecordScoringPlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,85 +4091,77 @@ func (x *GetGameByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetGameByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RecordScoringPlayRequest.ProtoReflect.Descriptor instead.
+func (*RecordScoringPlayRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetGameByIDRequest) GetGameId() string {
+func (x *RecordScoringPlayRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type GetGameByIDResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetGameByIDResponse) Reset() {
-	*x = GetGameByIDResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RecordScoringPlayRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
 }
 
-func (x *GetGameByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RecordScoringPlayRequest) GetType() ScoringPlayType {
+	if x != nil {
+		return x.Type
+	}
+	return ScoringPlayType_SCORING_PLAY_TYPE_UNSPECIFIED
 }
 
-func (*GetGameByIDResponse) ProtoMessage() {}
-
-func (x *GetGameByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[13]
+func (x *RecordScoringPlayRequest) GetQuarter() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Quarter
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetGameByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetGameByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{13}
+func (x *RecordScoringPlayRequest) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
 }
 
-func (x *GetGameByIDResponse) GetGame() *Game {
+func (x *RecordScoringPlayRequest) GetDescription() string {
 	if x != nil {
-		return x.Game
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-// GetGamesByWeek
-type GetGamesByWeekRequest struct {
+type RecordScoringPlayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Week          int32                  `protobuf:"varint,1,opt,name=week,proto3" json:"week,omitempty"`
+	Play          *ScoringPlay           `protobuf:"bytes,1,opt,name=play,proto3" json:"play,omitempty"`
+	Game          *Game                  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesByWeekRequest) Reset() {
-	*x = GetGamesByWeekRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[14]
+func (x *RecordScoringPlayResponse) Reset() {
+	*x = RecordScoringPlayResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesByWeekRequest) String() string {
+func (x *RecordScoringPlayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesByWeekRequest) ProtoMessage() {}
+func (*RecordScoringPlayResponse) ProtoMessage() {}
 
-func (x *GetGamesByWeekRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[14]
+func (x *RecordScoringPlayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,42 +4172,63 @@ func (x *GetGamesByWeekRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesByWeekRequest.ProtoReflect.Descriptor instead.
-func (*GetGamesByWeekRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RecordScoringPlayResponse.ProtoReflect.Descriptor instead.
+func (*RecordScoringPlayResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetGamesByWeekRequest) GetWeek() int32 {
+func (x *RecordScoringPlayResponse) GetPlay() *ScoringPlay {
 	if x != nil {
-		return x.Week
+		return x.Play
 	}
-	return 0
+	return nil
 }
 
-type GetGamesByWeekResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+func (x *RecordScoringPlayResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *RecordScoringPlayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AmendScoringPlayRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayId string                 `protobuf:"bytes,2,opt,name=play_id,json=playId,proto3" json:"play_id,omitempty"` // Must be an effective play
+	Void   bool                   `protobuf:"varint,3,opt,name=void,proto3" json:"void,omitempty"`                  // Void the play instead of correcting it
+	Reason string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Corrected values; empty fields keep the amended play's value
+	TeamId        string          `protobuf:"bytes,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Type          ScoringPlayType `protobuf:"varint,6,opt,name=type,proto3,enum=proto.ScoringPlayType" json:"type,omitempty"`
+	Quarter       int32           `protobuf:"varint,7,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	Clock         string          `protobuf:"bytes,8,opt,name=clock,proto3" json:"clock,omitempty"`
+	Description   string          `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesByWeekResponse) Reset() {
-	*x = GetGamesByWeekResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[15]
+func (x *AmendScoringPlayRequest) Reset() {
+	*x = AmendScoringPlayRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesByWeekResponse) String() string {
+func (x *AmendScoringPlayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesByWeekResponse) ProtoMessage() {}
+func (*AmendScoringPlayRequest) ProtoMessage() {}
 
-func (x *GetGamesByWeekResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[15]
+func (x *AmendScoringPlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,101 +4239,98 @@ func (x *GetGamesByWeekResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesByWeekResponse.ProtoReflect.Descriptor instead.
-func (*GetGamesByWeekResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AmendScoringPlayRequest.ProtoReflect.Descriptor instead.
+func (*AmendScoringPlayRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetGamesByWeekResponse) GetGames() []*Game {
+func (x *AmendScoringPlayRequest) GetGameId() string {
 	if x != nil {
-		return x.Games
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGamesByWeekResponse) GetTotal() int32 {
+func (x *AmendScoringPlayRequest) GetPlayId() string {
 	if x != nil {
-		return x.Total
+		return x.PlayId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGamesByWeekResponse) GetWeek() int32 {
+func (x *AmendScoringPlayRequest) GetVoid() bool {
 	if x != nil {
-		return x.Week
+		return x.Void
 	}
-	return 0
+	return false
 }
 
-// GetGamesByTeam
-type GetGamesByTeamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AmendScoringPlayRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *GetGamesByTeamRequest) Reset() {
-	*x = GetGamesByTeamRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AmendScoringPlayRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
 }
 
-func (x *GetGamesByTeamRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AmendScoringPlayRequest) GetType() ScoringPlayType {
+	if x != nil {
+		return x.Type
+	}
+	return ScoringPlayType_SCORING_PLAY_TYPE_UNSPECIFIED
 }
 
-func (*GetGamesByTeamRequest) ProtoMessage() {}
-
-func (x *GetGamesByTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[16]
+func (x *AmendScoringPlayRequest) GetQuarter() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Quarter
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetGamesByTeamRequest.ProtoReflect.Descriptor instead.
-func (*GetGamesByTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{16}
+func (x *AmendScoringPlayRequest) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
 }
 
-func (x *GetGamesByTeamRequest) GetTeamId() string {
+func (x *AmendScoringPlayRequest) GetDescription() string {
 	if x != nil {
-		return x.TeamId
+		return x.Description
 	}
 	return ""
 }
 
-type GetGamesByTeamResponse struct {
+type AmendScoringPlayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Play          *ScoringPlay           `protobuf:"bytes,1,opt,name=play,proto3" json:"play,omitempty"`
+	Game          *Game                  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesByTeamResponse) Reset() {
-	*x = GetGamesByTeamResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[17]
+func (x *AmendScoringPlayResponse) Reset() {
+	*x = AmendScoringPlayResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesByTeamResponse) String() string {
+func (x *AmendScoringPlayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesByTeamResponse) ProtoMessage() {}
+func (*AmendScoringPlayResponse) ProtoMessage() {}
 
-func (x *GetGamesByTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[17]
+func (x *AmendScoringPlayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,55 +4341,54 @@ func (x *GetGamesByTeamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesByTeamResponse.ProtoReflect.Descriptor instead.
-func (*GetGamesByTeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AmendScoringPlayResponse.ProtoReflect.Descriptor instead.
+func (*AmendScoringPlayResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetGamesByTeamResponse) GetGames() []*Game {
+func (x *AmendScoringPlayResponse) GetPlay() *ScoringPlay {
 	if x != nil {
-		return x.Games
+		return x.Play
 	}
 	return nil
 }
 
-func (x *GetGamesByTeamResponse) GetTotal() int32 {
+func (x *AmendScoringPlayResponse) GetGame() *Game {
 	if x != nil {
-		return x.Total
+		return x.Game
 	}
-	return 0
+	return nil
 }
 
-func (x *GetGamesByTeamResponse) GetTeamId() string {
+func (x *AmendScoringPlayResponse) GetMessage() string {
 	if x != nil {
-		return x.TeamId
+		return x.Message
 	}
 	return ""
 }
 
-// GetGamesByStatus
-type GetGamesByStatusRequest struct {
+type GetPlayByPlayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        GameStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesByStatusRequest) Reset() {
-	*x = GetGamesByStatusRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[18]
+func (x *GetPlayByPlayRequest) Reset() {
+	*x = GetPlayByPlayRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesByStatusRequest) String() string {
+func (x *GetPlayByPlayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesByStatusRequest) ProtoMessage() {}
+func (*GetPlayByPlayRequest) ProtoMessage() {}
 
-func (x *GetGamesByStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[18]
+func (x *GetPlayByPlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,42 +4399,41 @@ func (x *GetGamesByStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesByStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetGamesByStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetPlayByPlayRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayByPlayRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetGamesByStatusRequest) GetStatus() GameStatus {
+func (x *GetPlayByPlayRequest) GetGameId() string {
 	if x != nil {
-		return x.Status
+		return x.GameId
 	}
-	return GameStatus_GAME_STATUS_UNSPECIFIED
+	return ""
 }
 
-type GetGamesByStatusResponse struct {
+type GetPlayByPlayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Status        GameStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Plays         []*ScoringPlay         `protobuf:"bytes,2,rep,name=plays,proto3" json:"plays,omitempty"` // Full log ordered by sequence, amendments included
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesByStatusResponse) Reset() {
-	*x = GetGamesByStatusResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[19]
+func (x *GetPlayByPlayResponse) Reset() {
+	*x = GetPlayByPlayResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesByStatusResponse) String() string {
+func (x *GetPlayByPlayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesByStatusResponse) ProtoMessage() {}
+func (*GetPlayByPlayResponse) ProtoMessage() {}
 
-func (x *GetGamesByStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[19]
+func (x *GetPlayByPlayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,58 +4444,50 @@ func (x *GetGamesByStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesByStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetGamesByStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetPlayByPlayResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayByPlayResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetGamesByStatusResponse) GetGames() []*Game {
+func (x *GetPlayByPlayResponse) GetGame() *Game {
 	if x != nil {
-		return x.Games
+		return x.Game
 	}
 	return nil
 }
 
-func (x *GetGamesByStatusResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetGamesByStatusResponse) GetStatus() GameStatus {
+func (x *GetPlayByPlayResponse) GetPlays() []*ScoringPlay {
 	if x != nil {
-		return x.Status
+		return x.Plays
 	}
-	return GameStatus_GAME_STATUS_UNSPECIFIED
+	return nil
 }
 
-// CreateGame (for admin/testing)
-type CreateGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HomeTeamId    string                 `protobuf:"bytes,1,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
-	AwayTeamId    string                 `protobuf:"bytes,2,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
-	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
-	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateGameClockRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GameId           string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Quarter          int32                  `protobuf:"varint,2,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	Clock            string                 `protobuf:"bytes,3,opt,name=clock,proto3" json:"clock,omitempty"`                                                 // "MM:SS"
+	PossessionTeamId string                 `protobuf:"bytes,4,opt,name=possession_team_id,json=possessionTeamId,proto3" json:"possession_team_id,omitempty"` // Empty clears the possession
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateGameRequest) Reset() {
-	*x = CreateGameRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[20]
+func (x *UpdateGameClockRequest) Reset() {
+	*x = UpdateGameClockRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameRequest) String() string {
+func (x *UpdateGameClockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameRequest) ProtoMessage() {}
+func (*UpdateGameClockRequest) ProtoMessage() {}
 
-func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[20]
+func (x *UpdateGameClockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,40 +4498,40 @@ func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateGameClockRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameClockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateGameRequest) GetHomeTeamId() string {
+func (x *UpdateGameClockRequest) GetGameId() string {
 	if x != nil {
-		return x.HomeTeamId
+		return x.GameId
 	}
 	return ""
 }
 
-func (x *CreateGameRequest) GetAwayTeamId() string {
+func (x *UpdateGameClockRequest) GetQuarter() int32 {
 	if x != nil {
-		return x.AwayTeamId
+		return x.Quarter
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGameRequest) GetWeek() int32 {
+func (x *UpdateGameClockRequest) GetClock() string {
 	if x != nil {
-		return x.Week
+		return x.Clock
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGameRequest) GetScheduledAt() *timestamppb.Timestamp {
+func (x *UpdateGameClockRequest) GetPossessionTeamId() string {
 	if x != nil {
-		return x.ScheduledAt
+		return x.PossessionTeamId
 	}
-	return nil
+	return ""
 }
 
-type CreateGameResponse struct {
+type UpdateGameClockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -1370,21 +4539,21 @@ type CreateGameResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameResponse) Reset() {
-	*x = CreateGameResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[21]
+func (x *UpdateGameClockResponse) Reset() {
+	*x = UpdateGameClockResponse{}
+	mi := &file_proto_game_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameResponse) String() string {
+func (x *UpdateGameClockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameResponse) ProtoMessage() {}
+func (*UpdateGameClockResponse) ProtoMessage() {}
 
-func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[21]
+func (x *UpdateGameClockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,50 +4564,50 @@ func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpdateGameClockResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameClockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateGameResponse) GetGame() *Game {
+func (x *UpdateGameClockResponse) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-func (x *CreateGameResponse) GetMessage() string {
+func (x *UpdateGameClockResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// UpdateGameScore
-type UpdateGameScoreRequest struct {
+// Live updates
+type WatchGamesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	HomeScore     int32                  `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
-	AwayScore     int32                  `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	SinceVersion  int64                  `protobuf:"varint,1,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"` // 0 = send every game first; otherwise only games changed after this version
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`                                 // Optional filters
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGameScoreRequest) Reset() {
-	*x = UpdateGameScoreRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[22]
+func (x *WatchGamesRequest) Reset() {
+	*x = WatchGamesRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGameScoreRequest) String() string {
+func (x *WatchGamesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGameScoreRequest) ProtoMessage() {}
+func (*WatchGamesRequest) ProtoMessage() {}
 
-func (x *UpdateGameScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[22]
+func (x *WatchGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,108 +4618,55 @@ func (x *UpdateGameScoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGameScoreRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGameScoreRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *UpdateGameScoreRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
+// Deprecated: Use WatchGamesRequest.ProtoReflect.Descriptor instead.
+func (*WatchGamesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateGameScoreRequest) GetHomeScore() int32 {
+func (x *WatchGamesRequest) GetSinceVersion() int64 {
 	if x != nil {
-		return x.HomeScore
+		return x.SinceVersion
 	}
 	return 0
 }
 
-func (x *UpdateGameScoreRequest) GetAwayScore() int32 {
+func (x *WatchGamesRequest) GetSeason() int32 {
 	if x != nil {
-		return x.AwayScore
+		return x.Season
 	}
 	return 0
 }
 
-type UpdateGameScoreResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateGameScoreResponse) Reset() {
-	*x = UpdateGameScoreResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateGameScoreResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateGameScoreResponse) ProtoMessage() {}
-
-func (x *UpdateGameScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[23]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateGameScoreResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGameScoreResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *UpdateGameScoreResponse) GetGame() *Game {
+func (x *WatchGamesRequest) GetWeek() int32 {
 	if x != nil {
-		return x.Game
-	}
-	return nil
-}
-
-func (x *UpdateGameScoreResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+		return x.Week
 	}
-	return ""
+	return 0
 }
 
-// UpdateGameStatus
-type UpdateGameStatusRequest struct {
+type WatchGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Status        GameStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=proto.GameStatus" json:"status,omitempty"`
+	SinceVersion  int64                  `protobuf:"varint,2,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGameStatusRequest) Reset() {
-	*x = UpdateGameStatusRequest{}
-	mi := &file_proto_game_service_proto_msgTypes[24]
+func (x *WatchGameRequest) Reset() {
+	*x = WatchGameRequest{}
+	mi := &file_proto_game_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGameStatusRequest) String() string {
+func (x *WatchGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGameStatusRequest) ProtoMessage() {}
+func (*WatchGameRequest) ProtoMessage() {}
 
-func (x *UpdateGameStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[24]
+func (x *WatchGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,48 +4677,48 @@ func (x *UpdateGameStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGameStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGameStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use WatchGameRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateGameStatusRequest) GetGameId() string {
+func (x *WatchGameRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (x *UpdateGameStatusRequest) GetStatus() GameStatus {
+func (x *WatchGameRequest) GetSinceVersion() int64 {
 	if x != nil {
-		return x.Status
+		return x.SinceVersion
 	}
-	return GameStatus_GAME_STATUS_UNSPECIFIED
+	return 0
 }
 
-type UpdateGameStatusResponse struct {
+type GameUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`          // Current state; game.version is the resume token
+	Snapshot      bool                   `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // Part of the initial state rather than a live change
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGameStatusResponse) Reset() {
-	*x = UpdateGameStatusResponse{}
-	mi := &file_proto_game_service_proto_msgTypes[25]
+func (x *GameUpdate) Reset() {
+	*x = GameUpdate{}
+	mi := &file_proto_game_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGameStatusResponse) String() string {
+func (x *GameUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGameStatusResponse) ProtoMessage() {}
+func (*GameUpdate) ProtoMessage() {}
 
-func (x *UpdateGameStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_proto_msgTypes[25]
+func (x *GameUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,30 +4729,30 @@ func (x *UpdateGameStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGameStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGameStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_game_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GameUpdate.ProtoReflect.Descriptor instead.
+func (*GameUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *UpdateGameStatusResponse) GetGame() *Game {
+func (x *GameUpdate) GetGame() *Game {
 	if x != nil {
 		return x.Game
 	}
 	return nil
 }
 
-func (x *UpdateGameStatusResponse) GetMessage() string {
+func (x *GameUpdate) GetSnapshot() bool {
 	if x != nil {
-		return x.Message
+		return x.Snapshot
 	}
-	return ""
+	return false
 }
 
 var File_proto_game_service_proto protoreflect.FileDescriptor
 
 const file_proto_game_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/game_service.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
+	"\x18proto/game_service.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
 	"\x04Team\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -1647,7 +4763,7 @@ const file_proto_game_service_proto_rawDesc = "" +
 	"conference\x12+\n" +
 	"\bdivision\x18\x06 \x01(\x0e2\x0f.proto.DivisionR\bdivision\x12\x19\n" +
 	"\blogo_url\x18\a \x01(\tR\alogoUrl\x12\x18\n" +
-	"\astadium\x18\b \x01(\tR\astadium\"\x90\x03\n" +
+	"\astadium\x18\b \x01(\tR\astadium\"\xad\x05\n" +
 	"\x04Game\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
 	"\fhome_team_id\x18\x02 \x01(\tR\n" +
@@ -1664,82 +4780,347 @@ const file_proto_game_service_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
 	"\fcompleted_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x14\n" +
-	"\x12GetAllTeamsRequest\"N\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x16\n" +
+	"\x06season\x18\v \x01(\x05R\x06season\x12\x1b\n" +
+	"\x06spread\x18\f \x01(\x01H\x00R\x06spread\x88\x01\x01\x12>\n" +
+	"\rlines_lock_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vlinesLockAt\x12\x19\n" +
+	"\x05total\x18\x0e \x01(\x01H\x01R\x05total\x88\x01\x01\x12\x18\n" +
+	"\aquarter\x18\x0f \x01(\x05R\aquarter\x12\x14\n" +
+	"\x05clock\x18\x10 \x01(\tR\x05clock\x12,\n" +
+	"\x12possession_team_id\x18\x11 \x01(\tR\x10possessionTeamId\x12\x18\n" +
+	"\aversion\x18\x12 \x01(\x03R\aversionB\t\n" +
+	"\a_spreadB\b\n" +
+	"\x06_total\"\xca\x03\n" +
+	"\vScoringPlay\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x05R\bsequence\x120\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x18.proto.ScoringPlayActionR\x06action\x12$\n" +
+	"\x0eamends_play_id\x18\x05 \x01(\tR\famendsPlayId\x12\x17\n" +
+	"\ateam_id\x18\x06 \x01(\tR\x06teamId\x12*\n" +
+	"\x04type\x18\a \x01(\x0e2\x16.proto.ScoringPlayTypeR\x04type\x12\x16\n" +
+	"\x06points\x18\b \x01(\x05R\x06points\x12\x18\n" +
+	"\aquarter\x18\t \x01(\x05R\aquarter\x12\x14\n" +
+	"\x05clock\x18\n" +
+	" \x01(\tR\x05clock\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12\x16\n" +
+	"\x06reason\x18\f \x01(\tR\x06reason\x12\x1c\n" +
+	"\teffective\x18\r \x01(\bR\teffective\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x02\n" +
+	"\x10GameStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x122\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x11.proto.GameStatusR\n" +
+	"fromStatus\x12.\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x11.proto.GameStatusR\btoStatus\x12\x1a\n" +
+	"\boverride\x18\x05 \x01(\bR\boverride\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xcb\x01\n" +
+	"\x12GetAllTeamsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x121\n" +
+	"\n" +
+	"conference\x18\x04 \x01(\x0e2\x11.proto.ConferenceR\n" +
+	"conference\x12+\n" +
+	"\bdivision\x18\x05 \x01(\x0e2\x0f.proto.DivisionR\bdivision\"v\n" +
 	"\x13GetAllTeamsResponse\x12!\n" +
 	"\x05teams\x18\x01 \x03(\v2\v.proto.TeamR\x05teams\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"-\n" +
 	"\x12GetTeamByIDRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"6\n" +
 	"\x13GetTeamByIDResponse\x12\x1f\n" +
-	"\x04team\x18\x01 \x01(\v2\v.proto.TeamR\x04team\"P\n" +
+	"\x04team\x18\x01 \x01(\v2\v.proto.TeamR\x04team\"\x8c\x01\n" +
 	"\x1bGetTeamsByConferenceRequest\x121\n" +
 	"\n" +
 	"conference\x18\x01 \x01(\x0e2\x11.proto.ConferenceR\n" +
-	"conference\"\x8a\x01\n" +
+	"conference\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xb2\x01\n" +
 	"\x1cGetTeamsByConferenceResponse\x12!\n" +
 	"\x05teams\x18\x01 \x03(\v2\v.proto.TeamR\x05teams\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x121\n" +
 	"\n" +
 	"conference\x18\x03 \x01(\x0e2\x11.proto.ConferenceR\n" +
-	"conference\"H\n" +
+	"conference\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x84\x01\n" +
 	"\x19GetTeamsByDivisionRequest\x12+\n" +
-	"\bdivision\x18\x01 \x01(\x0e2\x0f.proto.DivisionR\bdivision\"\x82\x01\n" +
+	"\bdivision\x18\x01 \x01(\x0e2\x0f.proto.DivisionR\bdivision\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xaa\x01\n" +
 	"\x1aGetTeamsByDivisionResponse\x12!\n" +
 	"\x05teams\x18\x01 \x03(\v2\v.proto.TeamR\x05teams\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12+\n" +
-	"\bdivision\x18\x03 \x01(\x0e2\x0f.proto.DivisionR\bdivision\"\x14\n" +
-	"\x12GetAllGamesRequest\"N\n" +
+	"\bdivision\x18\x03 \x01(\x0e2\x0f.proto.DivisionR\bdivision\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x89\x03\n" +
+	"\x12GetAllGamesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06season\x18\x04 \x01(\x05R\x06season\x12\x1b\n" +
+	"\tweek_from\x18\x05 \x01(\x05R\bweekFrom\x12\x17\n" +
+	"\aweek_to\x18\x06 \x01(\x05R\x06weekTo\x12\x17\n" +
+	"\ateam_id\x18\a \x01(\tR\x06teamId\x12)\n" +
+	"\x06status\x18\b \x01(\x0e2\x11.proto.GameStatusR\x06status\x12C\n" +
+	"\x0fscheduled_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0escheduledAfter\x12E\n" +
+	"\x10scheduled_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0fscheduledBefore\"v\n" +
 	"\x13GetAllGamesResponse\x12!\n" +
 	"\x05games\x18\x01 \x03(\v2\v.proto.GameR\x05games\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"-\n" +
 	"\x12GetGameByIDRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\"6\n" +
 	"\x13GetGameByIDResponse\x12\x1f\n" +
-	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\"+\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\"g\n" +
 	"\x15GetGamesByWeekRequest\x12\x12\n" +
-	"\x04week\x18\x01 \x01(\x05R\x04week\"e\n" +
+	"\x04week\x18\x01 \x01(\x05R\x04week\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8d\x01\n" +
 	"\x16GetGamesByWeekResponse\x12!\n" +
 	"\x05games\x18\x01 \x03(\v2\v.proto.GameR\x05games\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
-	"\x04week\x18\x03 \x01(\x05R\x04week\"0\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"l\n" +
 	"\x15GetGamesByTeamRequest\x12\x17\n" +
-	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"j\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x92\x01\n" +
 	"\x16GetGamesByTeamResponse\x12!\n" +
 	"\x05games\x18\x01 \x03(\v2\v.proto.GameR\x05games\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x17\n" +
-	"\ateam_id\x18\x03 \x01(\tR\x06teamId\"D\n" +
+	"\ateam_id\x18\x03 \x01(\tR\x06teamId\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x80\x01\n" +
 	"\x17GetGamesByStatusRequest\x12)\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x11.proto.GameStatusR\x06status\"~\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x11.proto.GameStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa6\x01\n" +
 	"\x18GetGamesByStatusResponse\x12!\n" +
 	"\x05games\x18\x01 \x03(\v2\v.proto.GameR\x05games\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12)\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x11.proto.GameStatusR\x06status\"\xaa\x01\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x11.proto.GameStatusR\x06status\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xc2\x01\n" +
 	"\x11CreateGameRequest\x12 \n" +
 	"\fhome_team_id\x18\x01 \x01(\tR\n" +
 	"homeTeamId\x12 \n" +
 	"\faway_team_id\x18\x02 \x01(\tR\n" +
 	"awayTeamId\x12\x12\n" +
 	"\x04week\x18\x03 \x01(\x05R\x04week\x12=\n" +
-	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"O\n" +
+	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x16\n" +
+	"\x06season\x18\x05 \x01(\x05R\x06season\"O\n" +
 	"\x12CreateGameResponse\x12\x1f\n" +
 	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"o\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
 	"\x16UpdateGameScoreRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1d\n" +
 	"\n" +
 	"home_score\x18\x02 \x01(\x05R\thomeScore\x12\x1d\n" +
 	"\n" +
-	"away_score\x18\x03 \x01(\x05R\tawayScore\"T\n" +
+	"away_score\x18\x03 \x01(\x05R\tawayScore\x12\x1a\n" +
+	"\boverride\x18\x04 \x01(\bR\boverride\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"T\n" +
 	"\x17UpdateGameScoreResponse\x12\x1f\n" +
 	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"]\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
 	"\x17UpdateGameStatusRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12)\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x11.proto.GameStatusR\x06status\"U\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.proto.GameStatusR\x06status\x12\x1a\n" +
+	"\boverride\x18\x03 \x01(\bR\boverride\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x86\x01\n" +
 	"\x18UpdateGameStatusResponse\x12\x1f\n" +
 	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*P\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x06change\x18\x03 \x01(\v2\x17.proto.GameStatusChangeR\x06change\"\xeb\x01\n" +
+	"\x15RescheduleGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12=\n" +
+	"\fscheduled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\x12N\n" +
+	"\x11prediction_policy\x18\x04 \x01(\x0e2!.proto.ReschedulePredictionPolicyR\x10predictionPolicy\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xd4\x01\n" +
+	"\x16RescheduleGameResponse\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12N\n" +
+	"\x11prediction_policy\x18\x03 \x01(\x0e2!.proto.ReschedulePredictionPolicyR\x10predictionPolicy\x12/\n" +
+	"\x06change\x18\x04 \x01(\v2\x17.proto.GameStatusChangeR\x06change\"6\n" +
+	"\x1bGetGameStatusHistoryRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"r\n" +
+	"\x1cGetGameStatusHistoryResponse\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x121\n" +
+	"\achanges\x18\x02 \x03(\v2\x17.proto.GameStatusChangeR\achanges\"\xbe\x01\n" +
+	"\x16UpdateGameLinesRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\x06spread\x18\x02 \x01(\x01H\x00R\x06spread\x88\x01\x01\x12>\n" +
+	"\rlines_lock_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vlinesLockAt\x12\x19\n" +
+	"\x05total\x18\x04 \x01(\x01H\x01R\x05total\x88\x01\x01B\t\n" +
+	"\a_spreadB\b\n" +
+	"\x06_total\"T\n" +
+	"\x17UpdateGameLinesResponse\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x15ImportScheduleRequest\x12-\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x15.proto.ScheduleFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x16\n" +
+	"\x06season\x18\x03 \x01(\x05R\x06season\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"e\n" +
+	"\x13ScheduleFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xc7\x02\n" +
+	"\x0eScheduleChange\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x16\n" +
+	"\x06season\x18\x04 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x05 \x01(\x05R\x04week\x12 \n" +
+	"\fhome_team_id\x18\x06 \x01(\tR\n" +
+	"homeTeamId\x12 \n" +
+	"\faway_team_id\x18\a \x01(\tR\n" +
+	"awayTeamId\x12=\n" +
+	"\fscheduled_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x124\n" +
+	"\achanges\x18\t \x03(\v2\x1a.proto.ScheduleFieldChangeR\achanges\"=\n" +
+	"\rScheduleIssue\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xfd\x01\n" +
+	"\x16ImportScheduleResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\x12/\n" +
+	"\achanges\x18\x05 \x03(\v2\x15.proto.ScheduleChangeR\achanges\x12,\n" +
+	"\x06issues\x18\x06 \x03(\v2\x14.proto.ScheduleIssueR\x06issues\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xa1\x01\n" +
+	"\x13GetStandingsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x02 \x01(\x05R\x04week\x121\n" +
+	"\n" +
+	"conference\x18\x03 \x01(\x0e2\x11.proto.ConferenceR\n" +
+	"conference\x12+\n" +
+	"\bdivision\x18\x04 \x01(\x0e2\x0f.proto.DivisionR\bdivision\"H\n" +
+	"\x06Record\x12\x12\n" +
+	"\x04wins\x18\x01 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x02 \x01(\x05R\x06losses\x12\x12\n" +
+	"\x04ties\x18\x03 \x01(\x05R\x04ties\"\xe5\x06\n" +
+	"\fTeamStanding\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x121\n" +
+	"\n" +
+	"conference\x18\x02 \x01(\x0e2\x11.proto.ConferenceR\n" +
+	"conference\x12+\n" +
+	"\bdivision\x18\x03 \x01(\x0e2\x0f.proto.DivisionR\bdivision\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x05 \x01(\x05R\x06losses\x12\x12\n" +
+	"\x04ties\x18\x06 \x01(\x05R\x04ties\x12%\n" +
+	"\x0ewin_percentage\x18\a \x01(\x01R\rwinPercentage\x126\n" +
+	"\x0fdivision_record\x18\b \x01(\v2\r.proto.RecordR\x0edivisionRecord\x12:\n" +
+	"\x11conference_record\x18\t \x01(\v2\r.proto.RecordR\x10conferenceRecord\x12.\n" +
+	"\vhome_record\x18\n" +
+	" \x01(\v2\r.proto.RecordR\n" +
+	"homeRecord\x12.\n" +
+	"\vaway_record\x18\v \x01(\v2\r.proto.RecordR\n" +
+	"awayRecord\x12\x1d\n" +
+	"\n" +
+	"points_for\x18\f \x01(\x05R\tpointsFor\x12%\n" +
+	"\x0epoints_against\x18\r \x01(\x05R\rpointsAgainst\x12-\n" +
+	"\x12point_differential\x18\x0e \x01(\x05R\x11pointDifferential\x12\x16\n" +
+	"\x06streak\x18\x0f \x01(\tR\x06streak\x12.\n" +
+	"\x13strength_of_victory\x18\x10 \x01(\x01R\x11strengthOfVictory\x120\n" +
+	"\x14strength_of_schedule\x18\x11 \x01(\x01R\x12strengthOfSchedule\x12#\n" +
+	"\rdivision_rank\x18\x12 \x01(\x05R\fdivisionRank\x12'\n" +
+	"\x0fconference_rank\x18\x13 \x01(\x05R\x0econferenceRank\x12/\n" +
+	"\x13division_tiebreaker\x18\x14 \x01(\tR\x12divisionTiebreaker\x123\n" +
+	"\x15conference_tiebreaker\x18\x15 \x01(\tR\x14conferenceTiebreaker\"u\n" +
+	"\x14GetStandingsResponse\x121\n" +
+	"\tstandings\x18\x01 \x03(\v2\x13.proto.TeamStandingR\tstandings\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\"m\n" +
+	"\vPlayoffSeed\x121\n" +
+	"\n" +
+	"conference\x18\x01 \x01(\x0e2\x11.proto.ConferenceR\n" +
+	"conference\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x05R\x04seed\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\tR\x06teamId\"\xc6\x01\n" +
+	"\vPlayoffGame\x12)\n" +
+	"\x05round\x18\x01 \x01(\x0e2\x13.proto.PlayoffRoundR\x05round\x121\n" +
+	"\n" +
+	"conference\x18\x02 \x01(\x0e2\x11.proto.ConferenceR\n" +
+	"conference\x12\x1b\n" +
+	"\thome_seed\x18\x03 \x01(\x05R\bhomeSeed\x12\x1b\n" +
+	"\taway_seed\x18\x04 \x01(\x05R\bawaySeed\x12\x1f\n" +
+	"\x04game\x18\x05 \x01(\v2\v.proto.GameR\x04game\"\xa6\x01\n" +
+	"\x0ePlayoffBracket\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12(\n" +
+	"\x05seeds\x18\x02 \x03(\v2\x12.proto.PlayoffSeedR\x05seeds\x12(\n" +
+	"\x05games\x18\x03 \x03(\v2\x12.proto.PlayoffGameR\x05games\x12(\n" +
+	"\x10champion_team_id\x18\x04 \x01(\tR\x0echampionTeamId\"{\n" +
+	"\x1dGeneratePlayoffBracketRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12B\n" +
+	"\x0fwild_card_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rwildCardStart\"k\n" +
+	"\x1eGeneratePlayoffBracketResponse\x12/\n" +
+	"\abracket\x18\x01 \x01(\v2\x15.proto.PlayoffBracketR\abracket\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\x18GetPlayoffBracketRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\"L\n" +
+	"\x19GetPlayoffBracketResponse\x12/\n" +
+	"\abracket\x18\x01 \x01(\v2\x15.proto.PlayoffBracketR\abracket\"\xca\x01\n" +
+	"\x18RecordScoringPlayRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12*\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x16.proto.ScoringPlayTypeR\x04type\x12\x18\n" +
+	"\aquarter\x18\x04 \x01(\x05R\aquarter\x12\x14\n" +
+	"\x05clock\x18\x05 \x01(\tR\x05clock\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"~\n" +
+	"\x19RecordScoringPlayResponse\x12&\n" +
+	"\x04play\x18\x01 \x01(\v2\x12.proto.ScoringPlayR\x04play\x12\x1f\n" +
+	"\x04game\x18\x02 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x8e\x02\n" +
+	"\x17AmendScoringPlayRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\aplay_id\x18\x02 \x01(\tR\x06playId\x12\x12\n" +
+	"\x04void\x18\x03 \x01(\bR\x04void\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x17\n" +
+	"\ateam_id\x18\x05 \x01(\tR\x06teamId\x12*\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x16.proto.ScoringPlayTypeR\x04type\x12\x18\n" +
+	"\aquarter\x18\a \x01(\x05R\aquarter\x12\x14\n" +
+	"\x05clock\x18\b \x01(\tR\x05clock\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\"}\n" +
+	"\x18AmendScoringPlayResponse\x12&\n" +
+	"\x04play\x18\x01 \x01(\v2\x12.proto.ScoringPlayR\x04play\x12\x1f\n" +
+	"\x04game\x18\x02 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"/\n" +
+	"\x14GetPlayByPlayRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"b\n" +
+	"\x15GetPlayByPlayResponse\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12(\n" +
+	"\x05plays\x18\x02 \x03(\v2\x12.proto.ScoringPlayR\x05plays\"\x8f\x01\n" +
+	"\x16UpdateGameClockRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x18\n" +
+	"\aquarter\x18\x02 \x01(\x05R\aquarter\x12\x14\n" +
+	"\x05clock\x18\x03 \x01(\tR\x05clock\x12,\n" +
+	"\x12possession_team_id\x18\x04 \x01(\tR\x10possessionTeamId\"T\n" +
+	"\x17UpdateGameClockResponse\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"d\n" +
+	"\x11WatchGamesRequest\x12#\n" +
+	"\rsince_version\x18\x01 \x01(\x03R\fsinceVersion\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\"P\n" +
+	"\x10WatchGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12#\n" +
+	"\rsince_version\x18\x02 \x01(\x03R\fsinceVersion\"I\n" +
+	"\n" +
+	"GameUpdate\x12\x1f\n" +
+	"\x04game\x18\x01 \x01(\v2\v.proto.GameR\x04game\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\bR\bsnapshot*P\n" +
 	"\n" +
 	"Conference\x12\x1a\n" +
 	"\x16CONFERENCE_UNSPECIFIED\x10\x00\x12\x12\n" +
@@ -1762,21 +5143,66 @@ const file_proto_game_service_proto_rawDesc = "" +
 	"\x17GAME_STATUS_IN_PROGRESS\x10\x02\x12\x19\n" +
 	"\x15GAME_STATUS_COMPLETED\x10\x03\x12\x19\n" +
 	"\x15GAME_STATUS_POSTPONED\x10\x04\x12\x18\n" +
-	"\x14GAME_STATUS_CANCELED\x10\x052\xbe\a\n" +
-	"\vGameService\x12D\n" +
-	"\vGetAllTeams\x12\x19.proto.GetAllTeamsRequest\x1a\x1a.proto.GetAllTeamsResponse\x12D\n" +
-	"\vGetTeamByID\x12\x19.proto.GetTeamByIDRequest\x1a\x1a.proto.GetTeamByIDResponse\x12_\n" +
-	"\x14GetTeamsByConference\x12\".proto.GetTeamsByConferenceRequest\x1a#.proto.GetTeamsByConferenceResponse\x12Y\n" +
-	"\x12GetTeamsByDivision\x12 .proto.GetTeamsByDivisionRequest\x1a!.proto.GetTeamsByDivisionResponse\x12D\n" +
-	"\vGetAllGames\x12\x19.proto.GetAllGamesRequest\x1a\x1a.proto.GetAllGamesResponse\x12D\n" +
-	"\vGetGameByID\x12\x19.proto.GetGameByIDRequest\x1a\x1a.proto.GetGameByIDResponse\x12M\n" +
-	"\x0eGetGamesByWeek\x12\x1c.proto.GetGamesByWeekRequest\x1a\x1d.proto.GetGamesByWeekResponse\x12M\n" +
-	"\x0eGetGamesByTeam\x12\x1c.proto.GetGamesByTeamRequest\x1a\x1d.proto.GetGamesByTeamResponse\x12S\n" +
-	"\x10GetGamesByStatus\x12\x1e.proto.GetGamesByStatusRequest\x1a\x1f.proto.GetGamesByStatusResponse\x12A\n" +
+	"\x14GAME_STATUS_CANCELED\x10\x05*\x98\x01\n" +
+	"\x1aReschedulePredictionPolicy\x12,\n" +
+	"(RESCHEDULE_PREDICTION_POLICY_UNSPECIFIED\x10\x00\x12%\n" +
+	"!RESCHEDULE_PREDICTION_POLICY_KEEP\x10\x01\x12%\n" +
+	"!RESCHEDULE_PREDICTION_POLICY_VOID\x10\x02*}\n" +
+	"\x0eScheduleFormat\x12\x1f\n" +
+	"\x1bSCHEDULE_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SCHEDULE_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14SCHEDULE_FORMAT_JSON\x10\x02\x12\x17\n" +
+	"\x13SCHEDULE_FORMAT_ICS\x10\x03*\xa4\x01\n" +
+	"\fPlayoffRound\x12\x1d\n" +
+	"\x19PLAYOFF_ROUND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PLAYOFF_ROUND_WILD_CARD\x10\x01\x12\x1c\n" +
+	"\x18PLAYOFF_ROUND_DIVISIONAL\x10\x02\x12\x1c\n" +
+	"\x18PLAYOFF_ROUND_CONFERENCE\x10\x03\x12\x1c\n" +
+	"\x18PLAYOFF_ROUND_SUPER_BOWL\x10\x04*\xfb\x01\n" +
+	"\x0fScoringPlayType\x12!\n" +
+	"\x1dSCORING_PLAY_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSCORING_PLAY_TYPE_TOUCHDOWN\x10\x01\x12 \n" +
+	"\x1cSCORING_PLAY_TYPE_FIELD_GOAL\x10\x02\x12\x1c\n" +
+	"\x18SCORING_PLAY_TYPE_SAFETY\x10\x03\x12!\n" +
+	"\x1dSCORING_PLAY_TYPE_EXTRA_POINT\x10\x04\x12\x1f\n" +
+	"\x1bSCORING_PLAY_TYPE_TWO_POINT\x10\x05\x12 \n" +
+	"\x1cSCORING_PLAY_TYPE_ADJUSTMENT\x10\x06*\x97\x01\n" +
+	"\x11ScoringPlayAction\x12#\n" +
+	"\x1fSCORING_PLAY_ACTION_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSCORING_PLAY_ACTION_RECORD\x10\x01\x12\x1f\n" +
+	"\x1bSCORING_PLAY_ACTION_CORRECT\x10\x02\x12\x1c\n" +
+	"\x18SCORING_PLAY_ACTION_VOID\x10\x032\xfa\x15\n" +
+	"\vGameService\x12X\n" +
+	"\vGetAllTeams\x12\x19.proto.GetAllTeamsRequest\x1a\x1a.proto.GetAllTeamsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/teams\x12b\n" +
+	"\vGetTeamByID\x12\x19.proto.GetTeamByIDRequest\x1a\x1a.proto.GetTeamByIDResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/teams/{team_id}\x12\x8e\x01\n" +
+	"\x14GetTeamsByConference\x12\".proto.GetTeamsByConferenceRequest\x1a#.proto.GetTeamsByConferenceResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/teams/by-conference/{conference}\x12\x84\x01\n" +
+	"\x12GetTeamsByDivision\x12 .proto.GetTeamsByDivisionRequest\x1a!.proto.GetTeamsByDivisionResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/teams/by-division/{division}\x12X\n" +
+	"\vGetAllGames\x12\x19.proto.GetAllGamesRequest\x1a\x1a.proto.GetAllGamesResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/games\x12b\n" +
+	"\vGetGameByID\x12\x19.proto.GetGameByIDRequest\x1a\x1a.proto.GetGameByIDResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/games/{game_id}\x12p\n" +
+	"\x0eGetGamesByWeek\x12\x1c.proto.GetGamesByWeekRequest\x1a\x1d.proto.GetGamesByWeekResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/games/by-week/{week}\x12q\n" +
+	"\x0eGetGamesByTeam\x12\x1c.proto.GetGamesByTeamRequest\x1a\x1d.proto.GetGamesByTeamResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/teams/{team_id}/games\x12z\n" +
+	"\x10GetGamesByStatus\x12\x1e.proto.GetGamesByStatusRequest\x1a\x1f.proto.GetGamesByStatusResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/games/by-status/{status}\x12X\n" +
+	"\n" +
+	"CreateGame\x12\x18.proto.CreateGameRequest\x1a\x19.proto.CreateGameResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/api/games\x12w\n" +
+	"\x0fUpdateGameScore\x12\x1d.proto.UpdateGameScoreRequest\x1a\x1e.proto.UpdateGameScoreResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/games/{game_id}/score\x12{\n" +
+	"\x10UpdateGameStatus\x12\x1e.proto.UpdateGameStatusRequest\x1a\x1f.proto.UpdateGameStatusResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/api/games/{game_id}/status\x12\x8c\x01\n" +
+	"\x14GetGameStatusHistory\x12\".proto.GetGameStatusHistoryRequest\x1a#.proto.GetGameStatusHistoryResponse\"+\x82\xd3\xe4\x93\x02%\x12#/api/games/{game_id}/status-history\x12y\n" +
+	"\x0eRescheduleGame\x12\x1c.proto.RescheduleGameRequest\x1a\x1d.proto.RescheduleGameResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/games/{game_id}/reschedule\x12w\n" +
+	"\x0fUpdateGameLines\x12\x1d.proto.UpdateGameLinesRequest\x1a\x1e.proto.UpdateGameLinesResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/games/{game_id}/lines\x12k\n" +
+	"\x0eImportSchedule\x12\x1c.proto.ImportScheduleRequest\x1a\x1d.proto.ImportScheduleResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/games/import\x12_\n" +
+	"\fGetStandings\x12\x1a.proto.GetStandingsRequest\x1a\x1b.proto.GetStandingsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/standings\x12\x7f\n" +
+	"\x16GeneratePlayoffBracket\x12$.proto.GeneratePlayoffBracketRequest\x1a%.proto.GeneratePlayoffBracketResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/playoffs\x12m\n" +
+	"\x11GetPlayoffBracket\x12\x1f.proto.GetPlayoffBracketRequest\x1a .proto.GetPlayoffBracketResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/playoffs\x12}\n" +
+	"\x11RecordScoringPlay\x12\x1f.proto.RecordScoringPlayRequest\x1a .proto.RecordScoringPlayResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/games/{game_id}/plays\x12\x84\x01\n" +
+	"\x10AmendScoringPlay\x12\x1e.proto.AmendScoringPlayRequest\x1a\x1f.proto.AmendScoringPlayResponse\"/\x82\xd3\xe4\x93\x02):\x01*2$/api/games/{game_id}/plays/{play_id}\x12n\n" +
+	"\rGetPlayByPlay\x12\x1b.proto.GetPlayByPlayRequest\x1a\x1c.proto.GetPlayByPlayResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/games/{game_id}/plays\x12w\n" +
+	"\x0fUpdateGameClock\x12\x1d.proto.UpdateGameClockRequest\x1a\x1e.proto.UpdateGameClockResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/games/{game_id}/clock\x12;\n" +
 	"\n" +
-	"CreateGame\x12\x18.proto.CreateGameRequest\x1a\x19.proto.CreateGameResponse\x12P\n" +
-	"\x0fUpdateGameScore\x12\x1d.proto.UpdateGameScoreRequest\x1a\x1e.proto.UpdateGameScoreResponse\x12S\n" +
-	"\x10UpdateGameStatus\x12\x1e.proto.UpdateGameStatusRequest\x1a\x1f.proto.UpdateGameStatusResponseB\x19Z\x17kickoff.com/proto;protob\x06proto3"
+	"WatchGames\x12\x18.proto.WatchGamesRequest\x1a\x11.proto.GameUpdate0\x01\x129\n" +
+	"\tWatchGame\x12\x17.proto.WatchGameRequest\x1a\x11.proto.GameUpdate0\x01B\x19Z\x17kickoff.com/proto;protob\x06proto3"
 
 var (
 	file_proto_game_service_proto_rawDescOnce sync.Once
@@ -1790,96 +5216,217 @@ func file_proto_game_service_proto_rawDescGZIP() []byte {
 	return file_proto_game_service_proto_rawDescData
 }
 
-var file_proto_game_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_game_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_proto_game_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proto_game_service_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_proto_game_service_proto_goTypes = []any{
-	(Conference)(0),                      // 0: proto.Conference
-	(Division)(0),                        // 1: proto.Division
-	(GameStatus)(0),                      // 2: proto.GameStatus
-	(*Team)(nil),                         // 3: proto.Team
-	(*Game)(nil),                         // 4: proto.Game
-	(*GetAllTeamsRequest)(nil),           // 5: proto.GetAllTeamsRequest
-	(*GetAllTeamsResponse)(nil),          // 6: proto.GetAllTeamsResponse
-	(*GetTeamByIDRequest)(nil),           // 7: proto.GetTeamByIDRequest
-	(*GetTeamByIDResponse)(nil),          // 8: proto.GetTeamByIDResponse
-	(*GetTeamsByConferenceRequest)(nil),  // 9: proto.GetTeamsByConferenceRequest
-	(*GetTeamsByConferenceResponse)(nil), // 10: proto.GetTeamsByConferenceResponse
-	(*GetTeamsByDivisionRequest)(nil),    // 11: proto.GetTeamsByDivisionRequest
-	(*GetTeamsByDivisionResponse)(nil),   // 12: proto.GetTeamsByDivisionResponse
-	(*GetAllGamesRequest)(nil),           // 13: proto.GetAllGamesRequest
-	(*GetAllGamesResponse)(nil),          // 14: proto.GetAllGamesResponse
-	(*GetGameByIDRequest)(nil),           // 15: proto.GetGameByIDRequest
-	(*GetGameByIDResponse)(nil),          // 16: proto.GetGameByIDResponse
-	(*GetGamesByWeekRequest)(nil),        // 17: proto.GetGamesByWeekRequest
-	(*GetGamesByWeekResponse)(nil),       // 18: proto.GetGamesByWeekResponse
-	(*GetGamesByTeamRequest)(nil),        // 19: proto.GetGamesByTeamRequest
-	(*GetGamesByTeamResponse)(nil),       // 20: proto.GetGamesByTeamResponse
-	(*GetGamesByStatusRequest)(nil),      // 21: proto.GetGamesByStatusRequest
-	(*GetGamesByStatusResponse)(nil),     // 22: proto.GetGamesByStatusResponse
-	(*CreateGameRequest)(nil),            // 23: proto.CreateGameRequest
-	(*CreateGameResponse)(nil),           // 24: proto.CreateGameResponse
-	(*UpdateGameScoreRequest)(nil),       // 25: proto.UpdateGameScoreRequest
-	(*UpdateGameScoreResponse)(nil),      // 26: proto.UpdateGameScoreResponse
-	(*UpdateGameStatusRequest)(nil),      // 27: proto.UpdateGameStatusRequest
-	(*UpdateGameStatusResponse)(nil),     // 28: proto.UpdateGameStatusResponse
-	(*timestamppb.Timestamp)(nil),        // 29: google.protobuf.Timestamp
+	(Conference)(0),                        // 0: proto.Conference
+	(Division)(0),                          // 1: proto.Division
+	(GameStatus)(0),                        // 2: proto.GameStatus
+	(ReschedulePredictionPolicy)(0),        // 3: proto.ReschedulePredictionPolicy
+	(ScheduleFormat)(0),                    // 4: proto.ScheduleFormat
+	(PlayoffRound)(0),                      // 5: proto.PlayoffRound
+	(ScoringPlayType)(0),                   // 6: proto.ScoringPlayType
+	(ScoringPlayAction)(0),                 // 7: proto.ScoringPlayAction
+	(*Team)(nil),                           // 8: proto.Team
+	(*Game)(nil),                           // 9: proto.Game
+	(*ScoringPlay)(nil),                    // 10: proto.ScoringPlay
+	(*GameStatusChange)(nil),               // 11: proto.GameStatusChange
+	(*GetAllTeamsRequest)(nil),             // 12: proto.GetAllTeamsRequest
+	(*GetAllTeamsResponse)(nil),            // 13: proto.GetAllTeamsResponse
+	(*GetTeamByIDRequest)(nil),             // 14: proto.GetTeamByIDRequest
+	(*GetTeamByIDResponse)(nil),            // 15: proto.GetTeamByIDResponse
+	(*GetTeamsByConferenceRequest)(nil),    // 16: proto.GetTeamsByConferenceRequest
+	(*GetTeamsByConferenceResponse)(nil),   // 17: proto.GetTeamsByConferenceResponse
+	(*GetTeamsByDivisionRequest)(nil),      // 18: proto.GetTeamsByDivisionRequest
+	(*GetTeamsByDivisionResponse)(nil),     // 19: proto.GetTeamsByDivisionResponse
+	(*GetAllGamesRequest)(nil),             // 20: proto.GetAllGamesRequest
+	(*GetAllGamesResponse)(nil),            // 21: proto.GetAllGamesResponse
+	(*GetGameByIDRequest)(nil),             // 22: proto.GetGameByIDRequest
+	(*GetGameByIDResponse)(nil),            // 23: proto.GetGameByIDResponse
+	(*GetGamesByWeekRequest)(nil),          // 24: proto.GetGamesByWeekRequest
+	(*GetGamesByWeekResponse)(nil),         // 25: proto.GetGamesByWeekResponse
+	(*GetGamesByTeamRequest)(nil),          // 26: proto.GetGamesByTeamRequest
+	(*GetGamesByTeamResponse)(nil),         // 27: proto.GetGamesByTeamResponse
+	(*GetGamesByStatusRequest)(nil),        // 28: proto.GetGamesByStatusRequest
+	(*GetGamesByStatusResponse)(nil),       // 29: proto.GetGamesByStatusResponse
+	(*CreateGameRequest)(nil),              // 30: proto.CreateGameRequest
+	(*CreateGameResponse)(nil),             // 31: proto.CreateGameResponse
+	(*UpdateGameScoreRequest)(nil),         // 32: proto.UpdateGameScoreRequest
+	(*UpdateGameScoreResponse)(nil),        // 33: proto.UpdateGameScoreResponse
+	(*UpdateGameStatusRequest)(nil),        // 34: proto.UpdateGameStatusRequest
+	(*UpdateGameStatusResponse)(nil),       // 35: proto.UpdateGameStatusResponse
+	(*RescheduleGameRequest)(nil),          // 36: proto.RescheduleGameRequest
+	(*RescheduleGameResponse)(nil),         // 37: proto.RescheduleGameResponse
+	(*GetGameStatusHistoryRequest)(nil),    // 38: proto.GetGameStatusHistoryRequest
+	(*GetGameStatusHistoryResponse)(nil),   // 39: proto.GetGameStatusHistoryResponse
+	(*UpdateGameLinesRequest)(nil),         // 40: proto.UpdateGameLinesRequest
+	(*UpdateGameLinesResponse)(nil),        // 41: proto.UpdateGameLinesResponse
+	(*ImportScheduleRequest)(nil),          // 42: proto.ImportScheduleRequest
+	(*ScheduleFieldChange)(nil),            // 43: proto.ScheduleFieldChange
+	(*ScheduleChange)(nil),                 // 44: proto.ScheduleChange
+	(*ScheduleIssue)(nil),                  // 45: proto.ScheduleIssue
+	(*ImportScheduleResponse)(nil),         // 46: proto.ImportScheduleResponse
+	(*GetStandingsRequest)(nil),            // 47: proto.GetStandingsRequest
+	(*Record)(nil),                         // 48: proto.Record
+	(*TeamStanding)(nil),                   // 49: proto.TeamStanding
+	(*GetStandingsResponse)(nil),           // 50: proto.GetStandingsResponse
+	(*PlayoffSeed)(nil),                    // 51: proto.PlayoffSeed
+	(*PlayoffGame)(nil),                    // 52: proto.PlayoffGame
+	(*PlayoffBracket)(nil),                 // 53: proto.PlayoffBracket
+	(*GeneratePlayoffBracketRequest)(nil),  // 54: proto.GeneratePlayoffBracketRequest
+	(*GeneratePlayoffBracketResponse)(nil), // 55: proto.GeneratePlayoffBracketResponse
+	(*GetPlayoffBracketRequest)(nil),       // 56: proto.GetPlayoffBracketRequest
+	(*GetPlayoffBracketResponse)(nil),      // 57: proto.GetPlayoffBracketResponse
+	(*RecordScoringPlayRequest)(nil),       // 58: proto.RecordScoringPlayRequest
+	(*RecordScoringPlayResponse)(nil),      // 59: proto.RecordScoringPlayResponse
+	(*AmendScoringPlayRequest)(nil),        // 60: proto.AmendScoringPlayRequest
+	(*AmendScoringPlayResponse)(nil),       // 61: proto.AmendScoringPlayResponse
+	(*GetPlayByPlayRequest)(nil),           // 62: proto.GetPlayByPlayRequest
+	(*GetPlayByPlayResponse)(nil),          // 63: proto.GetPlayByPlayResponse
+	(*UpdateGameClockRequest)(nil),         // 64: proto.UpdateGameClockRequest
+	(*UpdateGameClockResponse)(nil),        // 65: proto.UpdateGameClockResponse
+	(*WatchGamesRequest)(nil),              // 66: proto.WatchGamesRequest
+	(*WatchGameRequest)(nil),               // 67: proto.WatchGameRequest
+	(*GameUpdate)(nil),                     // 68: proto.GameUpdate
+	(*timestamppb.Timestamp)(nil),          // 69: google.protobuf.Timestamp
 }
 var file_proto_game_service_proto_depIdxs = []int32{
-	0,  // 0: proto.Team.conference:type_name -> proto.Conference
-	1,  // 1: proto.Team.division:type_name -> proto.Division
-	2,  // 2: proto.Game.status:type_name -> proto.GameStatus
-	29, // 3: proto.Game.scheduled_at:type_name -> google.protobuf.Timestamp
-	29, // 4: proto.Game.started_at:type_name -> google.protobuf.Timestamp
-	29, // 5: proto.Game.completed_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: proto.GetAllTeamsResponse.teams:type_name -> proto.Team
-	3,  // 7: proto.GetTeamByIDResponse.team:type_name -> proto.Team
-	0,  // 8: proto.GetTeamsByConferenceRequest.conference:type_name -> proto.Conference
-	3,  // 9: proto.GetTeamsByConferenceResponse.teams:type_name -> proto.Team
-	0,  // 10: proto.GetTeamsByConferenceResponse.conference:type_name -> proto.Conference
-	1,  // 11: proto.GetTeamsByDivisionRequest.division:type_name -> proto.Division
-	3,  // 12: proto.GetTeamsByDivisionResponse.teams:type_name -> proto.Team
-	1,  // 13: proto.GetTeamsByDivisionResponse.division:type_name -> proto.Division
-	4,  // 14: proto.GetAllGamesResponse.games:type_name -> proto.Game
-	4,  // 15: proto.GetGameByIDResponse.game:type_name -> proto.Game
-	4,  // 16: proto.GetGamesByWeekResponse.games:type_name -> proto.Game
-	4,  // 17: proto.GetGamesByTeamResponse.games:type_name -> proto.Game
-	2,  // 18: proto.GetGamesByStatusRequest.status:type_name -> proto.GameStatus
-	4,  // 19: proto.GetGamesByStatusResponse.games:type_name -> proto.Game
-	2,  // 20: proto.GetGamesByStatusResponse.status:type_name -> proto.GameStatus
-	29, // 21: proto.CreateGameRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	4,  // 22: proto.CreateGameResponse.game:type_name -> proto.Game
-	4,  // 23: proto.UpdateGameScoreResponse.game:type_name -> proto.Game
-	2,  // 24: proto.UpdateGameStatusRequest.status:type_name -> proto.GameStatus
-	4,  // 25: proto.UpdateGameStatusResponse.game:type_name -> proto.Game
-	5,  // 26: proto.GameService.GetAllTeams:input_type -> proto.GetAllTeamsRequest
-	7,  // 27: proto.GameService.GetTeamByID:input_type -> proto.GetTeamByIDRequest
-	9,  // 28: proto.GameService.GetTeamsByConference:input_type -> proto.GetTeamsByConferenceRequest
-	11, // 29: proto.GameService.GetTeamsByDivision:input_type -> proto.GetTeamsByDivisionRequest
-	13, // 30: proto.GameService.GetAllGames:input_type -> proto.GetAllGamesRequest
-	15, // 31: proto.GameService.GetGameByID:input_type -> proto.GetGameByIDRequest
-	17, // 32: proto.GameService.GetGamesByWeek:input_type -> proto.GetGamesByWeekRequest
-	19, // 33: proto.GameService.GetGamesByTeam:input_type -> proto.GetGamesByTeamRequest
-	21, // 34: proto.GameService.GetGamesByStatus:input_type -> proto.GetGamesByStatusRequest
-	23, // 35: proto.GameService.CreateGame:input_type -> proto.CreateGameRequest
-	25, // 36: proto.GameService.UpdateGameScore:input_type -> proto.UpdateGameScoreRequest
-	27, // 37: proto.GameService.UpdateGameStatus:input_type -> proto.UpdateGameStatusRequest
-	6,  // 38: proto.GameService.GetAllTeams:output_type -> proto.GetAllTeamsResponse
-	8,  // 39: proto.GameService.GetTeamByID:output_type -> proto.GetTeamByIDResponse
-	10, // 40: proto.GameService.GetTeamsByConference:output_type -> proto.GetTeamsByConferenceResponse
-	12, // 41: proto.GameService.GetTeamsByDivision:output_type -> proto.GetTeamsByDivisionResponse
-	14, // 42: proto.GameService.GetAllGames:output_type -> proto.GetAllGamesResponse
-	16, // 43: proto.GameService.GetGameByID:output_type -> proto.GetGameByIDResponse
-	18, // 44: proto.GameService.GetGamesByWeek:output_type -> proto.GetGamesByWeekResponse
-	20, // 45: proto.GameService.GetGamesByTeam:output_type -> proto.GetGamesByTeamResponse
-	22, // 46: proto.GameService.GetGamesByStatus:output_type -> proto.GetGamesByStatusResponse
-	24, // 47: proto.GameService.CreateGame:output_type -> proto.CreateGameResponse
-	26, // 48: proto.GameService.UpdateGameScore:output_type -> proto.UpdateGameScoreResponse
-	28, // 49: proto.GameService.UpdateGameStatus:output_type -> proto.UpdateGameStatusResponse
-	38, // [38:50] is the sub-list for method output_type
-	26, // [26:38] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	0,   // 0: proto.Team.conference:type_name -> proto.Conference
+	1,   // 1: proto.Team.division:type_name -> proto.Division
+	2,   // 2: proto.Game.status:type_name -> proto.GameStatus
+	69,  // 3: proto.Game.scheduled_at:type_name -> google.protobuf.Timestamp
+	69,  // 4: proto.Game.started_at:type_name -> google.protobuf.Timestamp
+	69,  // 5: proto.Game.completed_at:type_name -> google.protobuf.Timestamp
+	69,  // 6: proto.Game.lines_lock_at:type_name -> google.protobuf.Timestamp
+	7,   // 7: proto.ScoringPlay.action:type_name -> proto.ScoringPlayAction
+	6,   // 8: proto.ScoringPlay.type:type_name -> proto.ScoringPlayType
+	69,  // 9: proto.ScoringPlay.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 10: proto.GameStatusChange.from_status:type_name -> proto.GameStatus
+	2,   // 11: proto.GameStatusChange.to_status:type_name -> proto.GameStatus
+	69,  // 12: proto.GameStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	0,   // 13: proto.GetAllTeamsRequest.conference:type_name -> proto.Conference
+	1,   // 14: proto.GetAllTeamsRequest.division:type_name -> proto.Division
+	8,   // 15: proto.GetAllTeamsResponse.teams:type_name -> proto.Team
+	8,   // 16: proto.GetTeamByIDResponse.team:type_name -> proto.Team
+	0,   // 17: proto.GetTeamsByConferenceRequest.conference:type_name -> proto.Conference
+	8,   // 18: proto.GetTeamsByConferenceResponse.teams:type_name -> proto.Team
+	0,   // 19: proto.GetTeamsByConferenceResponse.conference:type_name -> proto.Conference
+	1,   // 20: proto.GetTeamsByDivisionRequest.division:type_name -> proto.Division
+	8,   // 21: proto.GetTeamsByDivisionResponse.teams:type_name -> proto.Team
+	1,   // 22: proto.GetTeamsByDivisionResponse.division:type_name -> proto.Division
+	2,   // 23: proto.GetAllGamesRequest.status:type_name -> proto.GameStatus
+	69,  // 24: proto.GetAllGamesRequest.scheduled_after:type_name -> google.protobuf.Timestamp
+	69,  // 25: proto.GetAllGamesRequest.scheduled_before:type_name -> google.protobuf.Timestamp
+	9,   // 26: proto.GetAllGamesResponse.games:type_name -> proto.Game
+	9,   // 27: proto.GetGameByIDResponse.game:type_name -> proto.Game
+	9,   // 28: proto.GetGamesByWeekResponse.games:type_name -> proto.Game
+	9,   // 29: proto.GetGamesByTeamResponse.games:type_name -> proto.Game
+	2,   // 30: proto.GetGamesByStatusRequest.status:type_name -> proto.GameStatus
+	9,   // 31: proto.GetGamesByStatusResponse.games:type_name -> proto.Game
+	2,   // 32: proto.GetGamesByStatusResponse.status:type_name -> proto.GameStatus
+	69,  // 33: proto.CreateGameRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	9,   // 34: proto.CreateGameResponse.game:type_name -> proto.Game
+	9,   // 35: proto.UpdateGameScoreResponse.game:type_name -> proto.Game
+	2,   // 36: proto.UpdateGameStatusRequest.status:type_name -> proto.GameStatus
+	9,   // 37: proto.UpdateGameStatusResponse.game:type_name -> proto.Game
+	11,  // 38: proto.UpdateGameStatusResponse.change:type_name -> proto.GameStatusChange
+	69,  // 39: proto.RescheduleGameRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	3,   // 40: proto.RescheduleGameRequest.prediction_policy:type_name -> proto.ReschedulePredictionPolicy
+	9,   // 41: proto.RescheduleGameResponse.game:type_name -> proto.Game
+	3,   // 42: proto.RescheduleGameResponse.prediction_policy:type_name -> proto.ReschedulePredictionPolicy
+	11,  // 43: proto.RescheduleGameResponse.change:type_name -> proto.GameStatusChange
+	9,   // 44: proto.GetGameStatusHistoryResponse.game:type_name -> proto.Game
+	11,  // 45: proto.GetGameStatusHistoryResponse.changes:type_name -> proto.GameStatusChange
+	69,  // 46: proto.UpdateGameLinesRequest.lines_lock_at:type_name -> google.protobuf.Timestamp
+	9,   // 47: proto.UpdateGameLinesResponse.game:type_name -> proto.Game
+	4,   // 48: proto.ImportScheduleRequest.format:type_name -> proto.ScheduleFormat
+	69,  // 49: proto.ScheduleChange.scheduled_at:type_name -> google.protobuf.Timestamp
+	43,  // 50: proto.ScheduleChange.changes:type_name -> proto.ScheduleFieldChange
+	44,  // 51: proto.ImportScheduleResponse.changes:type_name -> proto.ScheduleChange
+	45,  // 52: proto.ImportScheduleResponse.issues:type_name -> proto.ScheduleIssue
+	0,   // 53: proto.GetStandingsRequest.conference:type_name -> proto.Conference
+	1,   // 54: proto.GetStandingsRequest.division:type_name -> proto.Division
+	0,   // 55: proto.TeamStanding.conference:type_name -> proto.Conference
+	1,   // 56: proto.TeamStanding.division:type_name -> proto.Division
+	48,  // 57: proto.TeamStanding.division_record:type_name -> proto.Record
+	48,  // 58: proto.TeamStanding.conference_record:type_name -> proto.Record
+	48,  // 59: proto.TeamStanding.home_record:type_name -> proto.Record
+	48,  // 60: proto.TeamStanding.away_record:type_name -> proto.Record
+	49,  // 61: proto.GetStandingsResponse.standings:type_name -> proto.TeamStanding
+	0,   // 62: proto.PlayoffSeed.conference:type_name -> proto.Conference
+	5,   // 63: proto.PlayoffGame.round:type_name -> proto.PlayoffRound
+	0,   // 64: proto.PlayoffGame.conference:type_name -> proto.Conference
+	9,   // 65: proto.PlayoffGame.game:type_name -> proto.Game
+	51,  // 66: proto.PlayoffBracket.seeds:type_name -> proto.PlayoffSeed
+	52,  // 67: proto.PlayoffBracket.games:type_name -> proto.PlayoffGame
+	69,  // 68: proto.GeneratePlayoffBracketRequest.wild_card_start:type_name -> google.protobuf.Timestamp
+	53,  // 69: proto.GeneratePlayoffBracketResponse.bracket:type_name -> proto.PlayoffBracket
+	53,  // 70: proto.GetPlayoffBracketResponse.bracket:type_name -> proto.PlayoffBracket
+	6,   // 71: proto.RecordScoringPlayRequest.type:type_name -> proto.ScoringPlayType
+	10,  // 72: proto.RecordScoringPlayResponse.play:type_name -> proto.ScoringPlay
+	9,   // 73: proto.RecordScoringPlayResponse.game:type_name -> proto.Game
+	6,   // 74: proto.AmendScoringPlayRequest.type:type_name -> proto.ScoringPlayType
+	10,  // 75: proto.AmendScoringPlayResponse.play:type_name -> proto.ScoringPlay
+	9,   // 76: proto.AmendScoringPlayResponse.game:type_name -> proto.Game
+	9,   // 77: proto.GetPlayByPlayResponse.game:type_name -> proto.Game
+	10,  // 78: proto.GetPlayByPlayResponse.plays:type_name -> proto.ScoringPlay
+	9,   // 79: proto.UpdateGameClockResponse.game:type_name -> proto.Game
+	9,   // 80: proto.GameUpdate.game:type_name -> proto.Game
+	12,  // 81: proto.GameService.GetAllTeams:input_type -> proto.GetAllTeamsRequest
+	14,  // 82: proto.GameService.GetTeamByID:input_type -> proto.GetTeamByIDRequest
+	16,  // 83: proto.GameService.GetTeamsByConference:input_type -> proto.GetTeamsByConferenceRequest
+	18,  // 84: proto.GameService.GetTeamsByDivision:input_type -> proto.GetTeamsByDivisionRequest
+	20,  // 85: proto.GameService.GetAllGames:input_type -> proto.GetAllGamesRequest
+	22,  // 86: proto.GameService.GetGameByID:input_type -> proto.GetGameByIDRequest
+	24,  // 87: proto.GameService.GetGamesByWeek:input_type -> proto.GetGamesByWeekRequest
+	26,  // 88: proto.GameService.GetGamesByTeam:input_type -> proto.GetGamesByTeamRequest
+	28,  // 89: proto.GameService.GetGamesByStatus:input_type -> proto.GetGamesByStatusRequest
+	30,  // 90: proto.GameService.CreateGame:input_type -> proto.CreateGameRequest
+	32,  // 91: proto.GameService.UpdateGameScore:input_type -> proto.UpdateGameScoreRequest
+	34,  // 92: proto.GameService.UpdateGameStatus:input_type -> proto.UpdateGameStatusRequest
+	38,  // 93: proto.GameService.GetGameStatusHistory:input_type -> proto.GetGameStatusHistoryRequest
+	36,  // 94: proto.GameService.RescheduleGame:input_type -> proto.RescheduleGameRequest
+	40,  // 95: proto.GameService.UpdateGameLines:input_type -> proto.UpdateGameLinesRequest
+	42,  // 96: proto.GameService.ImportSchedule:input_type -> proto.ImportScheduleRequest
+	47,  // 97: proto.GameService.GetStandings:input_type -> proto.GetStandingsRequest
+	54,  // 98: proto.GameService.GeneratePlayoffBracket:input_type -> proto.GeneratePlayoffBracketRequest
+	56,  // 99: proto.GameService.GetPlayoffBracket:input_type -> proto.GetPlayoffBracketRequest
+	58,  // 100: proto.GameService.RecordScoringPlay:input_type -> proto.RecordScoringPlayRequest
+	60,  // 101: proto.GameService.AmendScoringPlay:input_type -> proto.AmendScoringPlayRequest
+	62,  // 102: proto.GameService.GetPlayByPlay:input_type -> proto.GetPlayByPlayRequest
+	64,  // 103: proto.GameService.UpdateGameClock:input_type -> proto.UpdateGameClockRequest
+	66,  // 104: proto.GameService.WatchGames:input_type -> proto.WatchGamesRequest
+	67,  // 105: proto.GameService.WatchGame:input_type -> proto.WatchGameRequest
+	13,  // 106: proto.GameService.GetAllTeams:output_type -> proto.GetAllTeamsResponse
+	15,  // 107: proto.GameService.GetTeamByID:output_type -> proto.GetTeamByIDResponse
+	17,  // 108: proto.GameService.GetTeamsByConference:output_type -> proto.GetTeamsByConferenceResponse
+	19,  // 109: proto.GameService.GetTeamsByDivision:output_type -> proto.GetTeamsByDivisionResponse
+	21,  // 110: proto.GameService.GetAllGames:output_type -> proto.GetAllGamesResponse
+	23,  // 111: proto.GameService.GetGameByID:output_type -> proto.GetGameByIDResponse
+	25,  // 112: proto.GameService.GetGamesByWeek:output_type -> proto.GetGamesByWeekResponse
+	27,  // 113: proto.GameService.GetGamesByTeam:output_type -> proto.GetGamesByTeamResponse
+	29,  // 114: proto.GameService.GetGamesByStatus:output_type -> proto.GetGamesByStatusResponse
+	31,  // 115: proto.GameService.CreateGame:output_type -> proto.CreateGameResponse
+	33,  // 116: proto.GameService.UpdateGameScore:output_type -> proto.UpdateGameScoreResponse
+	35,  // 117: proto.GameService.UpdateGameStatus:output_type -> proto.UpdateGameStatusResponse
+	39,  // 118: proto.GameService.GetGameStatusHistory:output_type -> proto.GetGameStatusHistoryResponse
+	37,  // 119: proto.GameService.RescheduleGame:output_type -> proto.RescheduleGameResponse
+	41,  // 120: proto.GameService.UpdateGameLines:output_type -> proto.UpdateGameLinesResponse
+	46,  // 121: proto.GameService.ImportSchedule:output_type -> proto.ImportScheduleResponse
+	50,  // 122: proto.GameService.GetStandings:output_type -> proto.GetStandingsResponse
+	55,  // 123: proto.GameService.GeneratePlayoffBracket:output_type -> proto.GeneratePlayoffBracketResponse
+	57,  // 124: proto.GameService.GetPlayoffBracket:output_type -> proto.GetPlayoffBracketResponse
+	59,  // 125: proto.GameService.RecordScoringPlay:output_type -> proto.RecordScoringPlayResponse
+	61,  // 126: proto.GameService.AmendScoringPlay:output_type -> proto.AmendScoringPlayResponse
+	63,  // 127: proto.GameService.GetPlayByPlay:output_type -> proto.GetPlayByPlayResponse
+	65,  // 128: proto.GameService.UpdateGameClock:output_type -> proto.UpdateGameClockResponse
+	68,  // 129: proto.GameService.WatchGames:output_type -> proto.GameUpdate
+	68,  // 130: proto.GameService.WatchGame:output_type -> proto.GameUpdate
+	106, // [106:131] is the sub-list for method output_type
+	81,  // [81:106] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_proto_game_service_proto_init() }
@@ -1887,13 +5434,15 @@ func file_proto_game_service_proto_init() {
 	if File_proto_game_service_proto != nil {
 		return
 	}
+	file_proto_game_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_game_service_proto_msgTypes[32].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_game_service_proto_rawDesc), len(file_proto_game_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   26,
+			NumEnums:      8,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,18 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GameService_GetAllTeams_FullMethodName          = "/proto.GameService/GetAllTeams"
-	GameService_GetTeamByID_FullMethodName          = "/proto.GameService/GetTeamByID"
-	GameService_GetTeamsByConference_FullMethodName = "/proto.GameService/GetTeamsByConference"
-	GameService_GetTeamsByDivision_FullMethodName   = "/proto.GameService/GetTeamsByDivision"
-	GameService_GetAllGames_FullMethodName          = "/proto.GameService/GetAllGames"
-	GameService_GetGameByID_FullMethodName          = "/proto.GameService/GetGameByID"
-	GameService_GetGamesByWeek_FullMethodName       = "/proto.GameService/GetGamesByWeek"
-	GameService_GetGamesByTeam_FullMethodName       = "/proto.GameService/GetGamesByTeam"
-	GameService_GetGamesByStatus_FullMethodName     = "/proto.GameService/GetGamesByStatus"
-	GameService_CreateGame_FullMethodName           = "/proto.GameService/CreateGame"
-	GameService_UpdateGameScore_FullMethodName      = "/proto.GameService/UpdateGameScore"
-	GameService_UpdateGameStatus_FullMethodName     = "/proto.GameService/UpdateGameStatus"
+	GameService_GetAllTeams_FullMethodName            = "/proto.GameService/GetAllTeams"
+	GameService_GetTeamByID_FullMethodName            = "/proto.GameService/GetTeamByID"
+	GameService_GetTeamsByConference_FullMethodName   = "/proto.GameService/GetTeamsByConference"
+	GameService_GetTeamsByDivision_FullMethodName     = "/proto.GameService/GetTeamsByDivision"
+	GameService_GetAllGames_FullMethodName            = "/proto.GameService/GetAllGames"
+	GameService_GetGameByID_FullMethodName            = "/proto.GameService/GetGameByID"
+	GameService_GetGamesByWeek_FullMethodName         = "/proto.GameService/GetGamesByWeek"
+	GameService_GetGamesByTeam_FullMethodName         = "/proto.GameService/GetGamesByTeam"
+	GameService_GetGamesByStatus_FullMethodName       = "/proto.GameService/GetGamesByStatus"
+	GameService_CreateGame_FullMethodName             = "/proto.GameService/CreateGame"
+	GameService_UpdateGameScore_FullMethodName        = "/proto.GameService/UpdateGameScore"
+	GameService_UpdateGameStatus_FullMethodName       = "/proto.GameService/UpdateGameStatus"
+	GameService_GetGameStatusHistory_FullMethodName   = "/proto.GameService/GetGameStatusHistory"
+	GameService_RescheduleGame_FullMethodName         = "/proto.GameService/RescheduleGame"
+	GameService_UpdateGameLines_FullMethodName        = "/proto.GameService/UpdateGameLines"
+	GameService_ImportSchedule_FullMethodName         = "/proto.GameService/ImportSchedule"
+	GameService_GetStandings_FullMethodName           = "/proto.GameService/GetStandings"
+	GameService_GeneratePlayoffBracket_FullMethodName = "/proto.GameService/GeneratePlayoffBracket"
+	GameService_GetPlayoffBracket_FullMethodName      = "/proto.GameService/GetPlayoffBracket"
+	GameService_RecordScoringPlay_FullMethodName      = "/proto.GameService/RecordScoringPlay"
+	GameService_AmendScoringPlay_FullMethodName       = "/proto.GameService/AmendScoringPlay"
+	GameService_GetPlayByPlay_FullMethodName          = "/proto.GameService/GetPlayByPlay"
+	GameService_UpdateGameClock_FullMethodName        = "/proto.GameService/UpdateGameClock"
+	GameService_WatchGames_FullMethodName             = "/proto.GameService/WatchGames"
+	GameService_WatchGame_FullMethodName              = "/proto.GameService/WatchGame"
 )
 
 // GameServiceClient is the client API for GameService service.
@@ -52,6 +65,23 @@ type GameServiceClient interface {
 	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
 	UpdateGameScore(ctx context.Context, in *UpdateGameScoreRequest, opts ...grpc.CallOption) (*UpdateGameScoreResponse, error)
 	UpdateGameStatus(ctx context.Context, in *UpdateGameStatusRequest, opts ...grpc.CallOption) (*UpdateGameStatusResponse, error)
+	GetGameStatusHistory(ctx context.Context, in *GetGameStatusHistoryRequest, opts ...grpc.CallOption) (*GetGameStatusHistoryResponse, error)
+	RescheduleGame(ctx context.Context, in *RescheduleGameRequest, opts ...grpc.CallOption) (*RescheduleGameResponse, error)
+	UpdateGameLines(ctx context.Context, in *UpdateGameLinesRequest, opts ...grpc.CallOption) (*UpdateGameLinesResponse, error)
+	ImportSchedule(ctx context.Context, in *ImportScheduleRequest, opts ...grpc.CallOption) (*ImportScheduleResponse, error)
+	// Standings
+	GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error)
+	// Playoffs
+	GeneratePlayoffBracket(ctx context.Context, in *GeneratePlayoffBracketRequest, opts ...grpc.CallOption) (*GeneratePlayoffBracketResponse, error)
+	GetPlayoffBracket(ctx context.Context, in *GetPlayoffBracketRequest, opts ...grpc.CallOption) (*GetPlayoffBracketResponse, error)
+	// Play-by-play
+	RecordScoringPlay(ctx context.Context, in *RecordScoringPlayRequest, opts ...grpc.CallOption) (*RecordScoringPlayResponse, error)
+	AmendScoringPlay(ctx context.Context, in *AmendScoringPlayRequest, opts ...grpc.CallOption) (*AmendScoringPlayResponse, error)
+	GetPlayByPlay(ctx context.Context, in *GetPlayByPlayRequest, opts ...grpc.CallOption) (*GetPlayByPlayResponse, error)
+	UpdateGameClock(ctx context.Context, in *UpdateGameClockRequest, opts ...grpc.CallOption) (*UpdateGameClockResponse, error)
+	// Live updates (server streaming)
+	WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameUpdate], error)
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameUpdate], error)
 }
 
 type gameServiceClient struct {
@@ -182,6 +212,154 @@ func (c *gameServiceClient) UpdateGameStatus(ctx context.Context, in *UpdateGame
 	return out, nil
 }
 
+func (c *gameServiceClient) GetGameStatusHistory(ctx context.Context, in *GetGameStatusHistoryRequest, opts ...grpc.CallOption) (*GetGameStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, GameService_GetGameStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RescheduleGame(ctx context.Context, in *RescheduleGameRequest, opts ...grpc.CallOption) (*RescheduleGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleGameResponse)
+	err := c.cc.Invoke(ctx, GameService_RescheduleGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateGameLines(ctx context.Context, in *UpdateGameLinesRequest, opts ...grpc.CallOption) (*UpdateGameLinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGameLinesResponse)
+	err := c.cc.Invoke(ctx, GameService_UpdateGameLines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ImportSchedule(ctx context.Context, in *ImportScheduleRequest, opts ...grpc.CallOption) (*ImportScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportScheduleResponse)
+	err := c.cc.Invoke(ctx, GameService_ImportSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetStandings(ctx context.Context, in *GetStandingsRequest, opts ...grpc.CallOption) (*GetStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStandingsResponse)
+	err := c.cc.Invoke(ctx, GameService_GetStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GeneratePlayoffBracket(ctx context.Context, in *GeneratePlayoffBracketRequest, opts ...grpc.CallOption) (*GeneratePlayoffBracketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePlayoffBracketResponse)
+	err := c.cc.Invoke(ctx, GameService_GeneratePlayoffBracket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetPlayoffBracket(ctx context.Context, in *GetPlayoffBracketRequest, opts ...grpc.CallOption) (*GetPlayoffBracketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayoffBracketResponse)
+	err := c.cc.Invoke(ctx, GameService_GetPlayoffBracket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RecordScoringPlay(ctx context.Context, in *RecordScoringPlayRequest, opts ...grpc.CallOption) (*RecordScoringPlayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordScoringPlayResponse)
+	err := c.cc.Invoke(ctx, GameService_RecordScoringPlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AmendScoringPlay(ctx context.Context, in *AmendScoringPlayRequest, opts ...grpc.CallOption) (*AmendScoringPlayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmendScoringPlayResponse)
+	err := c.cc.Invoke(ctx, GameService_AmendScoringPlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetPlayByPlay(ctx context.Context, in *GetPlayByPlayRequest, opts ...grpc.CallOption) (*GetPlayByPlayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlayByPlayResponse)
+	err := c.cc.Invoke(ctx, GameService_GetPlayByPlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateGameClock(ctx context.Context, in *UpdateGameClockRequest, opts ...grpc.CallOption) (*UpdateGameClockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGameClockResponse)
+	err := c.cc.Invoke(ctx, GameService_UpdateGameClock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[0], GameService_WatchGames_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGamesRequest, GameUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGamesClient = grpc.ServerStreamingClient[GameUpdate]
+
+func (c *gameServiceClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GameUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[1], GameService_WatchGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGameRequest, GameUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGameClient = grpc.ServerStreamingClient[GameUpdate]
+
 // GameServiceServer is the server API for GameService service.
 // All implementations must embed UnimplementedGameServiceServer
 // for forward compatibility.
@@ -201,6 +379,23 @@ type GameServiceServer interface {
 	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
 	UpdateGameScore(context.Context, *UpdateGameScoreRequest) (*UpdateGameScoreResponse, error)
 	UpdateGameStatus(context.Context, *UpdateGameStatusRequest) (*UpdateGameStatusResponse, error)
+	GetGameStatusHistory(context.Context, *GetGameStatusHistoryRequest) (*GetGameStatusHistoryResponse, error)
+	RescheduleGame(context.Context, *RescheduleGameRequest) (*RescheduleGameResponse, error)
+	UpdateGameLines(context.Context, *UpdateGameLinesRequest) (*UpdateGameLinesResponse, error)
+	ImportSchedule(context.Context, *ImportScheduleRequest) (*ImportScheduleResponse, error)
+	// Standings
+	GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error)
+	// Playoffs
+	GeneratePlayoffBracket(context.Context, *GeneratePlayoffBracketRequest) (*GeneratePlayoffBracketResponse, error)
+	GetPlayoffBracket(context.Context, *GetPlayoffBracketRequest) (*GetPlayoffBracketResponse, error)
+	// Play-by-play
+	RecordScoringPlay(context.Context, *RecordScoringPlayRequest) (*RecordScoringPlayResponse, error)
+	AmendScoringPlay(context.Context, *AmendScoringPlayRequest) (*AmendScoringPlayResponse, error)
+	GetPlayByPlay(context.Context, *GetPlayByPlayRequest) (*GetPlayByPlayResponse, error)
+	UpdateGameClock(context.Context, *UpdateGameClockRequest) (*UpdateGameClockResponse, error)
+	// Live updates (server streaming)
+	WatchGames(*WatchGamesRequest, grpc.ServerStreamingServer[GameUpdate]) error
+	WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameUpdate]) error
 	mustEmbedUnimplementedGameServiceServer()
 }
 
@@ -247,6 +442,45 @@ func (UnimplementedGameServiceServer) UpdateGameScore(context.Context, *UpdateGa
 func (UnimplementedGameServiceServer) UpdateGameStatus(context.Context, *UpdateGameStatusRequest) (*UpdateGameStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameStatus not implemented")
 }
+func (UnimplementedGameServiceServer) GetGameStatusHistory(context.Context, *GetGameStatusHistoryRequest) (*GetGameStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameStatusHistory not implemented")
+}
+func (UnimplementedGameServiceServer) RescheduleGame(context.Context, *RescheduleGameRequest) (*RescheduleGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleGame not implemented")
+}
+func (UnimplementedGameServiceServer) UpdateGameLines(context.Context, *UpdateGameLinesRequest) (*UpdateGameLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameLines not implemented")
+}
+func (UnimplementedGameServiceServer) ImportSchedule(context.Context, *ImportScheduleRequest) (*ImportScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSchedule not implemented")
+}
+func (UnimplementedGameServiceServer) GetStandings(context.Context, *GetStandingsRequest) (*GetStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandings not implemented")
+}
+func (UnimplementedGameServiceServer) GeneratePlayoffBracket(context.Context, *GeneratePlayoffBracketRequest) (*GeneratePlayoffBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePlayoffBracket not implemented")
+}
+func (UnimplementedGameServiceServer) GetPlayoffBracket(context.Context, *GetPlayoffBracketRequest) (*GetPlayoffBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayoffBracket not implemented")
+}
+func (UnimplementedGameServiceServer) RecordScoringPlay(context.Context, *RecordScoringPlayRequest) (*RecordScoringPlayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordScoringPlay not implemented")
+}
+func (UnimplementedGameServiceServer) AmendScoringPlay(context.Context, *AmendScoringPlayRequest) (*AmendScoringPlayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendScoringPlay not implemented")
+}
+func (UnimplementedGameServiceServer) GetPlayByPlay(context.Context, *GetPlayByPlayRequest) (*GetPlayByPlayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayByPlay not implemented")
+}
+func (UnimplementedGameServiceServer) UpdateGameClock(context.Context, *UpdateGameClockRequest) (*UpdateGameClockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGameClock not implemented")
+}
+func (UnimplementedGameServiceServer) WatchGames(*WatchGamesRequest, grpc.ServerStreamingServer[GameUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGames not implemented")
+}
+func (UnimplementedGameServiceServer) WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[GameUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
 func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
 func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
 
@@ -484,6 +718,226 @@ func _GameService_UpdateGameStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_GetGameStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGameStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGameStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGameStatusHistory(ctx, req.(*GetGameStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RescheduleGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RescheduleGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RescheduleGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RescheduleGame(ctx, req.(*RescheduleGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateGameLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateGameLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_UpdateGameLines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateGameLines(ctx, req.(*UpdateGameLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ImportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ImportSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ImportSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ImportSchedule(ctx, req.(*ImportScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetStandings(ctx, req.(*GetStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GeneratePlayoffBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePlayoffBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GeneratePlayoffBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GeneratePlayoffBracket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GeneratePlayoffBracket(ctx, req.(*GeneratePlayoffBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetPlayoffBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayoffBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetPlayoffBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetPlayoffBracket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetPlayoffBracket(ctx, req.(*GetPlayoffBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RecordScoringPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordScoringPlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RecordScoringPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RecordScoringPlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RecordScoringPlay(ctx, req.(*RecordScoringPlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AmendScoringPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendScoringPlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AmendScoringPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AmendScoringPlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AmendScoringPlay(ctx, req.(*AmendScoringPlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetPlayByPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayByPlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetPlayByPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetPlayByPlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetPlayByPlay(ctx, req.(*GetPlayByPlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateGameClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGameClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateGameClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_UpdateGameClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateGameClock(ctx, req.(*UpdateGameClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_WatchGames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchGames(m, &grpc.GenericServerStream[WatchGamesRequest, GameUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGamesServer = grpc.ServerStreamingServer[GameUpdate]
+
+func _GameService_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchGame(m, &grpc.GenericServerStream[WatchGameRequest, GameUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchGameServer = grpc.ServerStreamingServer[GameUpdate]
+
 // GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -539,7 +993,62 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGameStatus",
 			Handler:    _GameService_UpdateGameStatus_Handler,
 		},
+		{
+			MethodName: "GetGameStatusHistory",
+			Handler:    _GameService_GetGameStatusHistory_Handler,
+		},
+		{
+			MethodName: "RescheduleGame",
+			Handler:    _GameService_RescheduleGame_Handler,
+		},
+		{
+			MethodName: "UpdateGameLines",
+			Handler:    _GameService_UpdateGameLines_Handler,
+		},
+		{
+			MethodName: "ImportSchedule",
+			Handler:    _GameService_ImportSchedule_Handler,
+		},
+		{
+			MethodName: "GetStandings",
+			Handler:    _GameService_GetStandings_Handler,
+		},
+		{
+			MethodName: "GeneratePlayoffBracket",
+			Handler:    _GameService_GeneratePlayoffBracket_Handler,
+		},
+		{
+			MethodName: "GetPlayoffBracket",
+			Handler:    _GameService_GetPlayoffBracket_Handler,
+		},
+		{
+			MethodName: "RecordScoringPlay",
+			Handler:    _GameService_RecordScoringPlay_Handler,
+		},
+		{
+			MethodName: "AmendScoringPlay",
+			Handler:    _GameService_AmendScoringPlay_Handler,
+		},
+		{
+			MethodName: "GetPlayByPlay",
+			Handler:    _GameService_GetPlayByPlay_Handler,
+		},
+		{
+			MethodName: "UpdateGameClock",
+			Handler:    _GameService_UpdateGameClock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGames",
+			Handler:       _GameService_WatchGames_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchGame",
+			Handler:       _GameService_WatchGame_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/game_service.proto",
 }
@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LeaderboardUpdateType int32
+
+const (
+	LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_UNSPECIFIED       LeaderboardUpdateType = 0
+	LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_PREDICTION_GRADED LeaderboardUpdateType = 1
+	LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_RANK_CHANGED      LeaderboardUpdateType = 2
+)
+
+// Enum value maps for LeaderboardUpdateType.
+var (
+	LeaderboardUpdateType_name = map[int32]string{
+		0: "LEADERBOARD_UPDATE_TYPE_UNSPECIFIED",
+		1: "LEADERBOARD_UPDATE_TYPE_PREDICTION_GRADED",
+		2: "LEADERBOARD_UPDATE_TYPE_RANK_CHANGED",
+	}
+	LeaderboardUpdateType_value = map[string]int32{
+		"LEADERBOARD_UPDATE_TYPE_UNSPECIFIED":       0,
+		"LEADERBOARD_UPDATE_TYPE_PREDICTION_GRADED": 1,
+		"LEADERBOARD_UPDATE_TYPE_RANK_CHANGED":      2,
+	}
+)
+
+func (x LeaderboardUpdateType) Enum() *LeaderboardUpdateType {
+	p := new(LeaderboardUpdateType)
+	*p = x
+	return p
+}
+
+func (x LeaderboardUpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardUpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_leaderboard_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LeaderboardUpdateType) Type() protoreflect.EnumType {
+	return &file_proto_leaderboard_service_proto_enumTypes[0]
+}
+
+func (x LeaderboardUpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardUpdateType.Descriptor instead.
+func (LeaderboardUpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{0}
+}
+
 type UserScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -29,6 +79,12 @@ type UserScore struct {
 	TotalPicks    int32                  `protobuf:"varint,3,opt,name=total_picks,json=totalPicks,proto3" json:"total_picks,omitempty"`
 	Percentage    float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	Rank          int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	Points        int32                  `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`
+	Season        int32                  `protobuf:"varint,7,opt,name=season,proto3" json:"season,omitempty"`                  // 0 = all-time
+	Week          int32                  `protobuf:"varint,8,opt,name=week,proto3" json:"week,omitempty"`                      // 0 = whole season
+	AtsWins       int32                  `protobuf:"varint,9,opt,name=ats_wins,json=atsWins,proto3" json:"ats_wins,omitempty"` // Against-the-spread record
+	AtsLosses     int32                  `protobuf:"varint,10,opt,name=ats_losses,json=atsLosses,proto3" json:"ats_losses,omitempty"`
+	AtsPushes     int32                  `protobuf:"varint,11,opt,name=ats_pushes,json=atsPushes,proto3" json:"ats_pushes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +154,48 @@ func (x *UserScore) GetRank() int32 {
 	return 0
 }
 
+func (x *UserScore) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *UserScore) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *UserScore) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *UserScore) GetAtsWins() int32 {
+	if x != nil {
+		return x.AtsWins
+	}
+	return 0
+}
+
+func (x *UserScore) GetAtsLosses() int32 {
+	if x != nil {
+		return x.AtsLosses
+	}
+	return 0
+}
+
+func (x *UserScore) GetAtsPushes() int32 {
+	if x != nil {
+		return x.AtsPushes
+	}
+	return 0
+}
+
 type PredictionDetail struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	GameId          string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
@@ -182,29 +280,35 @@ func (x *PredictionDetail) GetGameStatus() string {
 	return ""
 }
 
-// GetLeaderboard
-type GetLeaderboardRequest struct {
+type GradedPrediction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`   // Optional: limit number of results
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Optional: pagination offset
+	PredictionId  string                 `protobuf:"bytes,1,opt,name=prediction_id,json=predictionId,proto3" json:"prediction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Result        string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"` // "pending", "correct", "incorrect", "void"
+	Points        int32                  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	Season        int32                  `protobuf:"varint,6,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,7,opt,name=week,proto3" json:"week,omitempty"`
+	AgainstSpread bool                   `protobuf:"varint,8,opt,name=against_spread,json=againstSpread,proto3" json:"against_spread,omitempty"`
+	Push          bool                   `protobuf:"varint,9,opt,name=push,proto3" json:"push,omitempty"` // ATS pick voided because the final margin landed on the spread
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeaderboardRequest) Reset() {
-	*x = GetLeaderboardRequest{}
+func (x *GradedPrediction) Reset() {
+	*x = GradedPrediction{}
 	mi := &file_proto_leaderboard_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeaderboardRequest) String() string {
+func (x *GradedPrediction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaderboardRequest) ProtoMessage() {}
+func (*GradedPrediction) ProtoMessage() {}
 
-func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+func (x *GradedPrediction) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_leaderboard_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -216,48 +320,99 @@ func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
-func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GradedPrediction.ProtoReflect.Descriptor instead.
+func (*GradedPrediction) Descriptor() ([]byte, []int) {
 	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetLeaderboardRequest) GetLimit() int32 {
+func (x *GradedPrediction) GetPredictionId() string {
 	if x != nil {
-		return x.Limit
+		return x.PredictionId
+	}
+	return ""
+}
+
+func (x *GradedPrediction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GradedPrediction) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GradedPrediction) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *GradedPrediction) GetPoints() int32 {
+	if x != nil {
+		return x.Points
 	}
 	return 0
 }
 
-func (x *GetLeaderboardRequest) GetOffset() int32 {
+func (x *GradedPrediction) GetSeason() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Season
 	}
 	return 0
 }
 
-type GetLeaderboardResponse struct {
+func (x *GradedPrediction) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GradedPrediction) GetAgainstSpread() bool {
+	if x != nil {
+		return x.AgainstSpread
+	}
+	return false
+}
+
+func (x *GradedPrediction) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+type League struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Leaderboard   []*UserScore           `protobuf:"bytes,1,rep,name=leaderboard,proto3" json:"leaderboard,omitempty"`
-	TotalUsers    int32                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
-	GamesFinished int32                  `protobuf:"varint,3,opt,name=games_finished,json=gamesFinished,proto3" json:"games_finished,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	MemberCount   int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeaderboardResponse) Reset() {
-	*x = GetLeaderboardResponse{}
+func (x *League) Reset() {
+	*x = League{}
 	mi := &file_proto_leaderboard_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeaderboardResponse) String() string {
+func (x *League) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeaderboardResponse) ProtoMessage() {}
+func (*League) ProtoMessage() {}
 
-func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+func (x *League) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_leaderboard_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -269,54 +424,69 @@ func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
-func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use League.ProtoReflect.Descriptor instead.
+func (*League) Descriptor() ([]byte, []int) {
 	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetLeaderboardResponse) GetLeaderboard() []*UserScore {
+func (x *League) GetId() string {
 	if x != nil {
-		return x.Leaderboard
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetLeaderboardResponse) GetTotalUsers() int32 {
+func (x *League) GetName() string {
 	if x != nil {
-		return x.TotalUsers
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLeaderboardResponse) GetGamesFinished() int32 {
+func (x *League) GetOwnerId() string {
 	if x != nil {
-		return x.GamesFinished
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *League) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
 	}
 	return 0
 }
 
-// GetUserStats
-type GetUserStatsRequest struct {
+func (x *League) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LeagueMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // "admin", "member"
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserStatsRequest) Reset() {
-	*x = GetUserStatsRequest{}
+func (x *LeagueMember) Reset() {
+	*x = LeagueMember{}
 	mi := &file_proto_leaderboard_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserStatsRequest) String() string {
+func (x *LeagueMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserStatsRequest) ProtoMessage() {}
+func (*LeagueMember) ProtoMessage() {}
 
-func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *LeagueMember) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_leaderboard_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,41 +498,59 @@ func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeagueMember.ProtoReflect.Descriptor instead.
+func (*LeagueMember) Descriptor() ([]byte, []int) {
 	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetUserStatsRequest) GetUserId() string {
+func (x *LeagueMember) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetUserStatsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserStats        *UserScore             `protobuf:"bytes,1,opt,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
-	Predictions      []*PredictionDetail    `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	TotalPredictions int32                  `protobuf:"varint,3,opt,name=total_predictions,json=totalPredictions,proto3" json:"total_predictions,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *LeagueMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
 }
 
-func (x *GetUserStatsResponse) Reset() {
-	*x = GetUserStatsResponse{}
+func (x *LeagueMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type LeagueInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	LeagueId      string                 `protobuf:"bytes,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 = unlimited
+	Uses          int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset = never expires
+	Revoked       bool                   `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueInvite) Reset() {
+	*x = LeagueInvite{}
 	mi := &file_proto_leaderboard_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserStatsResponse) String() string {
+func (x *LeagueInvite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserStatsResponse) ProtoMessage() {}
+func (*LeagueInvite) ProtoMessage() {}
 
-func (x *GetUserStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *LeagueInvite) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_leaderboard_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,54 +562,86 @@ func (x *GetUserStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeagueInvite.ProtoReflect.Descriptor instead.
+func (*LeagueInvite) Descriptor() ([]byte, []int) {
 	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetUserStatsResponse) GetUserStats() *UserScore {
+func (x *LeagueInvite) GetCode() string {
 	if x != nil {
-		return x.UserStats
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserStatsResponse) GetPredictions() []*PredictionDetail {
+func (x *LeagueInvite) GetLeagueId() string {
 	if x != nil {
-		return x.Predictions
+		return x.LeagueId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserStatsResponse) GetTotalPredictions() int32 {
+func (x *LeagueInvite) GetCreatedBy() string {
 	if x != nil {
-		return x.TotalPredictions
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *LeagueInvite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
 	}
 	return 0
 }
 
-// GetTopUsers
-type GetTopUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopN          int32                  `protobuf:"varint,1,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"` // Get top N users (e.g., top 10)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LeagueInvite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
 }
 
-func (x *GetTopUsersRequest) Reset() {
-	*x = GetTopUsersRequest{}
+func (x *LeagueInvite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LeagueInvite) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type SurvivorStanding struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season         int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Alive          bool                   `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`
+	EliminatedWeek int32                  `protobuf:"varint,4,opt,name=eliminated_week,json=eliminatedWeek,proto3" json:"eliminated_week,omitempty"` // 0 while alive
+	WeeksSurvived  int32                  `protobuf:"varint,5,opt,name=weeks_survived,json=weeksSurvived,proto3" json:"weeks_survived,omitempty"`
+	Rank           int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SurvivorStanding) Reset() {
+	*x = SurvivorStanding{}
 	mi := &file_proto_leaderboard_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopUsersRequest) String() string {
+func (x *SurvivorStanding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopUsersRequest) ProtoMessage() {}
+func (*SurvivorStanding) ProtoMessage() {}
 
-func (x *GetTopUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *SurvivorStanding) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_leaderboard_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,40 +653,80 @@ func (x *GetTopUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetTopUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SurvivorStanding.ProtoReflect.Descriptor instead.
+func (*SurvivorStanding) Descriptor() ([]byte, []int) {
 	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetTopUsersRequest) GetTopN() int32 {
+func (x *SurvivorStanding) GetUserId() string {
 	if x != nil {
-		return x.TopN
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SurvivorStanding) GetSeason() int32 {
+	if x != nil {
+		return x.Season
 	}
 	return 0
 }
 
-type GetTopUsersResponse struct {
+func (x *SurvivorStanding) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *SurvivorStanding) GetEliminatedWeek() int32 {
+	if x != nil {
+		return x.EliminatedWeek
+	}
+	return 0
+}
+
+func (x *SurvivorStanding) GetWeeksSurvived() int32 {
+	if x != nil {
+		return x.WeeksSurvived
+	}
+	return 0
+}
+
+func (x *SurvivorStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+type BracketStanding struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopUsers      []*UserScore           `protobuf:"bytes,1,rep,name=top_users,json=topUsers,proto3" json:"top_users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Points        int32                  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Correct       int32                  `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	Incorrect     int32                  `protobuf:"varint,5,opt,name=incorrect,proto3" json:"incorrect,omitempty"`
+	MaxPoints     int32                  `protobuf:"varint,6,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"` // Points still reachable with the pending picks
+	Rank          int32                  `protobuf:"varint,7,opt,name=rank,proto3" json:"rank,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopUsersResponse) Reset() {
-	*x = GetTopUsersResponse{}
+func (x *BracketStanding) Reset() {
+	*x = BracketStanding{}
 	mi := &file_proto_leaderboard_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopUsersResponse) String() string {
+func (x *BracketStanding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopUsersResponse) ProtoMessage() {}
+func (*BracketStanding) ProtoMessage() {}
 
-func (x *GetTopUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *BracketStanding) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_leaderboard_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,48 +738,2368 @@ func (x *GetTopUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetTopUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BracketStanding.ProtoReflect.Descriptor instead.
+func (*BracketStanding) Descriptor() ([]byte, []int) {
 	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTopUsersResponse) GetTopUsers() []*UserScore {
+func (x *BracketStanding) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BracketStanding) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *BracketStanding) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *BracketStanding) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *BracketStanding) GetIncorrect() int32 {
+	if x != nil {
+		return x.Incorrect
+	}
+	return 0
+}
+
+func (x *BracketStanding) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *BracketStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// GetLeaderboard
+type GetLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Use page_size (limit is used when page_size is 0)
+	// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+	Offset        int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                       // Only 0: use page_token
+	Season        int32  `protobuf:"varint,3,opt,name=season,proto3" json:"season,omitempty"`                       // Optional: season standings (0 = all-time)
+	Week          int32  `protobuf:"varint,4,opt,name=week,proto3" json:"week,omitempty"`                           // Optional: weekly standings, requires season
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{8}
+}
+
+// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+func (x *GetLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leaderboard   []*UserScore           `protobuf:"bytes,1,rep,name=leaderboard,proto3" json:"leaderboard,omitempty"`
+	TotalUsers    int32                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	GamesFinished int32                  `protobuf:"varint,3,opt,name=games_finished,json=gamesFinished,proto3" json:"games_finished,omitempty"`
+	GamesTotal    int32                  `protobuf:"varint,4,opt,name=games_total,json=gamesTotal,proto3" json:"games_total,omitempty"`
+	Season        int32                  `protobuf:"varint,5,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,6,opt,name=week,proto3" json:"week,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetLeaderboardResponse) GetLeaderboard() []*UserScore {
+	if x != nil {
+		return x.Leaderboard
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetGamesFinished() int32 {
+	if x != nil {
+		return x.GamesFinished
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetGamesTotal() int32 {
+	if x != nil {
+		return x.GamesTotal
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetUserStats
+type GetUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsRequest) Reset() {
+	*x = GetUserStatsRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsRequest) ProtoMessage() {}
+
+func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserStatsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserStats        *UserScore             `protobuf:"bytes,1,opt,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
+	Predictions      []*PredictionDetail    `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	TotalPredictions int32                  `protobuf:"varint,3,opt,name=total_predictions,json=totalPredictions,proto3" json:"total_predictions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUserStatsResponse) Reset() {
+	*x = GetUserStatsResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsResponse) ProtoMessage() {}
+
+func (x *GetUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserStatsResponse) GetUserStats() *UserScore {
+	if x != nil {
+		return x.UserStats
+	}
+	return nil
+}
+
+func (x *GetUserStatsResponse) GetPredictions() []*PredictionDetail {
+	if x != nil {
+		return x.Predictions
+	}
+	return nil
+}
+
+func (x *GetUserStatsResponse) GetTotalPredictions() int32 {
+	if x != nil {
+		return x.TotalPredictions
+	}
+	return 0
+}
+
+// GetTopUsers
+type GetTopUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopN          int32                  `protobuf:"varint,1,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"` // Get top N users (e.g., top 10)
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopUsersRequest) Reset() {
+	*x = GetTopUsersRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopUsersRequest) ProtoMessage() {}
+
+func (x *GetTopUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetTopUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTopUsersRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *GetTopUsersRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetTopUsersRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+type GetTopUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopUsers      []*UserScore           `protobuf:"bytes,1,rep,name=top_users,json=topUsers,proto3" json:"top_users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopUsersResponse) Reset() {
+	*x = GetTopUsersResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopUsersResponse) ProtoMessage() {}
+
+func (x *GetTopUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetTopUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTopUsersResponse) GetTopUsers() []*UserScore {
+	if x != nil {
+		return x.TopUsers
+	}
+	return nil
+}
+
+func (x *GetTopUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// GetUserRank
+type GetUserRankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRankRequest) Reset() {
+	*x = GetUserRankRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRankRequest) ProtoMessage() {}
+
+func (x *GetUserRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRankRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRankRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetUserRankRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserRankRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetUserRankRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+type GetUserRankResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserScore     *UserScore             `protobuf:"bytes,1,opt,name=user_score,json=userScore,proto3" json:"user_score,omitempty"`
+	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	TotalUsers    int32                  `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRankResponse) Reset() {
+	*x = GetUserRankResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRankResponse) ProtoMessage() {}
+
+func (x *GetUserRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRankResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRankResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserRankResponse) GetUserScore() *UserScore {
+	if x != nil {
+		return x.UserScore
+	}
+	return nil
+}
+
+func (x *GetUserRankResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetUserRankResponse) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+// GetUserWeeklyStats
+type GetUserWeeklyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserWeeklyStatsRequest) Reset() {
+	*x = GetUserWeeklyStatsRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserWeeklyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserWeeklyStatsRequest) ProtoMessage() {}
+
+func (x *GetUserWeeklyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserWeeklyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserWeeklyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserWeeklyStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserWeeklyStatsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+type GetUserWeeklyStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Weeks         []*UserScore           `protobuf:"bytes,3,rep,name=weeks,proto3" json:"weeks,omitempty"` // One entry per week with graded picks, rank within that week
+	SeasonTotals  *UserScore             `protobuf:"bytes,4,opt,name=season_totals,json=seasonTotals,proto3" json:"season_totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserWeeklyStatsResponse) Reset() {
+	*x = GetUserWeeklyStatsResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserWeeklyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserWeeklyStatsResponse) ProtoMessage() {}
+
+func (x *GetUserWeeklyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserWeeklyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserWeeklyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserWeeklyStatsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserWeeklyStatsResponse) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetUserWeeklyStatsResponse) GetWeeks() []*UserScore {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+func (x *GetUserWeeklyStatsResponse) GetSeasonTotals() *UserScore {
+	if x != nil {
+		return x.SeasonTotals
+	}
+	return nil
+}
+
+// RecalculateLeaderboard (Admin operation)
+type RecalculateLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalculateLeaderboardRequest) Reset() {
+	*x = RecalculateLeaderboardRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateLeaderboardRequest) ProtoMessage() {}
+
+func (x *RecalculateLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*RecalculateLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{18}
+}
+
+type RecalculateLeaderboardResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	UsersProcessed int32                  `protobuf:"varint,2,opt,name=users_processed,json=usersProcessed,proto3" json:"users_processed,omitempty"`
+	GamesEvaluated int32                  `protobuf:"varint,3,opt,name=games_evaluated,json=gamesEvaluated,proto3" json:"games_evaluated,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecalculateLeaderboardResponse) Reset() {
+	*x = RecalculateLeaderboardResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalculateLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalculateLeaderboardResponse) ProtoMessage() {}
+
+func (x *RecalculateLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalculateLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*RecalculateLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecalculateLeaderboardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecalculateLeaderboardResponse) GetUsersProcessed() int32 {
+	if x != nil {
+		return x.UsersProcessed
+	}
+	return 0
+}
+
+func (x *RecalculateLeaderboardResponse) GetGamesEvaluated() int32 {
+	if x != nil {
+		return x.GamesEvaluated
+	}
+	return 0
+}
+
+// ApplyPredictionResults (admin - manual repair; results normally arrive as PredictionGraded events)
+type ApplyPredictionResultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*GradedPrediction    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPredictionResultsRequest) Reset() {
+	*x = ApplyPredictionResultsRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPredictionResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPredictionResultsRequest) ProtoMessage() {}
+
+func (x *ApplyPredictionResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPredictionResultsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPredictionResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApplyPredictionResultsRequest) GetResults() []*GradedPrediction {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ApplyPredictionResultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       int32                  `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	UsersUpdated  int32                  `protobuf:"varint,2,opt,name=users_updated,json=usersUpdated,proto3" json:"users_updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyPredictionResultsResponse) Reset() {
+	*x = ApplyPredictionResultsResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPredictionResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPredictionResultsResponse) ProtoMessage() {}
+
+func (x *ApplyPredictionResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPredictionResultsResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPredictionResultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApplyPredictionResultsResponse) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *ApplyPredictionResultsResponse) GetUsersUpdated() int32 {
+	if x != nil {
+		return x.UsersUpdated
+	}
+	return 0
+}
+
+// CreateLeague
+type CreateLeagueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Owner, becomes the first admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLeagueRequest) Reset() {
+	*x = CreateLeagueRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeagueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeagueRequest) ProtoMessage() {}
+
+func (x *CreateLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeagueRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateLeagueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLeagueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateLeagueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	League        *League                `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLeagueResponse) Reset() {
+	*x = CreateLeagueResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeagueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeagueResponse) ProtoMessage() {}
+
+func (x *CreateLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeagueResponse.ProtoReflect.Descriptor instead.
+func (*CreateLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateLeagueResponse) GetLeague() *League {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+func (x *CreateLeagueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetLeague
+type GetLeagueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId      string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueRequest) Reset() {
+	*x = GetLeagueRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueRequest) ProtoMessage() {}
+
+func (x *GetLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetLeagueRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+type GetLeagueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	League        *League                `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+	Members       []*LeagueMember        `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueResponse) Reset() {
+	*x = GetLeagueResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueResponse) ProtoMessage() {}
+
+func (x *GetLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLeagueResponse) GetLeague() *League {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+func (x *GetLeagueResponse) GetMembers() []*LeagueMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// GetUserLeagues
+type GetUserLeaguesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLeaguesRequest) Reset() {
+	*x = GetUserLeaguesRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLeaguesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLeaguesRequest) ProtoMessage() {}
+
+func (x *GetUserLeaguesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLeaguesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLeaguesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetUserLeaguesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserLeaguesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserLeaguesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetUserLeaguesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leagues       []*League              `protobuf:"bytes,1,rep,name=leagues,proto3" json:"leagues,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // Across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLeaguesResponse) Reset() {
+	*x = GetUserLeaguesResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLeaguesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLeaguesResponse) ProtoMessage() {}
+
+func (x *GetUserLeaguesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLeaguesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLeaguesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUserLeaguesResponse) GetLeagues() []*League {
+	if x != nil {
+		return x.Leagues
+	}
+	return nil
+}
+
+func (x *GetUserLeaguesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUserLeaguesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateLeagueInvite (league admins only)
+type CreateLeagueInviteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId       string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`                        // 0 = unlimited
+	ExpiresInHours int32                  `protobuf:"varint,4,opt,name=expires_in_hours,json=expiresInHours,proto3" json:"expires_in_hours,omitempty"` // 0 = never expires
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateLeagueInviteRequest) Reset() {
+	*x = CreateLeagueInviteRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeagueInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeagueInviteRequest) ProtoMessage() {}
+
+func (x *CreateLeagueInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeagueInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeagueInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateLeagueInviteRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *CreateLeagueInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateLeagueInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateLeagueInviteRequest) GetExpiresInHours() int32 {
+	if x != nil {
+		return x.ExpiresInHours
+	}
+	return 0
+}
+
+type CreateLeagueInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *LeagueInvite          `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLeagueInviteResponse) Reset() {
+	*x = CreateLeagueInviteResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeagueInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeagueInviteResponse) ProtoMessage() {}
+
+func (x *CreateLeagueInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeagueInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateLeagueInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateLeagueInviteResponse) GetInvite() *LeagueInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+// GetLeagueInvites (league admins only)
+type GetLeagueInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId      string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueInvitesRequest) Reset() {
+	*x = GetLeagueInvitesRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueInvitesRequest) ProtoMessage() {}
+
+func (x *GetLeagueInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetLeagueInvitesRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *GetLeagueInvitesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLeagueInvitesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLeagueInvitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetLeagueInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*LeagueInvite        `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // Across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueInvitesResponse) Reset() {
+	*x = GetLeagueInvitesResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueInvitesResponse) ProtoMessage() {}
+
+func (x *GetLeagueInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLeagueInvitesResponse) GetInvites() []*LeagueInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *GetLeagueInvitesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetLeagueInvitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RevokeLeagueInvite (league admins only)
+type RevokeLeagueInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LeagueId      string                 `protobuf:"bytes,3,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"` // Optional: the invite must belong to this league
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLeagueInviteRequest) Reset() {
+	*x = RevokeLeagueInviteRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeagueInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeagueInviteRequest) ProtoMessage() {}
+
+func (x *RevokeLeagueInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLeagueInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLeagueInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevokeLeagueInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RevokeLeagueInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeLeagueInviteRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+type RevokeLeagueInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeLeagueInviteResponse) Reset() {
+	*x = RevokeLeagueInviteResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeagueInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeagueInviteResponse) ProtoMessage() {}
+
+func (x *RevokeLeagueInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLeagueInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLeagueInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeLeagueInviteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeLeagueInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// JoinLeague
+type JoinLeagueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinLeagueRequest) Reset() {
+	*x = JoinLeagueRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinLeagueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinLeagueRequest) ProtoMessage() {}
+
+func (x *JoinLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinLeagueRequest.ProtoReflect.Descriptor instead.
+func (*JoinLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JoinLeagueRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *JoinLeagueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type JoinLeagueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	League        *League                `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinLeagueResponse) Reset() {
+	*x = JoinLeagueResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinLeagueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinLeagueResponse) ProtoMessage() {}
+
+func (x *JoinLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinLeagueResponse.ProtoReflect.Descriptor instead.
+func (*JoinLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *JoinLeagueResponse) GetLeague() *League {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+func (x *JoinLeagueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// LeaveLeague
+type LeaveLeagueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId      string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveLeagueRequest) Reset() {
+	*x = LeaveLeagueRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveLeagueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveLeagueRequest) ProtoMessage() {}
+
+func (x *LeaveLeagueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveLeagueRequest.ProtoReflect.Descriptor instead.
+func (*LeaveLeagueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LeaveLeagueRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *LeaveLeagueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveLeagueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveLeagueResponse) Reset() {
+	*x = LeaveLeagueResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveLeagueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveLeagueResponse) ProtoMessage() {}
+
+func (x *LeaveLeagueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveLeagueResponse.ProtoReflect.Descriptor instead.
+func (*LeaveLeagueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LeaveLeagueResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LeaveLeagueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateLeagueMember (league admins only): promote or demote a member
+type UpdateLeagueMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId      string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	ActingUserId  string                 `protobuf:"bytes,2,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"`
+	MemberUserId  string                 `protobuf:"bytes,3,opt,name=member_user_id,json=memberUserId,proto3" json:"member_user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "admin", "member"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLeagueMemberRequest) Reset() {
+	*x = UpdateLeagueMemberRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLeagueMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLeagueMemberRequest) ProtoMessage() {}
+
+func (x *UpdateLeagueMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLeagueMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeagueMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateLeagueMemberRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *UpdateLeagueMemberRequest) GetActingUserId() string {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return ""
+}
+
+func (x *UpdateLeagueMemberRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+func (x *UpdateLeagueMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateLeagueMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *LeagueMember          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLeagueMemberResponse) Reset() {
+	*x = UpdateLeagueMemberResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLeagueMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLeagueMemberResponse) ProtoMessage() {}
+
+func (x *UpdateLeagueMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLeagueMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLeagueMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateLeagueMemberResponse) GetMember() *LeagueMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *UpdateLeagueMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RemoveLeagueMember (league admins only)
+type RemoveLeagueMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId      string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	ActingUserId  string                 `protobuf:"bytes,2,opt,name=acting_user_id,json=actingUserId,proto3" json:"acting_user_id,omitempty"`
+	MemberUserId  string                 `protobuf:"bytes,3,opt,name=member_user_id,json=memberUserId,proto3" json:"member_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLeagueMemberRequest) Reset() {
+	*x = RemoveLeagueMemberRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLeagueMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLeagueMemberRequest) ProtoMessage() {}
+
+func (x *RemoveLeagueMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLeagueMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLeagueMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RemoveLeagueMemberRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *RemoveLeagueMemberRequest) GetActingUserId() string {
+	if x != nil {
+		return x.ActingUserId
+	}
+	return ""
+}
+
+func (x *RemoveLeagueMemberRequest) GetMemberUserId() string {
+	if x != nil {
+		return x.MemberUserId
+	}
+	return ""
+}
+
+type RemoveLeagueMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLeagueMemberResponse) Reset() {
+	*x = RemoveLeagueMemberResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLeagueMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLeagueMemberResponse) ProtoMessage() {}
+
+func (x *RemoveLeagueMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLeagueMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLeagueMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveLeagueMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveLeagueMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetLeagueLeaderboard: standings of the league members, same scopes as GetLeaderboard
+type GetLeagueLeaderboardRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	Season   int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"` // 0 = all seasons
+	Week     int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`     // Requires season
+	// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"` // Use page_size (limit is used when page_size is 0)
+	// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+	Offset        int32  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                       // Only 0: use page_token
+	PageSize      int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueLeaderboardRequest) Reset() {
+	*x = GetLeagueLeaderboardRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeagueLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLeagueLeaderboardRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *GetLeagueLeaderboardRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetLeagueLeaderboardRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+func (x *GetLeagueLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in proto/leaderboard_service.proto.
+func (x *GetLeagueLeaderboardRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetLeagueLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLeagueLeaderboardRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetLeagueLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	League        *League                `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+	Leaderboard   []*UserScore           `protobuf:"bytes,2,rep,name=leaderboard,proto3" json:"leaderboard,omitempty"`
+	TotalUsers    int32                  `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	Season        int32                  `protobuf:"varint,4,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,5,opt,name=week,proto3" json:"week,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueLeaderboardResponse) Reset() {
+	*x = GetLeagueLeaderboardResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeagueLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeagueLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetLeagueLeaderboardResponse) GetLeague() *League {
+	if x != nil {
+		return x.League
+	}
+	return nil
+}
+
+func (x *GetLeagueLeaderboardResponse) GetLeaderboard() []*UserScore {
+	if x != nil {
+		return x.Leaderboard
+	}
+	return nil
+}
+
+func (x *GetLeagueLeaderboardResponse) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+func (x *GetLeagueLeaderboardResponse) GetSeason() int32 {
 	if x != nil {
-		return x.TopUsers
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetLeagueLeaderboardResponse) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *GetLeagueLeaderboardResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetSurvivorStandings: alive entrants first, then by how long they lasted
+type GetSurvivorStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	LeagueId      string                 `protobuf:"bytes,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`    // Optional: only members of this league
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSurvivorStandingsRequest) Reset() {
+	*x = GetSurvivorStandingsRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSurvivorStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurvivorStandingsRequest) ProtoMessage() {}
+
+func (x *GetSurvivorStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurvivorStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSurvivorStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetSurvivorStandingsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetSurvivorStandingsRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *GetSurvivorStandingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSurvivorStandingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetSurvivorStandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*SurvivorStanding    `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Alive         int32                  `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`                                       // Across all pages
+	Eliminated    int32                  `protobuf:"varint,4,opt,name=eliminated,proto3" json:"eliminated,omitempty"`                             // Across all pages
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSurvivorStandingsResponse) Reset() {
+	*x = GetSurvivorStandingsResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSurvivorStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurvivorStandingsResponse) ProtoMessage() {}
+
+func (x *GetSurvivorStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurvivorStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSurvivorStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetSurvivorStandingsResponse) GetStandings() []*SurvivorStanding {
+	if x != nil {
+		return x.Standings
 	}
 	return nil
 }
 
-func (x *GetTopUsersResponse) GetTotal() int32 {
+func (x *GetSurvivorStandingsResponse) GetSeason() int32 {
 	if x != nil {
-		return x.Total
+		return x.Season
 	}
 	return 0
 }
 
-// GetUserRank
-type GetUserRankRequest struct {
+func (x *GetSurvivorStandingsResponse) GetAlive() int32 {
+	if x != nil {
+		return x.Alive
+	}
+	return 0
+}
+
+func (x *GetSurvivorStandingsResponse) GetEliminated() int32 {
+	if x != nil {
+		return x.Eliminated
+	}
+	return 0
+}
+
+func (x *GetSurvivorStandingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetBracketStandingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	LeagueId      string                 `protobuf:"bytes,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`    // Optional: only members of this league
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRankRequest) Reset() {
-	*x = GetUserRankRequest{}
-	mi := &file_proto_leaderboard_service_proto_msgTypes[8]
+func (x *GetBracketStandingsRequest) Reset() {
+	*x = GetBracketStandingsRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRankRequest) String() string {
+func (x *GetBracketStandingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRankRequest) ProtoMessage() {}
+func (*GetBracketStandingsRequest) ProtoMessage() {}
 
-func (x *GetUserRankRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_leaderboard_service_proto_msgTypes[8]
+func (x *GetBracketStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,42 +3110,64 @@ func (x *GetUserRankRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRankRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRankRequest) Descriptor() ([]byte, []int) {
-	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetBracketStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBracketStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetUserRankRequest) GetUserId() string {
+func (x *GetBracketStandingsRequest) GetSeason() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetBracketStandingsRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
 	}
 	return ""
 }
 
-type GetUserRankResponse struct {
+func (x *GetBracketStandingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetBracketStandingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetBracketStandingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserScore     *UserScore             `protobuf:"bytes,1,opt,name=user_score,json=userScore,proto3" json:"user_score,omitempty"`
-	Rank          int32                  `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
-	TotalUsers    int32                  `protobuf:"varint,3,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	Standings     []*BracketStanding     `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                                       // Across all pages
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRankResponse) Reset() {
-	*x = GetUserRankResponse{}
-	mi := &file_proto_leaderboard_service_proto_msgTypes[9]
+func (x *GetBracketStandingsResponse) Reset() {
+	*x = GetBracketStandingsResponse{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRankResponse) String() string {
+func (x *GetBracketStandingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRankResponse) ProtoMessage() {}
+func (*GetBracketStandingsResponse) ProtoMessage() {}
 
-func (x *GetUserRankResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_leaderboard_service_proto_msgTypes[9]
+func (x *GetBracketStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,54 +3178,62 @@ func (x *GetUserRankResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRankResponse.ProtoReflect.Descriptor instead.
-func (*GetUserRankResponse) Descriptor() ([]byte, []int) {
-	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetBracketStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetBracketStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetUserRankResponse) GetUserScore() *UserScore {
+func (x *GetBracketStandingsResponse) GetStandings() []*BracketStanding {
 	if x != nil {
-		return x.UserScore
+		return x.Standings
 	}
 	return nil
 }
 
-func (x *GetUserRankResponse) GetRank() int32 {
+func (x *GetBracketStandingsResponse) GetSeason() int32 {
 	if x != nil {
-		return x.Rank
+		return x.Season
 	}
 	return 0
 }
 
-func (x *GetUserRankResponse) GetTotalUsers() int32 {
+func (x *GetBracketStandingsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.TotalUsers
+		return x.Total
 	}
 	return 0
 }
 
-// RecalculateLeaderboard (Admin operation)
-type RecalculateLeaderboardRequest struct {
+func (x *GetBracketStandingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Live updates
+type WatchLeaderboardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	SinceVersion  int64                  `protobuf:"varint,1,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"` // 0 = start with the current ranks; otherwise only updates after this version
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecalculateLeaderboardRequest) Reset() {
-	*x = RecalculateLeaderboardRequest{}
-	mi := &file_proto_leaderboard_service_proto_msgTypes[10]
+func (x *WatchLeaderboardRequest) Reset() {
+	*x = WatchLeaderboardRequest{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecalculateLeaderboardRequest) String() string {
+func (x *WatchLeaderboardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecalculateLeaderboardRequest) ProtoMessage() {}
+func (*WatchLeaderboardRequest) ProtoMessage() {}
 
-func (x *RecalculateLeaderboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_leaderboard_service_proto_msgTypes[10]
+func (x *WatchLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,35 +3244,48 @@ func (x *RecalculateLeaderboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecalculateLeaderboardRequest.ProtoReflect.Descriptor instead.
-func (*RecalculateLeaderboardRequest) Descriptor() ([]byte, []int) {
-	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use WatchLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*WatchLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{48}
 }
 
-type RecalculateLeaderboardResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	UsersProcessed int32                  `protobuf:"varint,2,opt,name=users_processed,json=usersProcessed,proto3" json:"users_processed,omitempty"`
-	GamesEvaluated int32                  `protobuf:"varint,3,opt,name=games_evaluated,json=gamesEvaluated,proto3" json:"games_evaluated,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *WatchLeaderboardRequest) GetSinceVersion() int64 {
+	if x != nil {
+		return x.SinceVersion
+	}
+	return 0
 }
 
-func (x *RecalculateLeaderboardResponse) Reset() {
-	*x = RecalculateLeaderboardResponse{}
-	mi := &file_proto_leaderboard_service_proto_msgTypes[11]
+type LeaderboardUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // Resume token
+	Type          LeaderboardUpdateType  `protobuf:"varint,2,opt,name=type,proto3,enum=proto.LeaderboardUpdateType" json:"type,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Prediction    *GradedPrediction      `protobuf:"bytes,4,opt,name=prediction,proto3" json:"prediction,omitempty"`                          // PREDICTION_GRADED
+	Rank          int32                  `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`                                     // RANK_CHANGED
+	PreviousRank  int32                  `protobuf:"varint,6,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"` // 0 = first time on the leaderboard
+	Points        int32                  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`                                 // RANK_CHANGED: user's total points
+	Snapshot      bool                   `protobuf:"varint,8,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                             // Current rank sent when the stream starts, not a change
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardUpdate) Reset() {
+	*x = LeaderboardUpdate{}
+	mi := &file_proto_leaderboard_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecalculateLeaderboardResponse) String() string {
+func (x *LeaderboardUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecalculateLeaderboardResponse) ProtoMessage() {}
+func (*LeaderboardUpdate) ProtoMessage() {}
 
-func (x *RecalculateLeaderboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_leaderboard_service_proto_msgTypes[11]
+func (x *LeaderboardUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,37 +3296,79 @@ func (x *RecalculateLeaderboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecalculateLeaderboardResponse.ProtoReflect.Descriptor instead.
-func (*RecalculateLeaderboardResponse) Descriptor() ([]byte, []int) {
-	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LeaderboardUpdate.ProtoReflect.Descriptor instead.
+func (*LeaderboardUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *RecalculateLeaderboardResponse) GetMessage() string {
+func (x *LeaderboardUpdate) GetVersion() int64 {
 	if x != nil {
-		return x.Message
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeaderboardUpdate) GetType() LeaderboardUpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return LeaderboardUpdateType_LEADERBOARD_UPDATE_TYPE_UNSPECIFIED
+}
+
+func (x *LeaderboardUpdate) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecalculateLeaderboardResponse) GetUsersProcessed() int32 {
+func (x *LeaderboardUpdate) GetPrediction() *GradedPrediction {
 	if x != nil {
-		return x.UsersProcessed
+		return x.Prediction
+	}
+	return nil
+}
+
+func (x *LeaderboardUpdate) GetRank() int32 {
+	if x != nil {
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *RecalculateLeaderboardResponse) GetGamesEvaluated() int32 {
+func (x *LeaderboardUpdate) GetPreviousRank() int32 {
 	if x != nil {
-		return x.GamesEvaluated
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *LeaderboardUpdate) GetPoints() int32 {
+	if x != nil {
+		return x.Points
 	}
 	return 0
 }
 
+func (x *LeaderboardUpdate) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *LeaderboardUpdate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 var File_proto_leaderboard_service_proto protoreflect.FileDescriptor
 
 const file_proto_leaderboard_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fproto/leaderboard_service.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x01\n" +
+	"\x1fproto/leaderboard_service.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x02\n" +
 	"\tUserScore\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
 	"\rcorrect_picks\x18\x02 \x01(\x05R\fcorrectPicks\x12\x1f\n" +
@@ -712,7 +3377,16 @@ const file_proto_leaderboard_service_proto_rawDesc = "" +
 	"\n" +
 	"percentage\x18\x04 \x01(\x01R\n" +
 	"percentage\x12\x12\n" +
-	"\x04rank\x18\x05 \x01(\x05R\x04rank\"\xf1\x01\n" +
+	"\x04rank\x18\x05 \x01(\x05R\x04rank\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x05R\x06points\x12\x16\n" +
+	"\x06season\x18\a \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\b \x01(\x05R\x04week\x12\x19\n" +
+	"\bats_wins\x18\t \x01(\x05R\aatsWins\x12\x1d\n" +
+	"\n" +
+	"ats_losses\x18\n" +
+	" \x01(\x05R\tatsLosses\x12\x1d\n" +
+	"\n" +
+	"ats_pushes\x18\v \x01(\x05R\tatsPushes\"\xf1\x01\n" +
 	"\x10PredictionDetail\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12)\n" +
 	"\x10predicted_winner\x18\x02 \x01(\tR\x0fpredictedWinner\x129\n" +
@@ -721,46 +3395,269 @@ const file_proto_leaderboard_service_proto_rawDesc = "" +
 	"\ractual_winner\x18\x04 \x01(\tR\factualWinner\x12\x18\n" +
 	"\acorrect\x18\x05 \x01(\bR\acorrect\x12\x1f\n" +
 	"\vgame_status\x18\x06 \x01(\tR\n" +
-	"gameStatus\"E\n" +
-	"\x15GetLeaderboardRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"\x94\x01\n" +
+	"gameStatus\"\x80\x02\n" +
+	"\x10GradedPrediction\x12#\n" +
+	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x05R\x06points\x12\x16\n" +
+	"\x06season\x18\x06 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\a \x01(\x05R\x04week\x12%\n" +
+	"\x0eagainst_spread\x18\b \x01(\bR\ragainstSpread\x12\x12\n" +
+	"\x04push\x18\t \x01(\bR\x04push\"\xa5\x01\n" +
+	"\x06League\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"t\n" +
+	"\fLeagueMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x127\n" +
+	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xe2\x01\n" +
+	"\fLeagueInvite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\tR\bleagueId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\arevoked\x18\a \x01(\bR\arevoked\"\xbd\x01\n" +
+	"\x10SurvivorStanding\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x14\n" +
+	"\x05alive\x18\x03 \x01(\bR\x05alive\x12'\n" +
+	"\x0feliminated_week\x18\x04 \x01(\x05R\x0eeliminatedWeek\x12%\n" +
+	"\x0eweeks_survived\x18\x05 \x01(\x05R\rweeksSurvived\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\"\xc5\x01\n" +
+	"\x0fBracketStanding\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\x12\x18\n" +
+	"\acorrect\x18\x04 \x01(\x05R\acorrect\x12\x1c\n" +
+	"\tincorrect\x18\x05 \x01(\x05R\tincorrect\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x06 \x01(\x05R\tmaxPoints\x12\x12\n" +
+	"\x04rank\x18\a \x01(\x05R\x04rank\"\xb5\x01\n" +
+	"\x15GetLeaderboardRequest\x12\x18\n" +
+	"\x05limit\x18\x01 \x01(\x05B\x02\x18\x01R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x02 \x01(\x05B\x02\x18\x01R\x06offset\x12\x16\n" +
+	"\x06season\x18\x03 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x04 \x01(\x05R\x04week\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x89\x02\n" +
 	"\x16GetLeaderboardResponse\x122\n" +
 	"\vleaderboard\x18\x01 \x03(\v2\x10.proto.UserScoreR\vleaderboard\x12\x1f\n" +
 	"\vtotal_users\x18\x02 \x01(\x05R\n" +
 	"totalUsers\x12%\n" +
-	"\x0egames_finished\x18\x03 \x01(\x05R\rgamesFinished\".\n" +
+	"\x0egames_finished\x18\x03 \x01(\x05R\rgamesFinished\x12\x1f\n" +
+	"\vgames_total\x18\x04 \x01(\x05R\n" +
+	"gamesTotal\x12\x16\n" +
+	"\x06season\x18\x05 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x06 \x01(\x05R\x04week\x12&\n" +
+	"\x0fnext_page_token\x18\a \x01(\tR\rnextPageToken\".\n" +
 	"\x13GetUserStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xaf\x01\n" +
 	"\x14GetUserStatsResponse\x12/\n" +
 	"\n" +
 	"user_stats\x18\x01 \x01(\v2\x10.proto.UserScoreR\tuserStats\x129\n" +
 	"\vpredictions\x18\x02 \x03(\v2\x17.proto.PredictionDetailR\vpredictions\x12+\n" +
-	"\x11total_predictions\x18\x03 \x01(\x05R\x10totalPredictions\")\n" +
+	"\x11total_predictions\x18\x03 \x01(\x05R\x10totalPredictions\"U\n" +
 	"\x12GetTopUsersRequest\x12\x13\n" +
-	"\x05top_n\x18\x01 \x01(\x05R\x04topN\"Z\n" +
+	"\x05top_n\x18\x01 \x01(\x05R\x04topN\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\"Z\n" +
 	"\x13GetTopUsersResponse\x12-\n" +
 	"\ttop_users\x18\x01 \x03(\v2\x10.proto.UserScoreR\btopUsers\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"Y\n" +
 	"\x12GetUserRankRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"{\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\"{\n" +
 	"\x13GetUserRankResponse\x12/\n" +
 	"\n" +
 	"user_score\x18\x01 \x01(\v2\x10.proto.UserScoreR\tuserScore\x12\x12\n" +
 	"\x04rank\x18\x02 \x01(\x05R\x04rank\x12\x1f\n" +
 	"\vtotal_users\x18\x03 \x01(\x05R\n" +
-	"totalUsers\"\x1f\n" +
+	"totalUsers\"L\n" +
+	"\x19GetUserWeeklyStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\"\xac\x01\n" +
+	"\x1aGetUserWeeklyStatsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12&\n" +
+	"\x05weeks\x18\x03 \x03(\v2\x10.proto.UserScoreR\x05weeks\x125\n" +
+	"\rseason_totals\x18\x04 \x01(\v2\x10.proto.UserScoreR\fseasonTotals\"\x1f\n" +
 	"\x1dRecalculateLeaderboardRequest\"\x8c\x01\n" +
 	"\x1eRecalculateLeaderboardResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12'\n" +
 	"\x0fusers_processed\x18\x02 \x01(\x05R\x0eusersProcessed\x12'\n" +
-	"\x0fgames_evaluated\x18\x03 \x01(\x05R\x0egamesEvaluated2\x9f\x03\n" +
-	"\x12LeaderboardService\x12M\n" +
-	"\x0eGetLeaderboard\x12\x1c.proto.GetLeaderboardRequest\x1a\x1d.proto.GetLeaderboardResponse\x12G\n" +
-	"\fGetUserStats\x12\x1a.proto.GetUserStatsRequest\x1a\x1b.proto.GetUserStatsResponse\x12D\n" +
-	"\vGetTopUsers\x12\x19.proto.GetTopUsersRequest\x1a\x1a.proto.GetTopUsersResponse\x12D\n" +
-	"\vGetUserRank\x12\x19.proto.GetUserRankRequest\x1a\x1a.proto.GetUserRankResponse\x12e\n" +
-	"\x16RecalculateLeaderboard\x12$.proto.RecalculateLeaderboardRequest\x1a%.proto.RecalculateLeaderboardResponseB\x19Z\x17kickoff.com/proto;protob\x06proto3"
+	"\x0fgames_evaluated\x18\x03 \x01(\x05R\x0egamesEvaluated\"R\n" +
+	"\x1dApplyPredictionResultsRequest\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.proto.GradedPredictionR\aresults\"_\n" +
+	"\x1eApplyPredictionResultsResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\x05R\aapplied\x12#\n" +
+	"\rusers_updated\x18\x02 \x01(\x05R\fusersUpdated\"B\n" +
+	"\x13CreateLeagueRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"W\n" +
+	"\x14CreateLeagueResponse\x12%\n" +
+	"\x06league\x18\x01 \x01(\v2\r.proto.LeagueR\x06league\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x10GetLeagueRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\"i\n" +
+	"\x11GetLeagueResponse\x12%\n" +
+	"\x06league\x18\x01 \x01(\v2\r.proto.LeagueR\x06league\x12-\n" +
+	"\amembers\x18\x02 \x03(\v2\x13.proto.LeagueMemberR\amembers\"l\n" +
+	"\x15GetUserLeaguesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x16GetUserLeaguesResponse\x12'\n" +
+	"\aleagues\x18\x01 \x03(\v2\r.proto.LeagueR\aleagues\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x96\x01\n" +
+	"\x19CreateLeagueInviteRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x05R\amaxUses\x12(\n" +
+	"\x10expires_in_hours\x18\x04 \x01(\x05R\x0eexpiresInHours\"I\n" +
+	"\x1aCreateLeagueInviteResponse\x12+\n" +
+	"\x06invite\x18\x01 \x01(\v2\x13.proto.LeagueInviteR\x06invite\"\x8b\x01\n" +
+	"\x17GetLeagueInvitesRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x87\x01\n" +
+	"\x18GetLeagueInvitesResponse\x12-\n" +
+	"\ainvites\x18\x01 \x03(\v2\x13.proto.LeagueInviteR\ainvites\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"e\n" +
+	"\x19RevokeLeagueInviteRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tleague_id\x18\x03 \x01(\tR\bleagueId\"P\n" +
+	"\x1aRevokeLeagueInviteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"@\n" +
+	"\x11JoinLeagueRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"U\n" +
+	"\x12JoinLeagueResponse\x12%\n" +
+	"\x06league\x18\x01 \x01(\v2\r.proto.LeagueR\x06league\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"J\n" +
+	"\x12LeaveLeagueRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"I\n" +
+	"\x13LeaveLeagueResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x98\x01\n" +
+	"\x19UpdateLeagueMemberRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12$\n" +
+	"\x0eacting_user_id\x18\x02 \x01(\tR\factingUserId\x12$\n" +
+	"\x0emember_user_id\x18\x03 \x01(\tR\fmemberUserId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"c\n" +
+	"\x1aUpdateLeagueMemberResponse\x12+\n" +
+	"\x06member\x18\x01 \x01(\v2\x13.proto.LeagueMemberR\x06member\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x84\x01\n" +
+	"\x19RemoveLeagueMemberRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12$\n" +
+	"\x0eacting_user_id\x18\x02 \x01(\tR\factingUserId\x12$\n" +
+	"\x0emember_user_id\x18\x03 \x01(\tR\fmemberUserId\"P\n" +
+	"\x1aRemoveLeagueMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd8\x01\n" +
+	"\x1bGetLeagueLeaderboardRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\x12\x18\n" +
+	"\x05limit\x18\x04 \x01(\x05B\x02\x18\x01R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x05 \x01(\x05B\x02\x18\x01R\x06offset\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\xee\x01\n" +
+	"\x1cGetLeagueLeaderboardResponse\x12%\n" +
+	"\x06league\x18\x01 \x01(\v2\r.proto.LeagueR\x06league\x122\n" +
+	"\vleaderboard\x18\x02 \x03(\v2\x10.proto.UserScoreR\vleaderboard\x12\x1f\n" +
+	"\vtotal_users\x18\x03 \x01(\x05R\n" +
+	"totalUsers\x12\x16\n" +
+	"\x06season\x18\x04 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x05 \x01(\x05R\x04week\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"\x8e\x01\n" +
+	"\x1bGetSurvivorStandingsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\tR\bleagueId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xcb\x01\n" +
+	"\x1cGetSurvivorStandingsResponse\x125\n" +
+	"\tstandings\x18\x01 \x03(\v2\x17.proto.SurvivorStandingR\tstandings\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x14\n" +
+	"\x05alive\x18\x03 \x01(\x05R\x05alive\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x04 \x01(\x05R\n" +
+	"eliminated\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x8d\x01\n" +
+	"\x1aGetBracketStandingsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\tR\bleagueId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa9\x01\n" +
+	"\x1bGetBracketStandingsResponse\x124\n" +
+	"\tstandings\x18\x01 \x03(\v2\x16.proto.BracketStandingR\tstandings\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\">\n" +
+	"\x17WatchLeaderboardRequest\x12#\n" +
+	"\rsince_version\x18\x01 \x01(\x03R\fsinceVersion\"\xd9\x02\n" +
+	"\x11LeaderboardUpdate\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.proto.LeaderboardUpdateTypeR\x04type\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x127\n" +
+	"\n" +
+	"prediction\x18\x04 \x01(\v2\x17.proto.GradedPredictionR\n" +
+	"prediction\x12\x12\n" +
+	"\x04rank\x18\x05 \x01(\x05R\x04rank\x12#\n" +
+	"\rprevious_rank\x18\x06 \x01(\x05R\fpreviousRank\x12\x16\n" +
+	"\x06points\x18\a \x01(\x05R\x06points\x12\x1a\n" +
+	"\bsnapshot\x18\b \x01(\bR\bsnapshot\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*\x99\x01\n" +
+	"\x15LeaderboardUpdateType\x12'\n" +
+	"#LEADERBOARD_UPDATE_TYPE_UNSPECIFIED\x10\x00\x12-\n" +
+	")LEADERBOARD_UPDATE_TYPE_PREDICTION_GRADED\x10\x01\x12(\n" +
+	"$LEADERBOARD_UPDATE_TYPE_RANK_CHANGED\x10\x022\xd4\x14\n" +
+	"\x12LeaderboardService\x12g\n" +
+	"\x0eGetLeaderboard\x12\x1c.proto.GetLeaderboardRequest\x1a\x1d.proto.GetLeaderboardResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/leaderboard\x12\x88\x01\n" +
+	"\fGetUserStats\x12\x1a.proto.GetUserStatsRequest\x1a\x1b.proto.GetUserStatsResponse\"?\x82\xd3\xe4\x93\x029Z\x1b\x12\x19/api/user-stats/{user_id}\x12\x1a/api/users/{user_id}/stats\x12b\n" +
+	"\vGetTopUsers\x12\x19.proto.GetTopUsersRequest\x1a\x1a.proto.GetTopUsersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/leaderboard/top\x12g\n" +
+	"\vGetUserRank\x12\x19.proto.GetUserRankRequest\x1a\x1a.proto.GetUserRankResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/users/{user_id}/rank\x12\x84\x01\n" +
+	"\x12GetUserWeeklyStats\x12 .proto.GetUserWeeklyStatsRequest\x1a!.proto.GetUserWeeklyStatsResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/users/{user_id}/weekly-stats\x12\x8e\x01\n" +
+	"\x16RecalculateLeaderboard\x12$.proto.RecalculateLeaderboardRequest\x1a%.proto.RecalculateLeaderboardResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/leaderboard/recalculate\x12\x8a\x01\n" +
+	"\x16ApplyPredictionResults\x12$.proto.ApplyPredictionResultsRequest\x1a%.proto.ApplyPredictionResultsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/leaderboard/results\x12`\n" +
+	"\fCreateLeague\x12\x1a.proto.CreateLeagueRequest\x1a\x1b.proto.CreateLeagueResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/leagues\x12`\n" +
+	"\tGetLeague\x12\x17.proto.GetLeagueRequest\x1a\x18.proto.GetLeagueResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/leagues/{league_id}\x12c\n" +
+	"\x0eGetUserLeagues\x12\x1c.proto.GetUserLeaguesRequest\x1a\x1d.proto.GetUserLeaguesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/leagues\x12\x86\x01\n" +
+	"\x12CreateLeagueInvite\x12 .proto.CreateLeagueInviteRequest\x1a!.proto.CreateLeagueInviteResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/leagues/{league_id}/invites\x12}\n" +
+	"\x10GetLeagueInvites\x12\x1e.proto.GetLeagueInvitesRequest\x1a\x1f.proto.GetLeagueInvitesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/leagues/{league_id}/invites\x12\x8a\x01\n" +
+	"\x12RevokeLeagueInvite\x12 .proto.RevokeLeagueInviteRequest\x1a!.proto.RevokeLeagueInviteResponse\"/\x82\xd3\xe4\x93\x02)*'/api/leagues/{league_id}/invites/{code}\x12_\n" +
+	"\n" +
+	"JoinLeague\x12\x18.proto.JoinLeagueRequest\x1a\x19.proto.JoinLeagueResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/leagues/join\x12o\n" +
+	"\vLeaveLeague\x12\x19.proto.LeaveLeagueRequest\x1a\x1a.proto.LeaveLeagueResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/leagues/{league_id}/leave\x12\x97\x01\n" +
+	"\x12UpdateLeagueMember\x12 .proto.UpdateLeagueMemberRequest\x1a!.proto.UpdateLeagueMemberResponse\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/api/leagues/{league_id}/members/{member_user_id}\x12\x94\x01\n" +
+	"\x12RemoveLeagueMember\x12 .proto.RemoveLeagueMemberRequest\x1a!.proto.RemoveLeagueMemberResponse\"9\x82\xd3\xe4\x93\x023*1/api/leagues/{league_id}/members/{member_user_id}\x12\x8d\x01\n" +
+	"\x14GetLeagueLeaderboard\x12\".proto.GetLeagueLeaderboardRequest\x1a#.proto.GetLeagueLeaderboardResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/leagues/{league_id}/leaderboard\x12\x9b\x01\n" +
+	"\x14GetSurvivorStandings\x12\".proto.GetSurvivorStandingsRequest\x1a#.proto.GetSurvivorStandingsResponse\":\x82\xd3\xe4\x93\x024Z#\x12!/api/leagues/{league_id}/survivor\x12\r/api/survivor\x12\x98\x01\n" +
+	"\x13GetBracketStandings\x12!.proto.GetBracketStandingsRequest\x1a\".proto.GetBracketStandingsResponse\":\x82\xd3\xe4\x93\x024Z#\x12!/api/leagues/{league_id}/brackets\x12\r/api/brackets\x12N\n" +
+	"\x10WatchLeaderboard\x12\x1e.proto.WatchLeaderboardRequest\x1a\x18.proto.LeaderboardUpdate0\x01B\x19Z\x17kickoff.com/proto;protob\x06proto3"
 
 var (
 	file_proto_leaderboard_service_proto_rawDescOnce sync.Once
@@ -774,44 +3671,137 @@ func file_proto_leaderboard_service_proto_rawDescGZIP() []byte {
 	return file_proto_leaderboard_service_proto_rawDescData
 }
 
-var file_proto_leaderboard_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_leaderboard_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_leaderboard_service_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_proto_leaderboard_service_proto_goTypes = []any{
-	(*UserScore)(nil),                      // 0: proto.UserScore
-	(*PredictionDetail)(nil),               // 1: proto.PredictionDetail
-	(*GetLeaderboardRequest)(nil),          // 2: proto.GetLeaderboardRequest
-	(*GetLeaderboardResponse)(nil),         // 3: proto.GetLeaderboardResponse
-	(*GetUserStatsRequest)(nil),            // 4: proto.GetUserStatsRequest
-	(*GetUserStatsResponse)(nil),           // 5: proto.GetUserStatsResponse
-	(*GetTopUsersRequest)(nil),             // 6: proto.GetTopUsersRequest
-	(*GetTopUsersResponse)(nil),            // 7: proto.GetTopUsersResponse
-	(*GetUserRankRequest)(nil),             // 8: proto.GetUserRankRequest
-	(*GetUserRankResponse)(nil),            // 9: proto.GetUserRankResponse
-	(*RecalculateLeaderboardRequest)(nil),  // 10: proto.RecalculateLeaderboardRequest
-	(*RecalculateLeaderboardResponse)(nil), // 11: proto.RecalculateLeaderboardResponse
-	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
+	(LeaderboardUpdateType)(0),             // 0: proto.LeaderboardUpdateType
+	(*UserScore)(nil),                      // 1: proto.UserScore
+	(*PredictionDetail)(nil),               // 2: proto.PredictionDetail
+	(*GradedPrediction)(nil),               // 3: proto.GradedPrediction
+	(*League)(nil),                         // 4: proto.League
+	(*LeagueMember)(nil),                   // 5: proto.LeagueMember
+	(*LeagueInvite)(nil),                   // 6: proto.LeagueInvite
+	(*SurvivorStanding)(nil),               // 7: proto.SurvivorStanding
+	(*BracketStanding)(nil),                // 8: proto.BracketStanding
+	(*GetLeaderboardRequest)(nil),          // 9: proto.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil),         // 10: proto.GetLeaderboardResponse
+	(*GetUserStatsRequest)(nil),            // 11: proto.GetUserStatsRequest
+	(*GetUserStatsResponse)(nil),           // 12: proto.GetUserStatsResponse
+	(*GetTopUsersRequest)(nil),             // 13: proto.GetTopUsersRequest
+	(*GetTopUsersResponse)(nil),            // 14: proto.GetTopUsersResponse
+	(*GetUserRankRequest)(nil),             // 15: proto.GetUserRankRequest
+	(*GetUserRankResponse)(nil),            // 16: proto.GetUserRankResponse
+	(*GetUserWeeklyStatsRequest)(nil),      // 17: proto.GetUserWeeklyStatsRequest
+	(*GetUserWeeklyStatsResponse)(nil),     // 18: proto.GetUserWeeklyStatsResponse
+	(*RecalculateLeaderboardRequest)(nil),  // 19: proto.RecalculateLeaderboardRequest
+	(*RecalculateLeaderboardResponse)(nil), // 20: proto.RecalculateLeaderboardResponse
+	(*ApplyPredictionResultsRequest)(nil),  // 21: proto.ApplyPredictionResultsRequest
+	(*ApplyPredictionResultsResponse)(nil), // 22: proto.ApplyPredictionResultsResponse
+	(*CreateLeagueRequest)(nil),            // 23: proto.CreateLeagueRequest
+	(*CreateLeagueResponse)(nil),           // 24: proto.CreateLeagueResponse
+	(*GetLeagueRequest)(nil),               // 25: proto.GetLeagueRequest
+	(*GetLeagueResponse)(nil),              // 26: proto.GetLeagueResponse
+	(*GetUserLeaguesRequest)(nil),          // 27: proto.GetUserLeaguesRequest
+	(*GetUserLeaguesResponse)(nil),         // 28: proto.GetUserLeaguesResponse
+	(*CreateLeagueInviteRequest)(nil),      // 29: proto.CreateLeagueInviteRequest
+	(*CreateLeagueInviteResponse)(nil),     // 30: proto.CreateLeagueInviteResponse
+	(*GetLeagueInvitesRequest)(nil),        // 31: proto.GetLeagueInvitesRequest
+	(*GetLeagueInvitesResponse)(nil),       // 32: proto.GetLeagueInvitesResponse
+	(*RevokeLeagueInviteRequest)(nil),      // 33: proto.RevokeLeagueInviteRequest
+	(*RevokeLeagueInviteResponse)(nil),     // 34: proto.RevokeLeagueInviteResponse
+	(*JoinLeagueRequest)(nil),              // 35: proto.JoinLeagueRequest
+	(*JoinLeagueResponse)(nil),             // 36: proto.JoinLeagueResponse
+	(*LeaveLeagueRequest)(nil),             // 37: proto.LeaveLeagueRequest
+	(*LeaveLeagueResponse)(nil),            // 38: proto.LeaveLeagueResponse
+	(*UpdateLeagueMemberRequest)(nil),      // 39: proto.UpdateLeagueMemberRequest
+	(*UpdateLeagueMemberResponse)(nil),     // 40: proto.UpdateLeagueMemberResponse
+	(*RemoveLeagueMemberRequest)(nil),      // 41: proto.RemoveLeagueMemberRequest
+	(*RemoveLeagueMemberResponse)(nil),     // 42: proto.RemoveLeagueMemberResponse
+	(*GetLeagueLeaderboardRequest)(nil),    // 43: proto.GetLeagueLeaderboardRequest
+	(*GetLeagueLeaderboardResponse)(nil),   // 44: proto.GetLeagueLeaderboardResponse
+	(*GetSurvivorStandingsRequest)(nil),    // 45: proto.GetSurvivorStandingsRequest
+	(*GetSurvivorStandingsResponse)(nil),   // 46: proto.GetSurvivorStandingsResponse
+	(*GetBracketStandingsRequest)(nil),     // 47: proto.GetBracketStandingsRequest
+	(*GetBracketStandingsResponse)(nil),    // 48: proto.GetBracketStandingsResponse
+	(*WatchLeaderboardRequest)(nil),        // 49: proto.WatchLeaderboardRequest
+	(*LeaderboardUpdate)(nil),              // 50: proto.LeaderboardUpdate
+	(*timestamppb.Timestamp)(nil),          // 51: google.protobuf.Timestamp
 }
 var file_proto_leaderboard_service_proto_depIdxs = []int32{
-	12, // 0: proto.PredictionDetail.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: proto.GetLeaderboardResponse.leaderboard:type_name -> proto.UserScore
-	0,  // 2: proto.GetUserStatsResponse.user_stats:type_name -> proto.UserScore
-	1,  // 3: proto.GetUserStatsResponse.predictions:type_name -> proto.PredictionDetail
-	0,  // 4: proto.GetTopUsersResponse.top_users:type_name -> proto.UserScore
-	0,  // 5: proto.GetUserRankResponse.user_score:type_name -> proto.UserScore
-	2,  // 6: proto.LeaderboardService.GetLeaderboard:input_type -> proto.GetLeaderboardRequest
-	4,  // 7: proto.LeaderboardService.GetUserStats:input_type -> proto.GetUserStatsRequest
-	6,  // 8: proto.LeaderboardService.GetTopUsers:input_type -> proto.GetTopUsersRequest
-	8,  // 9: proto.LeaderboardService.GetUserRank:input_type -> proto.GetUserRankRequest
-	10, // 10: proto.LeaderboardService.RecalculateLeaderboard:input_type -> proto.RecalculateLeaderboardRequest
-	3,  // 11: proto.LeaderboardService.GetLeaderboard:output_type -> proto.GetLeaderboardResponse
-	5,  // 12: proto.LeaderboardService.GetUserStats:output_type -> proto.GetUserStatsResponse
-	7,  // 13: proto.LeaderboardService.GetTopUsers:output_type -> proto.GetTopUsersResponse
-	9,  // 14: proto.LeaderboardService.GetUserRank:output_type -> proto.GetUserRankResponse
-	11, // 15: proto.LeaderboardService.RecalculateLeaderboard:output_type -> proto.RecalculateLeaderboardResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	51, // 0: proto.PredictionDetail.created_at:type_name -> google.protobuf.Timestamp
+	51, // 1: proto.League.created_at:type_name -> google.protobuf.Timestamp
+	51, // 2: proto.LeagueMember.joined_at:type_name -> google.protobuf.Timestamp
+	51, // 3: proto.LeagueInvite.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: proto.GetLeaderboardResponse.leaderboard:type_name -> proto.UserScore
+	1,  // 5: proto.GetUserStatsResponse.user_stats:type_name -> proto.UserScore
+	2,  // 6: proto.GetUserStatsResponse.predictions:type_name -> proto.PredictionDetail
+	1,  // 7: proto.GetTopUsersResponse.top_users:type_name -> proto.UserScore
+	1,  // 8: proto.GetUserRankResponse.user_score:type_name -> proto.UserScore
+	1,  // 9: proto.GetUserWeeklyStatsResponse.weeks:type_name -> proto.UserScore
+	1,  // 10: proto.GetUserWeeklyStatsResponse.season_totals:type_name -> proto.UserScore
+	3,  // 11: proto.ApplyPredictionResultsRequest.results:type_name -> proto.GradedPrediction
+	4,  // 12: proto.CreateLeagueResponse.league:type_name -> proto.League
+	4,  // 13: proto.GetLeagueResponse.league:type_name -> proto.League
+	5,  // 14: proto.GetLeagueResponse.members:type_name -> proto.LeagueMember
+	4,  // 15: proto.GetUserLeaguesResponse.leagues:type_name -> proto.League
+	6,  // 16: proto.CreateLeagueInviteResponse.invite:type_name -> proto.LeagueInvite
+	6,  // 17: proto.GetLeagueInvitesResponse.invites:type_name -> proto.LeagueInvite
+	4,  // 18: proto.JoinLeagueResponse.league:type_name -> proto.League
+	5,  // 19: proto.UpdateLeagueMemberResponse.member:type_name -> proto.LeagueMember
+	4,  // 20: proto.GetLeagueLeaderboardResponse.league:type_name -> proto.League
+	1,  // 21: proto.GetLeagueLeaderboardResponse.leaderboard:type_name -> proto.UserScore
+	7,  // 22: proto.GetSurvivorStandingsResponse.standings:type_name -> proto.SurvivorStanding
+	8,  // 23: proto.GetBracketStandingsResponse.standings:type_name -> proto.BracketStanding
+	0,  // 24: proto.LeaderboardUpdate.type:type_name -> proto.LeaderboardUpdateType
+	3,  // 25: proto.LeaderboardUpdate.prediction:type_name -> proto.GradedPrediction
+	51, // 26: proto.LeaderboardUpdate.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 27: proto.LeaderboardService.GetLeaderboard:input_type -> proto.GetLeaderboardRequest
+	11, // 28: proto.LeaderboardService.GetUserStats:input_type -> proto.GetUserStatsRequest
+	13, // 29: proto.LeaderboardService.GetTopUsers:input_type -> proto.GetTopUsersRequest
+	15, // 30: proto.LeaderboardService.GetUserRank:input_type -> proto.GetUserRankRequest
+	17, // 31: proto.LeaderboardService.GetUserWeeklyStats:input_type -> proto.GetUserWeeklyStatsRequest
+	19, // 32: proto.LeaderboardService.RecalculateLeaderboard:input_type -> proto.RecalculateLeaderboardRequest
+	21, // 33: proto.LeaderboardService.ApplyPredictionResults:input_type -> proto.ApplyPredictionResultsRequest
+	23, // 34: proto.LeaderboardService.CreateLeague:input_type -> proto.CreateLeagueRequest
+	25, // 35: proto.LeaderboardService.GetLeague:input_type -> proto.GetLeagueRequest
+	27, // 36: proto.LeaderboardService.GetUserLeagues:input_type -> proto.GetUserLeaguesRequest
+	29, // 37: proto.LeaderboardService.CreateLeagueInvite:input_type -> proto.CreateLeagueInviteRequest
+	31, // 38: proto.LeaderboardService.GetLeagueInvites:input_type -> proto.GetLeagueInvitesRequest
+	33, // 39: proto.LeaderboardService.RevokeLeagueInvite:input_type -> proto.RevokeLeagueInviteRequest
+	35, // 40: proto.LeaderboardService.JoinLeague:input_type -> proto.JoinLeagueRequest
+	37, // 41: proto.LeaderboardService.LeaveLeague:input_type -> proto.LeaveLeagueRequest
+	39, // 42: proto.LeaderboardService.UpdateLeagueMember:input_type -> proto.UpdateLeagueMemberRequest
+	41, // 43: proto.LeaderboardService.RemoveLeagueMember:input_type -> proto.RemoveLeagueMemberRequest
+	43, // 44: proto.LeaderboardService.GetLeagueLeaderboard:input_type -> proto.GetLeagueLeaderboardRequest
+	45, // 45: proto.LeaderboardService.GetSurvivorStandings:input_type -> proto.GetSurvivorStandingsRequest
+	47, // 46: proto.LeaderboardService.GetBracketStandings:input_type -> proto.GetBracketStandingsRequest
+	49, // 47: proto.LeaderboardService.WatchLeaderboard:input_type -> proto.WatchLeaderboardRequest
+	10, // 48: proto.LeaderboardService.GetLeaderboard:output_type -> proto.GetLeaderboardResponse
+	12, // 49: proto.LeaderboardService.GetUserStats:output_type -> proto.GetUserStatsResponse
+	14, // 50: proto.LeaderboardService.GetTopUsers:output_type -> proto.GetTopUsersResponse
+	16, // 51: proto.LeaderboardService.GetUserRank:output_type -> proto.GetUserRankResponse
+	18, // 52: proto.LeaderboardService.GetUserWeeklyStats:output_type -> proto.GetUserWeeklyStatsResponse
+	20, // 53: proto.LeaderboardService.RecalculateLeaderboard:output_type -> proto.RecalculateLeaderboardResponse
+	22, // 54: proto.LeaderboardService.ApplyPredictionResults:output_type -> proto.ApplyPredictionResultsResponse
+	24, // 55: proto.LeaderboardService.CreateLeague:output_type -> proto.CreateLeagueResponse
+	26, // 56: proto.LeaderboardService.GetLeague:output_type -> proto.GetLeagueResponse
+	28, // 57: proto.LeaderboardService.GetUserLeagues:output_type -> proto.GetUserLeaguesResponse
+	30, // 58: proto.LeaderboardService.CreateLeagueInvite:output_type -> proto.CreateLeagueInviteResponse
+	32, // 59: proto.LeaderboardService.GetLeagueInvites:output_type -> proto.GetLeagueInvitesResponse
+	34, // 60: proto.LeaderboardService.RevokeLeagueInvite:output_type -> proto.RevokeLeagueInviteResponse
+	36, // 61: proto.LeaderboardService.JoinLeague:output_type -> proto.JoinLeagueResponse
+	38, // 62: proto.LeaderboardService.LeaveLeague:output_type -> proto.LeaveLeagueResponse
+	40, // 63: proto.LeaderboardService.UpdateLeagueMember:output_type -> proto.UpdateLeagueMemberResponse
+	42, // 64: proto.LeaderboardService.RemoveLeagueMember:output_type -> proto.RemoveLeagueMemberResponse
+	44, // 65: proto.LeaderboardService.GetLeagueLeaderboard:output_type -> proto.GetLeagueLeaderboardResponse
+	46, // 66: proto.LeaderboardService.GetSurvivorStandings:output_type -> proto.GetSurvivorStandingsResponse
+	48, // 67: proto.LeaderboardService.GetBracketStandings:output_type -> proto.GetBracketStandingsResponse
+	50, // 68: proto.LeaderboardService.WatchLeaderboard:output_type -> proto.LeaderboardUpdate
+	48, // [48:69] is the sub-list for method output_type
+	27, // [27:48] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_proto_leaderboard_service_proto_init() }
@@ -824,13 +3814,14 @@ func file_proto_leaderboard_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_leaderboard_service_proto_rawDesc), len(file_proto_leaderboard_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      1,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_leaderboard_service_proto_goTypes,
 		DependencyIndexes: file_proto_leaderboard_service_proto_depIdxs,
+		EnumInfos:         file_proto_leaderboard_service_proto_enumTypes,
 		MessageInfos:      file_proto_leaderboard_service_proto_msgTypes,
 	}.Build()
 	File_proto_leaderboard_service_proto = out.File
@@ -23,7 +23,23 @@ const (
 	LeaderboardService_GetUserStats_FullMethodName           = "/proto.LeaderboardService/GetUserStats"
 	LeaderboardService_GetTopUsers_FullMethodName            = "/proto.LeaderboardService/GetTopUsers"
 	LeaderboardService_GetUserRank_FullMethodName            = "/proto.LeaderboardService/GetUserRank"
+	LeaderboardService_GetUserWeeklyStats_FullMethodName     = "/proto.LeaderboardService/GetUserWeeklyStats"
 	LeaderboardService_RecalculateLeaderboard_FullMethodName = "/proto.LeaderboardService/RecalculateLeaderboard"
+	LeaderboardService_ApplyPredictionResults_FullMethodName = "/proto.LeaderboardService/ApplyPredictionResults"
+	LeaderboardService_CreateLeague_FullMethodName           = "/proto.LeaderboardService/CreateLeague"
+	LeaderboardService_GetLeague_FullMethodName              = "/proto.LeaderboardService/GetLeague"
+	LeaderboardService_GetUserLeagues_FullMethodName         = "/proto.LeaderboardService/GetUserLeagues"
+	LeaderboardService_CreateLeagueInvite_FullMethodName     = "/proto.LeaderboardService/CreateLeagueInvite"
+	LeaderboardService_GetLeagueInvites_FullMethodName       = "/proto.LeaderboardService/GetLeagueInvites"
+	LeaderboardService_RevokeLeagueInvite_FullMethodName     = "/proto.LeaderboardService/RevokeLeagueInvite"
+	LeaderboardService_JoinLeague_FullMethodName             = "/proto.LeaderboardService/JoinLeague"
+	LeaderboardService_LeaveLeague_FullMethodName            = "/proto.LeaderboardService/LeaveLeague"
+	LeaderboardService_UpdateLeagueMember_FullMethodName     = "/proto.LeaderboardService/UpdateLeagueMember"
+	LeaderboardService_RemoveLeagueMember_FullMethodName     = "/proto.LeaderboardService/RemoveLeagueMember"
+	LeaderboardService_GetLeagueLeaderboard_FullMethodName   = "/proto.LeaderboardService/GetLeagueLeaderboard"
+	LeaderboardService_GetSurvivorStandings_FullMethodName   = "/proto.LeaderboardService/GetSurvivorStandings"
+	LeaderboardService_GetBracketStandings_FullMethodName    = "/proto.LeaderboardService/GetBracketStandings"
+	LeaderboardService_WatchLeaderboard_FullMethodName       = "/proto.LeaderboardService/WatchLeaderboard"
 )
 
 // LeaderboardServiceClient is the client API for LeaderboardService service.
@@ -38,8 +54,31 @@ type LeaderboardServiceClient interface {
 	GetTopUsers(ctx context.Context, in *GetTopUsersRequest, opts ...grpc.CallOption) (*GetTopUsersResponse, error)
 	// Get user rank
 	GetUserRank(ctx context.Context, in *GetUserRankRequest, opts ...grpc.CallOption) (*GetUserRankResponse, error)
+	// Get a user's week-by-week results for a season
+	GetUserWeeklyStats(ctx context.Context, in *GetUserWeeklyStatsRequest, opts ...grpc.CallOption) (*GetUserWeeklyStatsResponse, error)
 	// Recalculate leaderboard (admin/internal)
 	RecalculateLeaderboard(ctx context.Context, in *RecalculateLeaderboardRequest, opts ...grpc.CallOption) (*RecalculateLeaderboardResponse, error)
+	// Apply graded predictions to user stats (internal)
+	ApplyPredictionResults(ctx context.Context, in *ApplyPredictionResultsRequest, opts ...grpc.CallOption) (*ApplyPredictionResultsResponse, error)
+	// Leagues (private pools)
+	CreateLeague(ctx context.Context, in *CreateLeagueRequest, opts ...grpc.CallOption) (*CreateLeagueResponse, error)
+	GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueResponse, error)
+	GetUserLeagues(ctx context.Context, in *GetUserLeaguesRequest, opts ...grpc.CallOption) (*GetUserLeaguesResponse, error)
+	CreateLeagueInvite(ctx context.Context, in *CreateLeagueInviteRequest, opts ...grpc.CallOption) (*CreateLeagueInviteResponse, error)
+	GetLeagueInvites(ctx context.Context, in *GetLeagueInvitesRequest, opts ...grpc.CallOption) (*GetLeagueInvitesResponse, error)
+	RevokeLeagueInvite(ctx context.Context, in *RevokeLeagueInviteRequest, opts ...grpc.CallOption) (*RevokeLeagueInviteResponse, error)
+	JoinLeague(ctx context.Context, in *JoinLeagueRequest, opts ...grpc.CallOption) (*JoinLeagueResponse, error)
+	LeaveLeague(ctx context.Context, in *LeaveLeagueRequest, opts ...grpc.CallOption) (*LeaveLeagueResponse, error)
+	UpdateLeagueMember(ctx context.Context, in *UpdateLeagueMemberRequest, opts ...grpc.CallOption) (*UpdateLeagueMemberResponse, error)
+	RemoveLeagueMember(ctx context.Context, in *RemoveLeagueMemberRequest, opts ...grpc.CallOption) (*RemoveLeagueMemberResponse, error)
+	// Standings of a league, computed from the same graded predictions
+	GetLeagueLeaderboard(ctx context.Context, in *GetLeagueLeaderboardRequest, opts ...grpc.CallOption) (*GetLeagueLeaderboardResponse, error)
+	// Survivor pool standings for a season: alive/eliminated state and elimination week
+	GetSurvivorStandings(ctx context.Context, in *GetSurvivorStandingsRequest, opts ...grpc.CallOption) (*GetSurvivorStandingsResponse, error)
+	// Playoff bracket standings for a season, by points
+	GetBracketStandings(ctx context.Context, in *GetBracketStandingsRequest, opts ...grpc.CallOption) (*GetBracketStandingsResponse, error)
+	// Graded predictions and rank changes as they happen (server streaming)
+	WatchLeaderboard(ctx context.Context, in *WatchLeaderboardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LeaderboardUpdate], error)
 }
 
 type leaderboardServiceClient struct {
@@ -90,6 +129,16 @@ func (c *leaderboardServiceClient) GetUserRank(ctx context.Context, in *GetUserR
 	return out, nil
 }
 
+func (c *leaderboardServiceClient) GetUserWeeklyStats(ctx context.Context, in *GetUserWeeklyStatsRequest, opts ...grpc.CallOption) (*GetUserWeeklyStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserWeeklyStatsResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetUserWeeklyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leaderboardServiceClient) RecalculateLeaderboard(ctx context.Context, in *RecalculateLeaderboardRequest, opts ...grpc.CallOption) (*RecalculateLeaderboardResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecalculateLeaderboardResponse)
@@ -100,6 +149,165 @@ func (c *leaderboardServiceClient) RecalculateLeaderboard(ctx context.Context, i
 	return out, nil
 }
 
+func (c *leaderboardServiceClient) ApplyPredictionResults(ctx context.Context, in *ApplyPredictionResultsRequest, opts ...grpc.CallOption) (*ApplyPredictionResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyPredictionResultsResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_ApplyPredictionResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) CreateLeague(ctx context.Context, in *CreateLeagueRequest, opts ...grpc.CallOption) (*CreateLeagueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLeagueResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_CreateLeague_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetLeague(ctx context.Context, in *GetLeagueRequest, opts ...grpc.CallOption) (*GetLeagueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeagueResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetLeague_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetUserLeagues(ctx context.Context, in *GetUserLeaguesRequest, opts ...grpc.CallOption) (*GetUserLeaguesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserLeaguesResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetUserLeagues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) CreateLeagueInvite(ctx context.Context, in *CreateLeagueInviteRequest, opts ...grpc.CallOption) (*CreateLeagueInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLeagueInviteResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_CreateLeagueInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetLeagueInvites(ctx context.Context, in *GetLeagueInvitesRequest, opts ...grpc.CallOption) (*GetLeagueInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeagueInvitesResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetLeagueInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) RevokeLeagueInvite(ctx context.Context, in *RevokeLeagueInviteRequest, opts ...grpc.CallOption) (*RevokeLeagueInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeLeagueInviteResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_RevokeLeagueInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) JoinLeague(ctx context.Context, in *JoinLeagueRequest, opts ...grpc.CallOption) (*JoinLeagueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinLeagueResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_JoinLeague_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) LeaveLeague(ctx context.Context, in *LeaveLeagueRequest, opts ...grpc.CallOption) (*LeaveLeagueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveLeagueResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_LeaveLeague_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) UpdateLeagueMember(ctx context.Context, in *UpdateLeagueMemberRequest, opts ...grpc.CallOption) (*UpdateLeagueMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLeagueMemberResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_UpdateLeagueMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) RemoveLeagueMember(ctx context.Context, in *RemoveLeagueMemberRequest, opts ...grpc.CallOption) (*RemoveLeagueMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveLeagueMemberResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_RemoveLeagueMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetLeagueLeaderboard(ctx context.Context, in *GetLeagueLeaderboardRequest, opts ...grpc.CallOption) (*GetLeagueLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeagueLeaderboardResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetLeagueLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetSurvivorStandings(ctx context.Context, in *GetSurvivorStandingsRequest, opts ...grpc.CallOption) (*GetSurvivorStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSurvivorStandingsResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetSurvivorStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) GetBracketStandings(ctx context.Context, in *GetBracketStandingsRequest, opts ...grpc.CallOption) (*GetBracketStandingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBracketStandingsResponse)
+	err := c.cc.Invoke(ctx, LeaderboardService_GetBracketStandings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardServiceClient) WatchLeaderboard(ctx context.Context, in *WatchLeaderboardRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LeaderboardUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LeaderboardService_ServiceDesc.Streams[0], LeaderboardService_WatchLeaderboard_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchLeaderboardRequest, LeaderboardUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LeaderboardService_WatchLeaderboardClient = grpc.ServerStreamingClient[LeaderboardUpdate]
+
 // LeaderboardServiceServer is the server API for LeaderboardService service.
 // All implementations must embed UnimplementedLeaderboardServiceServer
 // for forward compatibility.
@@ -112,8 +320,31 @@ type LeaderboardServiceServer interface {
 	GetTopUsers(context.Context, *GetTopUsersRequest) (*GetTopUsersResponse, error)
 	// Get user rank
 	GetUserRank(context.Context, *GetUserRankRequest) (*GetUserRankResponse, error)
+	// Get a user's week-by-week results for a season
+	GetUserWeeklyStats(context.Context, *GetUserWeeklyStatsRequest) (*GetUserWeeklyStatsResponse, error)
 	// Recalculate leaderboard (admin/internal)
 	RecalculateLeaderboard(context.Context, *RecalculateLeaderboardRequest) (*RecalculateLeaderboardResponse, error)
+	// Apply graded predictions to user stats (internal)
+	ApplyPredictionResults(context.Context, *ApplyPredictionResultsRequest) (*ApplyPredictionResultsResponse, error)
+	// Leagues (private pools)
+	CreateLeague(context.Context, *CreateLeagueRequest) (*CreateLeagueResponse, error)
+	GetLeague(context.Context, *GetLeagueRequest) (*GetLeagueResponse, error)
+	GetUserLeagues(context.Context, *GetUserLeaguesRequest) (*GetUserLeaguesResponse, error)
+	CreateLeagueInvite(context.Context, *CreateLeagueInviteRequest) (*CreateLeagueInviteResponse, error)
+	GetLeagueInvites(context.Context, *GetLeagueInvitesRequest) (*GetLeagueInvitesResponse, error)
+	RevokeLeagueInvite(context.Context, *RevokeLeagueInviteRequest) (*RevokeLeagueInviteResponse, error)
+	JoinLeague(context.Context, *JoinLeagueRequest) (*JoinLeagueResponse, error)
+	LeaveLeague(context.Context, *LeaveLeagueRequest) (*LeaveLeagueResponse, error)
+	UpdateLeagueMember(context.Context, *UpdateLeagueMemberRequest) (*UpdateLeagueMemberResponse, error)
+	RemoveLeagueMember(context.Context, *RemoveLeagueMemberRequest) (*RemoveLeagueMemberResponse, error)
+	// Standings of a league, computed from the same graded predictions
+	GetLeagueLeaderboard(context.Context, *GetLeagueLeaderboardRequest) (*GetLeagueLeaderboardResponse, error)
+	// Survivor pool standings for a season: alive/eliminated state and elimination week
+	GetSurvivorStandings(context.Context, *GetSurvivorStandingsRequest) (*GetSurvivorStandingsResponse, error)
+	// Playoff bracket standings for a season, by points
+	GetBracketStandings(context.Context, *GetBracketStandingsRequest) (*GetBracketStandingsResponse, error)
+	// Graded predictions and rank changes as they happen (server streaming)
+	WatchLeaderboard(*WatchLeaderboardRequest, grpc.ServerStreamingServer[LeaderboardUpdate]) error
 	mustEmbedUnimplementedLeaderboardServiceServer()
 }
 
@@ -136,9 +367,57 @@ func (UnimplementedLeaderboardServiceServer) GetTopUsers(context.Context, *GetTo
 func (UnimplementedLeaderboardServiceServer) GetUserRank(context.Context, *GetUserRankRequest) (*GetUserRankResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserRank not implemented")
 }
+func (UnimplementedLeaderboardServiceServer) GetUserWeeklyStats(context.Context, *GetUserWeeklyStatsRequest) (*GetUserWeeklyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserWeeklyStats not implemented")
+}
 func (UnimplementedLeaderboardServiceServer) RecalculateLeaderboard(context.Context, *RecalculateLeaderboardRequest) (*RecalculateLeaderboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecalculateLeaderboard not implemented")
 }
+func (UnimplementedLeaderboardServiceServer) ApplyPredictionResults(context.Context, *ApplyPredictionResultsRequest) (*ApplyPredictionResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPredictionResults not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) CreateLeague(context.Context, *CreateLeagueRequest) (*CreateLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeague not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetLeague(context.Context, *GetLeagueRequest) (*GetLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeague not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetUserLeagues(context.Context, *GetUserLeaguesRequest) (*GetUserLeaguesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLeagues not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) CreateLeagueInvite(context.Context, *CreateLeagueInviteRequest) (*CreateLeagueInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLeagueInvite not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetLeagueInvites(context.Context, *GetLeagueInvitesRequest) (*GetLeagueInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeagueInvites not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) RevokeLeagueInvite(context.Context, *RevokeLeagueInviteRequest) (*RevokeLeagueInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLeagueInvite not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) JoinLeague(context.Context, *JoinLeagueRequest) (*JoinLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinLeague not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) LeaveLeague(context.Context, *LeaveLeagueRequest) (*LeaveLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveLeague not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) UpdateLeagueMember(context.Context, *UpdateLeagueMemberRequest) (*UpdateLeagueMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeagueMember not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) RemoveLeagueMember(context.Context, *RemoveLeagueMemberRequest) (*RemoveLeagueMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLeagueMember not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetLeagueLeaderboard(context.Context, *GetLeagueLeaderboardRequest) (*GetLeagueLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeagueLeaderboard not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetSurvivorStandings(context.Context, *GetSurvivorStandingsRequest) (*GetSurvivorStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSurvivorStandings not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) GetBracketStandings(context.Context, *GetBracketStandingsRequest) (*GetBracketStandingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBracketStandings not implemented")
+}
+func (UnimplementedLeaderboardServiceServer) WatchLeaderboard(*WatchLeaderboardRequest, grpc.ServerStreamingServer[LeaderboardUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLeaderboard not implemented")
+}
 func (UnimplementedLeaderboardServiceServer) mustEmbedUnimplementedLeaderboardServiceServer() {}
 func (UnimplementedLeaderboardServiceServer) testEmbeddedByValue()                            {}
 
@@ -232,6 +511,24 @@ func _LeaderboardService_GetUserRank_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaderboardService_GetUserWeeklyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserWeeklyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetUserWeeklyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetUserWeeklyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetUserWeeklyStats(ctx, req.(*GetUserWeeklyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeaderboardService_RecalculateLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecalculateLeaderboardRequest)
 	if err := dec(in); err != nil {
@@ -250,6 +547,269 @@ func _LeaderboardService_RecalculateLeaderboard_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaderboardService_ApplyPredictionResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPredictionResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).ApplyPredictionResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_ApplyPredictionResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).ApplyPredictionResults(ctx, req.(*ApplyPredictionResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_CreateLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).CreateLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_CreateLeague_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).CreateLeague(ctx, req.(*CreateLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetLeague_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetLeague(ctx, req.(*GetLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetUserLeagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLeaguesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetUserLeagues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetUserLeagues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetUserLeagues(ctx, req.(*GetUserLeaguesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_CreateLeagueInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLeagueInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).CreateLeagueInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_CreateLeagueInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).CreateLeagueInvite(ctx, req.(*CreateLeagueInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetLeagueInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetLeagueInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetLeagueInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetLeagueInvites(ctx, req.(*GetLeagueInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_RevokeLeagueInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLeagueInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).RevokeLeagueInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_RevokeLeagueInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).RevokeLeagueInvite(ctx, req.(*RevokeLeagueInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_JoinLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).JoinLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_JoinLeague_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).JoinLeague(ctx, req.(*JoinLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_LeaveLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).LeaveLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_LeaveLeague_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).LeaveLeague(ctx, req.(*LeaveLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_UpdateLeagueMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeagueMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).UpdateLeagueMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_UpdateLeagueMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).UpdateLeagueMember(ctx, req.(*UpdateLeagueMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_RemoveLeagueMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLeagueMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).RemoveLeagueMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_RemoveLeagueMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).RemoveLeagueMember(ctx, req.(*RemoveLeagueMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetLeagueLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeagueLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetLeagueLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetLeagueLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetLeagueLeaderboard(ctx, req.(*GetLeagueLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetSurvivorStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSurvivorStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetSurvivorStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetSurvivorStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetSurvivorStandings(ctx, req.(*GetSurvivorStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_GetBracketStandings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBracketStandingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServiceServer).GetBracketStandings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaderboardService_GetBracketStandings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServiceServer).GetBracketStandings(ctx, req.(*GetBracketStandingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaderboardService_WatchLeaderboard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLeaderboardRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeaderboardServiceServer).WatchLeaderboard(m, &grpc.GenericServerStream[WatchLeaderboardRequest, LeaderboardUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LeaderboardService_WatchLeaderboardServer = grpc.ServerStreamingServer[LeaderboardUpdate]
+
 // LeaderboardService_ServiceDesc is the grpc.ServiceDesc for LeaderboardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -273,11 +833,77 @@ var LeaderboardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserRank",
 			Handler:    _LeaderboardService_GetUserRank_Handler,
 		},
+		{
+			MethodName: "GetUserWeeklyStats",
+			Handler:    _LeaderboardService_GetUserWeeklyStats_Handler,
+		},
 		{
 			MethodName: "RecalculateLeaderboard",
 			Handler:    _LeaderboardService_RecalculateLeaderboard_Handler,
 		},
+		{
+			MethodName: "ApplyPredictionResults",
+			Handler:    _LeaderboardService_ApplyPredictionResults_Handler,
+		},
+		{
+			MethodName: "CreateLeague",
+			Handler:    _LeaderboardService_CreateLeague_Handler,
+		},
+		{
+			MethodName: "GetLeague",
+			Handler:    _LeaderboardService_GetLeague_Handler,
+		},
+		{
+			MethodName: "GetUserLeagues",
+			Handler:    _LeaderboardService_GetUserLeagues_Handler,
+		},
+		{
+			MethodName: "CreateLeagueInvite",
+			Handler:    _LeaderboardService_CreateLeagueInvite_Handler,
+		},
+		{
+			MethodName: "GetLeagueInvites",
+			Handler:    _LeaderboardService_GetLeagueInvites_Handler,
+		},
+		{
+			MethodName: "RevokeLeagueInvite",
+			Handler:    _LeaderboardService_RevokeLeagueInvite_Handler,
+		},
+		{
+			MethodName: "JoinLeague",
+			Handler:    _LeaderboardService_JoinLeague_Handler,
+		},
+		{
+			MethodName: "LeaveLeague",
+			Handler:    _LeaderboardService_LeaveLeague_Handler,
+		},
+		{
+			MethodName: "UpdateLeagueMember",
+			Handler:    _LeaderboardService_UpdateLeagueMember_Handler,
+		},
+		{
+			MethodName: "RemoveLeagueMember",
+			Handler:    _LeaderboardService_RemoveLeagueMember_Handler,
+		},
+		{
+			MethodName: "GetLeagueLeaderboard",
+			Handler:    _LeaderboardService_GetLeagueLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetSurvivorStandings",
+			Handler:    _LeaderboardService_GetSurvivorStandings_Handler,
+		},
+		{
+			MethodName: "GetBracketStandings",
+			Handler:    _LeaderboardService_GetBracketStandings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLeaderboard",
+			Handler:       _LeaderboardService_WatchLeaderboard_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/leaderboard_service.proto",
 }
@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -77,6 +78,58 @@ func (PredictionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_prediction_service_proto_rawDescGZIP(), []int{0}
 }
 
+type PredictionType int32
+
+const (
+	PredictionType_PREDICTION_TYPE_UNSPECIFIED PredictionType = 0 // Treated as WINNER
+	PredictionType_PREDICTION_TYPE_WINNER      PredictionType = 1 // Pick the winner (straight up or against the spread)
+	PredictionType_PREDICTION_TYPE_TOTAL       PredictionType = 2 // Over/under on the combined score
+	PredictionType_PREDICTION_TYPE_SURVIVOR    PredictionType = 3 // Survivor pool: one team per week, never reused in a season
+)
+
+// Enum value maps for PredictionType.
+var (
+	PredictionType_name = map[int32]string{
+		0: "PREDICTION_TYPE_UNSPECIFIED",
+		1: "PREDICTION_TYPE_WINNER",
+		2: "PREDICTION_TYPE_TOTAL",
+		3: "PREDICTION_TYPE_SURVIVOR",
+	}
+	PredictionType_value = map[string]int32{
+		"PREDICTION_TYPE_UNSPECIFIED": 0,
+		"PREDICTION_TYPE_WINNER":      1,
+		"PREDICTION_TYPE_TOTAL":       2,
+		"PREDICTION_TYPE_SURVIVOR":    3,
+	}
+)
+
+func (x PredictionType) Enum() *PredictionType {
+	p := new(PredictionType)
+	*p = x
+	return p
+}
+
+func (x PredictionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PredictionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_prediction_service_proto_enumTypes[1].Descriptor()
+}
+
+func (PredictionType) Type() protoreflect.EnumType {
+	return &file_proto_prediction_service_proto_enumTypes[1]
+}
+
+func (x PredictionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PredictionType.Descriptor instead.
+func (PredictionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{1}
+}
+
 type Prediction struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -87,6 +140,14 @@ type Prediction struct {
 	Points            int32                  `protobuf:"varint,6,opt,name=points,proto3" json:"points,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Week              int32                  `protobuf:"varint,9,opt,name=week,proto3" json:"week,omitempty"` // Copied from the game when the prediction is created
+	Season            int32                  `protobuf:"varint,10,opt,name=season,proto3" json:"season,omitempty"`
+	Confidence        int32                  `protobuf:"varint,11,opt,name=confidence,proto3" json:"confidence,omitempty"`                            // Confidence pool: 1..N (games in the week), unique within the user's week, 0 = standard pick
+	AgainstSpread     bool                   `protobuf:"varint,12,opt,name=against_spread,json=againstSpread,proto3" json:"against_spread,omitempty"` // ATS pick: graded against the spread instead of straight up
+	Spread            float64                `protobuf:"fixed64,13,opt,name=spread,proto3" json:"spread,omitempty"`                                   // Home line when the ATS pick was made
+	Type              PredictionType         `protobuf:"varint,14,opt,name=type,proto3,enum=proto.PredictionType" json:"type,omitempty"`
+	TotalPick         string                 `protobuf:"bytes,15,opt,name=total_pick,json=totalPick,proto3" json:"total_pick,omitempty"` // TOTAL picks: "over" or "under"
+	Total             float64                `protobuf:"fixed64,16,opt,name=total,proto3" json:"total,omitempty"`                        // Total line when the TOTAL pick was made
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -177,18 +238,138 @@ func (x *Prediction) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Prediction) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *Prediction) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *Prediction) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Prediction) GetAgainstSpread() bool {
+	if x != nil {
+		return x.AgainstSpread
+	}
+	return false
+}
+
+func (x *Prediction) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *Prediction) GetType() PredictionType {
+	if x != nil {
+		return x.Type
+	}
+	return PredictionType_PREDICTION_TYPE_UNSPECIFIED
+}
+
+func (x *Prediction) GetTotalPick() string {
+	if x != nil {
+		return x.TotalPick
+	}
+	return ""
+}
+
+func (x *Prediction) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// A single pick inside a confidence pool ranking
+type ConfidencePick struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GameId            string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PredictedWinnerId string                 `protobuf:"bytes,2,opt,name=predicted_winner_id,json=predictedWinnerId,proto3" json:"predicted_winner_id,omitempty"`
+	Confidence        int32                  `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"` // Points awarded if the pick is correct
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConfidencePick) Reset() {
+	*x = ConfidencePick{}
+	mi := &file_proto_prediction_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfidencePick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfidencePick) ProtoMessage() {}
+
+func (x *ConfidencePick) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfidencePick.ProtoReflect.Descriptor instead.
+func (*ConfidencePick) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfidencePick) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ConfidencePick) GetPredictedWinnerId() string {
+	if x != nil {
+		return x.PredictedWinnerId
+	}
+	return ""
+}
+
+func (x *ConfidencePick) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
 type CreatePredictionRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty = the caller; another user requires site_admin
 	GameId            string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	PredictedWinnerId string                 `protobuf:"bytes,3,opt,name=predicted_winner_id,json=predictedWinnerId,proto3" json:"predicted_winner_id,omitempty"`
+	AgainstSpread     bool                   `protobuf:"varint,4,opt,name=against_spread,json=againstSpread,proto3" json:"against_spread,omitempty"` // Pick the team to cover the game's current spread
+	Type              PredictionType         `protobuf:"varint,5,opt,name=type,proto3,enum=proto.PredictionType" json:"type,omitempty"`
+	TotalPick         string                 `protobuf:"bytes,6,opt,name=total_pick,json=totalPick,proto3" json:"total_pick,omitempty"` // Required for TOTAL picks: "over" or "under"
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CreatePredictionRequest) Reset() {
 	*x = CreatePredictionRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[1]
+	mi := &file_proto_prediction_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -200,7 +381,7 @@ func (x *CreatePredictionRequest) String() string {
 func (*CreatePredictionRequest) ProtoMessage() {}
 
 func (x *CreatePredictionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[1]
+	mi := &file_proto_prediction_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +394,7 @@ func (x *CreatePredictionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePredictionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePredictionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{1}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreatePredictionRequest) GetUserId() string {
@@ -237,6 +418,27 @@ func (x *CreatePredictionRequest) GetPredictedWinnerId() string {
 	return ""
 }
 
+func (x *CreatePredictionRequest) GetAgainstSpread() bool {
+	if x != nil {
+		return x.AgainstSpread
+	}
+	return false
+}
+
+func (x *CreatePredictionRequest) GetType() PredictionType {
+	if x != nil {
+		return x.Type
+	}
+	return PredictionType_PREDICTION_TYPE_UNSPECIFIED
+}
+
+func (x *CreatePredictionRequest) GetTotalPick() string {
+	if x != nil {
+		return x.TotalPick
+	}
+	return ""
+}
+
 type CreatePredictionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Prediction    *Prediction            `protobuf:"bytes,1,opt,name=prediction,proto3" json:"prediction,omitempty"`
@@ -247,7 +449,7 @@ type CreatePredictionResponse struct {
 
 func (x *CreatePredictionResponse) Reset() {
 	*x = CreatePredictionResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[2]
+	mi := &file_proto_prediction_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +461,7 @@ func (x *CreatePredictionResponse) String() string {
 func (*CreatePredictionResponse) ProtoMessage() {}
 
 func (x *CreatePredictionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[2]
+	mi := &file_proto_prediction_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +474,7 @@ func (x *CreatePredictionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePredictionResponse.ProtoReflect.Descriptor instead.
 func (*CreatePredictionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{2}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePredictionResponse) GetPrediction() *Prediction {
@@ -298,7 +500,7 @@ type GetPredictionByIDRequest struct {
 
 func (x *GetPredictionByIDRequest) Reset() {
 	*x = GetPredictionByIDRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[3]
+	mi := &file_proto_prediction_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -310,7 +512,7 @@ func (x *GetPredictionByIDRequest) String() string {
 func (*GetPredictionByIDRequest) ProtoMessage() {}
 
 func (x *GetPredictionByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[3]
+	mi := &file_proto_prediction_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -323,7 +525,7 @@ func (x *GetPredictionByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPredictionByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetPredictionByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{3}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetPredictionByIDRequest) GetPredictionId() string {
@@ -342,7 +544,7 @@ type GetPredictionByIDResponse struct {
 
 func (x *GetPredictionByIDResponse) Reset() {
 	*x = GetPredictionByIDResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[4]
+	mi := &file_proto_prediction_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -354,7 +556,7 @@ func (x *GetPredictionByIDResponse) String() string {
 func (*GetPredictionByIDResponse) ProtoMessage() {}
 
 func (x *GetPredictionByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[4]
+	mi := &file_proto_prediction_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +569,7 @@ func (x *GetPredictionByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPredictionByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetPredictionByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{4}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetPredictionByIDResponse) GetPrediction() *Prediction {
@@ -380,13 +582,15 @@ func (x *GetPredictionByIDResponse) GetPrediction() *Prediction {
 type GetUserPredictionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserPredictionsRequest) Reset() {
 	*x = GetUserPredictionsRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[5]
+	mi := &file_proto_prediction_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +602,7 @@ func (x *GetUserPredictionsRequest) String() string {
 func (*GetUserPredictionsRequest) ProtoMessage() {}
 
 func (x *GetUserPredictionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[5]
+	mi := &file_proto_prediction_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +615,7 @@ func (x *GetUserPredictionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPredictionsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserPredictionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{5}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetUserPredictionsRequest) GetUserId() string {
@@ -421,22 +625,37 @@ func (x *GetUserPredictionsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetUserPredictionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserPredictionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetUserPredictionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Predictions   []*Prediction          `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"` // The counters cover all pages
 	Correct       int32                  `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
 	Incorrect     int32                  `protobuf:"varint,5,opt,name=incorrect,proto3" json:"incorrect,omitempty"`
 	Pending       int32                  `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
 	Percentage    float64                `protobuf:"fixed64,7,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,8,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserPredictionsResponse) Reset() {
 	*x = GetUserPredictionsResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[6]
+	mi := &file_proto_prediction_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +667,7 @@ func (x *GetUserPredictionsResponse) String() string {
 func (*GetUserPredictionsResponse) ProtoMessage() {}
 
 func (x *GetUserPredictionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[6]
+	mi := &file_proto_prediction_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +680,7 @@ func (x *GetUserPredictionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPredictionsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserPredictionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{6}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetUserPredictionsResponse) GetUserId() string {
@@ -513,16 +732,25 @@ func (x *GetUserPredictionsResponse) GetPercentage() float64 {
 	return 0
 }
 
+func (x *GetUserPredictionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetGamePredictionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGamePredictionsRequest) Reset() {
 	*x = GetGamePredictionsRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[7]
+	mi := &file_proto_prediction_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +762,7 @@ func (x *GetGamePredictionsRequest) String() string {
 func (*GetGamePredictionsRequest) ProtoMessage() {}
 
 func (x *GetGamePredictionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[7]
+	mi := &file_proto_prediction_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +775,7 @@ func (x *GetGamePredictionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGamePredictionsRequest.ProtoReflect.Descriptor instead.
 func (*GetGamePredictionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{7}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetGamePredictionsRequest) GetGameId() string {
@@ -557,18 +785,33 @@ func (x *GetGamePredictionsRequest) GetGameId() string {
 	return ""
 }
 
+func (x *GetGamePredictionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGamePredictionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetGamePredictionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Predictions   []*Prediction          `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                                       // Across all pages
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGamePredictionsResponse) Reset() {
 	*x = GetGamePredictionsResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[8]
+	mi := &file_proto_prediction_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +823,7 @@ func (x *GetGamePredictionsResponse) String() string {
 func (*GetGamePredictionsResponse) ProtoMessage() {}
 
 func (x *GetGamePredictionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[8]
+	mi := &file_proto_prediction_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +836,7 @@ func (x *GetGamePredictionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGamePredictionsResponse.ProtoReflect.Descriptor instead.
 func (*GetGamePredictionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{8}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetGamePredictionsResponse) GetGameId() string {
@@ -617,16 +860,26 @@ func (x *GetGamePredictionsResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *GetGamePredictionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetWeekPredictionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Week          string                 `protobuf:"bytes,1,opt,name=week,proto3" json:"week,omitempty"`
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`                       // Optional: 0 = any season
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetWeekPredictionsRequest) Reset() {
 	*x = GetWeekPredictionsRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[9]
+	mi := &file_proto_prediction_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -638,7 +891,7 @@ func (x *GetWeekPredictionsRequest) String() string {
 func (*GetWeekPredictionsRequest) ProtoMessage() {}
 
 func (x *GetWeekPredictionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[9]
+	mi := &file_proto_prediction_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +904,7 @@ func (x *GetWeekPredictionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWeekPredictionsRequest.ProtoReflect.Descriptor instead.
 func (*GetWeekPredictionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{9}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetWeekPredictionsRequest) GetWeek() string {
@@ -661,18 +914,40 @@ func (x *GetWeekPredictionsRequest) GetWeek() string {
 	return ""
 }
 
+func (x *GetWeekPredictionsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetWeekPredictionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetWeekPredictionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetWeekPredictionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Week          string                 `protobuf:"bytes,1,opt,name=week,proto3" json:"week,omitempty"`
 	Predictions   []*Prediction          `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                                       // Across all pages
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetWeekPredictionsResponse) Reset() {
 	*x = GetWeekPredictionsResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[10]
+	mi := &file_proto_prediction_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -684,7 +959,7 @@ func (x *GetWeekPredictionsResponse) String() string {
 func (*GetWeekPredictionsResponse) ProtoMessage() {}
 
 func (x *GetWeekPredictionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[10]
+	mi := &file_proto_prediction_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +972,7 @@ func (x *GetWeekPredictionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWeekPredictionsResponse.ProtoReflect.Descriptor instead.
 func (*GetWeekPredictionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{10}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetWeekPredictionsResponse) GetWeek() string {
@@ -721,18 +996,36 @@ func (x *GetWeekPredictionsResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *GetWeekPredictionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetAllPredictionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Empty for now, can add pagination later
-	Page          int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Deprecated: Marked as deprecated in proto/prediction_service.proto.
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                           // Only 0 or 1 (the first page): use page_token
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // "created_at" (default) or "week"; "-" prefix for descending
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,6,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Season        int32                  `protobuf:"varint,7,opt,name=season,proto3" json:"season,omitempty"`
+	WeekFrom      int32                  `protobuf:"varint,8,opt,name=week_from,json=weekFrom,proto3" json:"week_from,omitempty"` // Inclusive
+	WeekTo        int32                  `protobuf:"varint,9,opt,name=week_to,json=weekTo,proto3" json:"week_to,omitempty"`       // Inclusive
+	Status        PredictionStatus       `protobuf:"varint,10,opt,name=status,proto3,enum=proto.PredictionStatus" json:"status,omitempty"`
+	Type          PredictionType         `protobuf:"varint,11,opt,name=type,proto3,enum=proto.PredictionType" json:"type,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // Inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // Exclusive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllPredictionsRequest) Reset() {
 	*x = GetAllPredictionsRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[11]
+	mi := &file_proto_prediction_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -744,7 +1037,7 @@ func (x *GetAllPredictionsRequest) String() string {
 func (*GetAllPredictionsRequest) ProtoMessage() {}
 
 func (x *GetAllPredictionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[11]
+	mi := &file_proto_prediction_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,9 +1050,10 @@ func (x *GetAllPredictionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPredictionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllPredictionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{11}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{12}
 }
 
+// Deprecated: Marked as deprecated in proto/prediction_service.proto.
 func (x *GetAllPredictionsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
@@ -774,17 +1068,95 @@ func (x *GetAllPredictionsRequest) GetPageSize() int32 {
 	return 0
 }
 
-type GetAllPredictionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Predictions   []*Prediction          `protobuf:"bytes,1,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAllPredictionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func (x *GetAllPredictionsResponse) Reset() {
-	*x = GetAllPredictionsResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[12]
+func (x *GetAllPredictionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllPredictionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAllPredictionsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetAllPredictionsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetAllPredictionsRequest) GetWeekFrom() int32 {
+	if x != nil {
+		return x.WeekFrom
+	}
+	return 0
+}
+
+func (x *GetAllPredictionsRequest) GetWeekTo() int32 {
+	if x != nil {
+		return x.WeekTo
+	}
+	return 0
+}
+
+func (x *GetAllPredictionsRequest) GetStatus() PredictionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PredictionStatus_PREDICTION_STATUS_UNSPECIFIED
+}
+
+func (x *GetAllPredictionsRequest) GetType() PredictionType {
+	if x != nil {
+		return x.Type
+	}
+	return PredictionType_PREDICTION_TYPE_UNSPECIFIED
+}
+
+func (x *GetAllPredictionsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *GetAllPredictionsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type GetAllPredictionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Predictions   []*Prediction          `protobuf:"bytes,1,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // Predictions matching the filters, across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPredictionsResponse) Reset() {
+	*x = GetAllPredictionsResponse{}
+	mi := &file_proto_prediction_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -796,7 +1168,7 @@ func (x *GetAllPredictionsResponse) String() string {
 func (*GetAllPredictionsResponse) ProtoMessage() {}
 
 func (x *GetAllPredictionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[12]
+	mi := &file_proto_prediction_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +1181,7 @@ func (x *GetAllPredictionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPredictionsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllPredictionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{12}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetAllPredictionsResponse) GetPredictions() []*Prediction {
@@ -826,6 +1198,13 @@ func (x *GetAllPredictionsResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *GetAllPredictionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type DeletePredictionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PredictionId  string                 `protobuf:"bytes,1,opt,name=prediction_id,json=predictionId,proto3" json:"prediction_id,omitempty"`
@@ -835,7 +1214,7 @@ type DeletePredictionRequest struct {
 
 func (x *DeletePredictionRequest) Reset() {
 	*x = DeletePredictionRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[13]
+	mi := &file_proto_prediction_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -847,7 +1226,7 @@ func (x *DeletePredictionRequest) String() string {
 func (*DeletePredictionRequest) ProtoMessage() {}
 
 func (x *DeletePredictionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[13]
+	mi := &file_proto_prediction_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1239,7 @@ func (x *DeletePredictionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePredictionRequest.ProtoReflect.Descriptor instead.
 func (*DeletePredictionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{13}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeletePredictionRequest) GetPredictionId() string {
@@ -880,7 +1259,7 @@ type DeletePredictionResponse struct {
 
 func (x *DeletePredictionResponse) Reset() {
 	*x = DeletePredictionResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[14]
+	mi := &file_proto_prediction_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -892,7 +1271,7 @@ func (x *DeletePredictionResponse) String() string {
 func (*DeletePredictionResponse) ProtoMessage() {}
 
 func (x *DeletePredictionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[14]
+	mi := &file_proto_prediction_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,7 +1284,7 @@ func (x *DeletePredictionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePredictionResponse.ProtoReflect.Descriptor instead.
 func (*DeletePredictionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{14}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeletePredictionResponse) GetSuccess() bool {
@@ -933,7 +1312,7 @@ type UpdatePredictionStatusRequest struct {
 
 func (x *UpdatePredictionStatusRequest) Reset() {
 	*x = UpdatePredictionStatusRequest{}
-	mi := &file_proto_prediction_service_proto_msgTypes[15]
+	mi := &file_proto_prediction_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -945,7 +1324,7 @@ func (x *UpdatePredictionStatusRequest) String() string {
 func (*UpdatePredictionStatusRequest) ProtoMessage() {}
 
 func (x *UpdatePredictionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[15]
+	mi := &file_proto_prediction_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1337,7 @@ func (x *UpdatePredictionStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePredictionStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePredictionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{15}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdatePredictionStatusRequest) GetPredictionId() string {
@@ -992,7 +1371,7 @@ type UpdatePredictionStatusResponse struct {
 
 func (x *UpdatePredictionStatusResponse) Reset() {
 	*x = UpdatePredictionStatusResponse{}
-	mi := &file_proto_prediction_service_proto_msgTypes[16]
+	mi := &file_proto_prediction_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1383,7 @@ func (x *UpdatePredictionStatusResponse) String() string {
 func (*UpdatePredictionStatusResponse) ProtoMessage() {}
 
 func (x *UpdatePredictionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_prediction_service_proto_msgTypes[16]
+	mi := &file_proto_prediction_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1396,7 @@ func (x *UpdatePredictionStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePredictionStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePredictionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_prediction_service_proto_rawDescGZIP(), []int{16}
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdatePredictionStatusResponse) GetPrediction() *Prediction {
@@ -1034,97 +1413,1087 @@ func (x *UpdatePredictionStatusResponse) GetMessage() string {
 	return ""
 }
 
-var File_proto_prediction_service_proto protoreflect.FileDescriptor
+type SubmitConfidencePicksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty = the caller; another user requires site_admin
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Week          int32                  `protobuf:"varint,3,opt,name=week,proto3" json:"week,omitempty"`
+	Picks         []*ConfidencePick      `protobuf:"bytes,4,rep,name=picks,proto3" json:"picks,omitempty"` // Picks of the week, confidences 1..N (games in the week) used at most once; partial rankings are allowed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_prediction_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1eproto/prediction_service.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbd\x02\n" +
-	"\n" +
-	"Prediction\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
-	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12.\n" +
-	"\x13predicted_winner_id\x18\x04 \x01(\tR\x11predictedWinnerId\x12/\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x17.proto.PredictionStatusR\x06status\x12\x16\n" +
-	"\x06points\x18\x06 \x01(\x05R\x06points\x129\n" +
-	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"{\n" +
-	"\x17CreatePredictionRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
-	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12.\n" +
-	"\x13predicted_winner_id\x18\x03 \x01(\tR\x11predictedWinnerId\"g\n" +
-	"\x18CreatePredictionResponse\x121\n" +
-	"\n" +
-	"prediction\x18\x01 \x01(\v2\x11.proto.PredictionR\n" +
-	"prediction\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"?\n" +
-	"\x18GetPredictionByIDRequest\x12#\n" +
-	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\"N\n" +
-	"\x19GetPredictionByIDResponse\x121\n" +
-	"\n" +
-	"prediction\x18\x01 \x01(\v2\x11.proto.PredictionR\n" +
-	"prediction\"4\n" +
-	"\x19GetUserPredictionsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xf2\x01\n" +
-	"\x1aGetUserPredictionsResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x123\n" +
-	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
-	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x18\n" +
-	"\acorrect\x18\x04 \x01(\x05R\acorrect\x12\x1c\n" +
-	"\tincorrect\x18\x05 \x01(\x05R\tincorrect\x12\x18\n" +
-	"\apending\x18\x06 \x01(\x05R\apending\x12\x1e\n" +
-	"\n" +
-	"percentage\x18\a \x01(\x01R\n" +
-	"percentage\"4\n" +
-	"\x19GetGamePredictionsRequest\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x80\x01\n" +
-	"\x1aGetGamePredictionsResponse\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\x123\n" +
-	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
-	"\x05total\x18\x03 \x01(\x05R\x05total\"/\n" +
-	"\x19GetWeekPredictionsRequest\x12\x12\n" +
-	"\x04week\x18\x01 \x01(\tR\x04week\"{\n" +
-	"\x1aGetWeekPredictionsResponse\x12\x12\n" +
-	"\x04week\x18\x01 \x01(\tR\x04week\x123\n" +
-	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
-	"\x05total\x18\x03 \x01(\x05R\x05total\"K\n" +
-	"\x18GetAllPredictionsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"f\n" +
-	"\x19GetAllPredictionsResponse\x123\n" +
-	"\vpredictions\x18\x01 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\">\n" +
-	"\x17DeletePredictionRequest\x12#\n" +
-	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\"N\n" +
-	"\x18DeletePredictionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x8d\x01\n" +
-	"\x1dUpdatePredictionStatusRequest\x12#\n" +
-	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\x12/\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x17.proto.PredictionStatusR\x06status\x12\x16\n" +
-	"\x06points\x18\x03 \x01(\x05R\x06points\"m\n" +
-	"\x1eUpdatePredictionStatusResponse\x121\n" +
-	"\n" +
-	"prediction\x18\x01 \x01(\v2\x11.proto.PredictionR\n" +
-	"prediction\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*\xb0\x01\n" +
-	"\x10PredictionStatus\x12!\n" +
-	"\x1dPREDICTION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
-	"\x19PREDICTION_STATUS_PENDING\x10\x01\x12\x1d\n" +
-	"\x19PREDICTION_STATUS_CORRECT\x10\x02\x12\x1f\n" +
-	"\x1bPREDICTION_STATUS_INCORRECT\x10\x03\x12\x1a\n" +
-	"\x16PREDICTION_STATUS_VOID\x10\x042\xe5\x05\n" +
-	"\x11PredictionService\x12S\n" +
-	"\x10CreatePrediction\x12\x1e.proto.CreatePredictionRequest\x1a\x1f.proto.CreatePredictionResponse\x12V\n" +
-	"\x11GetPredictionByID\x12\x1f.proto.GetPredictionByIDRequest\x1a .proto.GetPredictionByIDResponse\x12Y\n" +
-	"\x12GetUserPredictions\x12 .proto.GetUserPredictionsRequest\x1a!.proto.GetUserPredictionsResponse\x12Y\n" +
-	"\x12GetGamePredictions\x12 .proto.GetGamePredictionsRequest\x1a!.proto.GetGamePredictionsResponse\x12Y\n" +
-	"\x12GetWeekPredictions\x12 .proto.GetWeekPredictionsRequest\x1a!.proto.GetWeekPredictionsResponse\x12V\n" +
-	"\x11GetAllPredictions\x12\x1f.proto.GetAllPredictionsRequest\x1a .proto.GetAllPredictionsResponse\x12S\n" +
-	"\x10DeletePrediction\x12\x1e.proto.DeletePredictionRequest\x1a\x1f.proto.DeletePredictionResponse\x12e\n" +
-	"\x16UpdatePredictionStatus\x12$.proto.UpdatePredictionStatusRequest\x1a%.proto.UpdatePredictionStatusResponseB\x19Z\x17kickoff.com/proto;protob\x06proto3"
+func (x *SubmitConfidencePicksRequest) Reset() {
+	*x = SubmitConfidencePicksRequest{}
+	mi := &file_proto_prediction_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitConfidencePicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitConfidencePicksRequest) ProtoMessage() {}
+
+func (x *SubmitConfidencePicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitConfidencePicksRequest.ProtoReflect.Descriptor instead.
+func (*SubmitConfidencePicksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SubmitConfidencePicksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitConfidencePicksRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *SubmitConfidencePicksRequest) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *SubmitConfidencePicksRequest) GetPicks() []*ConfidencePick {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+type SubmitConfidencePicksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Predictions   []*Prediction          `protobuf:"bytes,1,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitConfidencePicksResponse) Reset() {
+	*x = SubmitConfidencePicksResponse{}
+	mi := &file_proto_prediction_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitConfidencePicksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitConfidencePicksResponse) ProtoMessage() {}
+
+func (x *SubmitConfidencePicksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitConfidencePicksResponse.ProtoReflect.Descriptor instead.
+func (*SubmitConfidencePicksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubmitConfidencePicksResponse) GetPredictions() []*Prediction {
+	if x != nil {
+		return x.Predictions
+	}
+	return nil
+}
+
+func (x *SubmitConfidencePicksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Survivor pool entry of a user for a season
+type SurvivorEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season         int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	Alive          bool                   `protobuf:"varint,3,opt,name=alive,proto3" json:"alive,omitempty"`
+	EliminatedWeek int32                  `protobuf:"varint,4,opt,name=eliminated_week,json=eliminatedWeek,proto3" json:"eliminated_week,omitempty"` // 0 while alive
+	WeeksSurvived  int32                  `protobuf:"varint,5,opt,name=weeks_survived,json=weeksSurvived,proto3" json:"weeks_survived,omitempty"`    // Winning picks before elimination
+	UsedTeamIds    []string               `protobuf:"bytes,6,rep,name=used_team_ids,json=usedTeamIds,proto3" json:"used_team_ids,omitempty"`
+	Picks          []*Prediction          `protobuf:"bytes,7,rep,name=picks,proto3" json:"picks,omitempty"` // Ordered by week
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SurvivorEntry) Reset() {
+	*x = SurvivorEntry{}
+	mi := &file_proto_prediction_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurvivorEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurvivorEntry) ProtoMessage() {}
+
+func (x *SurvivorEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurvivorEntry.ProtoReflect.Descriptor instead.
+func (*SurvivorEntry) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SurvivorEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SurvivorEntry) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *SurvivorEntry) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *SurvivorEntry) GetEliminatedWeek() int32 {
+	if x != nil {
+		return x.EliminatedWeek
+	}
+	return 0
+}
+
+func (x *SurvivorEntry) GetWeeksSurvived() int32 {
+	if x != nil {
+		return x.WeeksSurvived
+	}
+	return 0
+}
+
+func (x *SurvivorEntry) GetUsedTeamIds() []string {
+	if x != nil {
+		return x.UsedTeamIds
+	}
+	return nil
+}
+
+func (x *SurvivorEntry) GetPicks() []*Prediction {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+type GetSurvivorEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty = the caller; another user requires site_admin
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSurvivorEntryRequest) Reset() {
+	*x = GetSurvivorEntryRequest{}
+	mi := &file_proto_prediction_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSurvivorEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurvivorEntryRequest) ProtoMessage() {}
+
+func (x *GetSurvivorEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurvivorEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetSurvivorEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSurvivorEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetSurvivorEntryRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+type GetSurvivorEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *SurvivorEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSurvivorEntryResponse) Reset() {
+	*x = GetSurvivorEntryResponse{}
+	mi := &file_proto_prediction_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSurvivorEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurvivorEntryResponse) ProtoMessage() {}
+
+func (x *GetSurvivorEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurvivorEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetSurvivorEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSurvivorEntryResponse) GetEntry() *SurvivorEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// Playoff bracket of a user: every winner picked before the Wild Card round.
+// Rounds are "wild_card", "divisional", "conference" and "super_bowl"; a
+// correct pick is worth 1, 2, 4 and 8 points.
+type BracketPick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         string                 `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Status        PredictionStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=proto.PredictionStatus" json:"status,omitempty"` // Incorrect as soon as the team is eliminated
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketPick) Reset() {
+	*x = BracketPick{}
+	mi := &file_proto_prediction_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketPick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketPick) ProtoMessage() {}
+
+func (x *BracketPick) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketPick.ProtoReflect.Descriptor instead.
+func (*BracketPick) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BracketPick) GetRound() string {
+	if x != nil {
+		return x.Round
+	}
+	return ""
+}
+
+func (x *BracketPick) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *BracketPick) GetStatus() PredictionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PredictionStatus_PREDICTION_STATUS_UNSPECIFIED
+}
+
+func (x *BracketPick) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type Bracket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Season        int32                  `protobuf:"varint,3,opt,name=season,proto3" json:"season,omitempty"`
+	Picks         []*BracketPick         `protobuf:"bytes,4,rep,name=picks,proto3" json:"picks,omitempty"` // Ordered by round
+	Points        int32                  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	Correct       int32                  `protobuf:"varint,6,opt,name=correct,proto3" json:"correct,omitempty"`
+	Incorrect     int32                  `protobuf:"varint,7,opt,name=incorrect,proto3" json:"incorrect,omitempty"`
+	MaxPoints     int32                  `protobuf:"varint,8,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"` // Points plus every pick still pending
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bracket) Reset() {
+	*x = Bracket{}
+	mi := &file_proto_prediction_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bracket) ProtoMessage() {}
+
+func (x *Bracket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bracket.ProtoReflect.Descriptor instead.
+func (*Bracket) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Bracket) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Bracket) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Bracket) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *Bracket) GetPicks() []*BracketPick {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+func (x *Bracket) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Bracket) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *Bracket) GetIncorrect() int32 {
+	if x != nil {
+		return x.Incorrect
+	}
+	return 0
+}
+
+func (x *Bracket) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *Bracket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Bracket) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SubmitBracketRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty = the caller; another user requires site_admin
+	Season              int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	WildCardWinners     []string               `protobuf:"bytes,3,rep,name=wild_card_winners,json=wildCardWinners,proto3" json:"wild_card_winners,omitempty"`           // 6 teams, one per Wild Card game
+	DivisionalWinners   []string               `protobuf:"bytes,4,rep,name=divisional_winners,json=divisionalWinners,proto3" json:"divisional_winners,omitempty"`       // 4 teams, from the matchups the picks above produce
+	ConferenceChampions []string               `protobuf:"bytes,5,rep,name=conference_champions,json=conferenceChampions,proto3" json:"conference_champions,omitempty"` // 2 teams
+	SuperBowlChampion   string                 `protobuf:"bytes,6,opt,name=super_bowl_champion,json=superBowlChampion,proto3" json:"super_bowl_champion,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SubmitBracketRequest) Reset() {
+	*x = SubmitBracketRequest{}
+	mi := &file_proto_prediction_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitBracketRequest) ProtoMessage() {}
+
+func (x *SubmitBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitBracketRequest.ProtoReflect.Descriptor instead.
+func (*SubmitBracketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubmitBracketRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitBracketRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *SubmitBracketRequest) GetWildCardWinners() []string {
+	if x != nil {
+		return x.WildCardWinners
+	}
+	return nil
+}
+
+func (x *SubmitBracketRequest) GetDivisionalWinners() []string {
+	if x != nil {
+		return x.DivisionalWinners
+	}
+	return nil
+}
+
+func (x *SubmitBracketRequest) GetConferenceChampions() []string {
+	if x != nil {
+		return x.ConferenceChampions
+	}
+	return nil
+}
+
+func (x *SubmitBracketRequest) GetSuperBowlChampion() string {
+	if x != nil {
+		return x.SuperBowlChampion
+	}
+	return ""
+}
+
+type SubmitBracketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bracket       *Bracket               `protobuf:"bytes,1,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitBracketResponse) Reset() {
+	*x = SubmitBracketResponse{}
+	mi := &file_proto_prediction_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitBracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitBracketResponse) ProtoMessage() {}
+
+func (x *SubmitBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitBracketResponse.ProtoReflect.Descriptor instead.
+func (*SubmitBracketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubmitBracketResponse) GetBracket() *Bracket {
+	if x != nil {
+		return x.Bracket
+	}
+	return nil
+}
+
+func (x *SubmitBracketResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetBracketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty = the caller; another user requires site_admin
+	Season        int32                  `protobuf:"varint,2,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBracketRequest) Reset() {
+	*x = GetBracketRequest{}
+	mi := &file_proto_prediction_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBracketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBracketRequest) ProtoMessage() {}
+
+func (x *GetBracketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBracketRequest.ProtoReflect.Descriptor instead.
+func (*GetBracketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetBracketRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBracketRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+type GetBracketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bracket       *Bracket               `protobuf:"bytes,1,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBracketResponse) Reset() {
+	*x = GetBracketResponse{}
+	mi := &file_proto_prediction_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBracketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBracketResponse) ProtoMessage() {}
+
+func (x *GetBracketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBracketResponse.ProtoReflect.Descriptor instead.
+func (*GetBracketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBracketResponse) GetBracket() *Bracket {
+	if x != nil {
+		return x.Bracket
+	}
+	return nil
+}
+
+type GradeGamePredictionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Regrade       bool                   `protobuf:"varint,2,opt,name=regrade,proto3" json:"regrade,omitempty"` // Also re-grade predictions that were already settled (score corrections)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeGamePredictionsRequest) Reset() {
+	*x = GradeGamePredictionsRequest{}
+	mi := &file_proto_prediction_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeGamePredictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeGamePredictionsRequest) ProtoMessage() {}
+
+func (x *GradeGamePredictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeGamePredictionsRequest.ProtoReflect.Descriptor instead.
+func (*GradeGamePredictionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GradeGamePredictionsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GradeGamePredictionsRequest) GetRegrade() bool {
+	if x != nil {
+		return x.Regrade
+	}
+	return false
+}
+
+type GradeGamePredictionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Predictions   []*Prediction          `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	Graded        int32                  `protobuf:"varint,3,opt,name=graded,proto3" json:"graded,omitempty"`
+	Correct       int32                  `protobuf:"varint,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	Incorrect     int32                  `protobuf:"varint,5,opt,name=incorrect,proto3" json:"incorrect,omitempty"`
+	Voided        int32                  `protobuf:"varint,6,opt,name=voided,proto3" json:"voided,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeGamePredictionsResponse) Reset() {
+	*x = GradeGamePredictionsResponse{}
+	mi := &file_proto_prediction_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeGamePredictionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeGamePredictionsResponse) ProtoMessage() {}
+
+func (x *GradeGamePredictionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prediction_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeGamePredictionsResponse.ProtoReflect.Descriptor instead.
+func (*GradeGamePredictionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prediction_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GradeGamePredictionsResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GradeGamePredictionsResponse) GetPredictions() []*Prediction {
+	if x != nil {
+		return x.Predictions
+	}
+	return nil
+}
+
+func (x *GradeGamePredictionsResponse) GetGraded() int32 {
+	if x != nil {
+		return x.Graded
+	}
+	return 0
+}
+
+func (x *GradeGamePredictionsResponse) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *GradeGamePredictionsResponse) GetIncorrect() int32 {
+	if x != nil {
+		return x.Incorrect
+	}
+	return 0
+}
+
+func (x *GradeGamePredictionsResponse) GetVoided() int32 {
+	if x != nil {
+		return x.Voided
+	}
+	return 0
+}
+
+var File_proto_prediction_service_proto protoreflect.FileDescriptor
+
+const file_proto_prediction_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1eproto/prediction_service.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x04\n" +
+	"\n" +
+	"Prediction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12.\n" +
+	"\x13predicted_winner_id\x18\x04 \x01(\tR\x11predictedWinnerId\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.proto.PredictionStatusR\x06status\x12\x16\n" +
+	"\x06points\x18\x06 \x01(\x05R\x06points\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04week\x18\t \x01(\x05R\x04week\x12\x16\n" +
+	"\x06season\x18\n" +
+	" \x01(\x05R\x06season\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\v \x01(\x05R\n" +
+	"confidence\x12%\n" +
+	"\x0eagainst_spread\x18\f \x01(\bR\ragainstSpread\x12\x16\n" +
+	"\x06spread\x18\r \x01(\x01R\x06spread\x12)\n" +
+	"\x04type\x18\x0e \x01(\x0e2\x15.proto.PredictionTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"total_pick\x18\x0f \x01(\tR\ttotalPick\x12\x14\n" +
+	"\x05total\x18\x10 \x01(\x01R\x05total\"y\n" +
+	"\x0eConfidencePick\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12.\n" +
+	"\x13predicted_winner_id\x18\x02 \x01(\tR\x11predictedWinnerId\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x05R\n" +
+	"confidence\"\xec\x01\n" +
+	"\x17CreatePredictionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12.\n" +
+	"\x13predicted_winner_id\x18\x03 \x01(\tR\x11predictedWinnerId\x12%\n" +
+	"\x0eagainst_spread\x18\x04 \x01(\bR\ragainstSpread\x12)\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x15.proto.PredictionTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"total_pick\x18\x06 \x01(\tR\ttotalPick\"g\n" +
+	"\x18CreatePredictionResponse\x121\n" +
+	"\n" +
+	"prediction\x18\x01 \x01(\v2\x11.proto.PredictionR\n" +
+	"prediction\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"?\n" +
+	"\x18GetPredictionByIDRequest\x12#\n" +
+	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\"N\n" +
+	"\x19GetPredictionByIDResponse\x121\n" +
+	"\n" +
+	"prediction\x18\x01 \x01(\v2\x11.proto.PredictionR\n" +
+	"prediction\"p\n" +
+	"\x19GetUserPredictionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9a\x02\n" +
+	"\x1aGetUserPredictionsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x123\n" +
+	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x18\n" +
+	"\acorrect\x18\x04 \x01(\x05R\acorrect\x12\x1c\n" +
+	"\tincorrect\x18\x05 \x01(\x05R\tincorrect\x12\x18\n" +
+	"\apending\x18\x06 \x01(\x05R\apending\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\a \x01(\x01R\n" +
+	"percentage\x12&\n" +
+	"\x0fnext_page_token\x18\b \x01(\tR\rnextPageToken\"p\n" +
+	"\x19GetGamePredictionsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa8\x01\n" +
+	"\x1aGetGamePredictionsResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x123\n" +
+	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x83\x01\n" +
+	"\x19GetWeekPredictionsRequest\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\tR\x04week\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa3\x01\n" +
+	"\x1aGetWeekPredictionsResponse\x12\x12\n" +
+	"\x04week\x18\x01 \x01(\tR\x04week\x123\n" +
+	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xe9\x03\n" +
+	"\x18GetAllPredictionsRequest\x12\x16\n" +
+	"\x04page\x18\x01 \x01(\x05B\x02\x18\x01R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x06 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06season\x18\a \x01(\x05R\x06season\x12\x1b\n" +
+	"\tweek_from\x18\b \x01(\x05R\bweekFrom\x12\x17\n" +
+	"\aweek_to\x18\t \x01(\x05R\x06weekTo\x12/\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x17.proto.PredictionStatusR\x06status\x12)\n" +
+	"\x04type\x18\v \x01(\x0e2\x15.proto.PredictionTypeR\x04type\x12?\n" +
+	"\rcreated_after\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"\x8e\x01\n" +
+	"\x19GetAllPredictionsResponse\x123\n" +
+	"\vpredictions\x18\x01 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\">\n" +
+	"\x17DeletePredictionRequest\x12#\n" +
+	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\"N\n" +
+	"\x18DeletePredictionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8d\x01\n" +
+	"\x1dUpdatePredictionStatusRequest\x12#\n" +
+	"\rprediction_id\x18\x01 \x01(\tR\fpredictionId\x12/\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x17.proto.PredictionStatusR\x06status\x12\x16\n" +
+	"\x06points\x18\x03 \x01(\x05R\x06points\"m\n" +
+	"\x1eUpdatePredictionStatusResponse\x121\n" +
+	"\n" +
+	"prediction\x18\x01 \x01(\v2\x11.proto.PredictionR\n" +
+	"prediction\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x90\x01\n" +
+	"\x1cSubmitConfidencePicksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04week\x18\x03 \x01(\x05R\x04week\x12+\n" +
+	"\x05picks\x18\x04 \x03(\v2\x15.proto.ConfidencePickR\x05picks\"n\n" +
+	"\x1dSubmitConfidencePicksResponse\x123\n" +
+	"\vpredictions\x18\x01 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf3\x01\n" +
+	"\rSurvivorEntry\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12\x14\n" +
+	"\x05alive\x18\x03 \x01(\bR\x05alive\x12'\n" +
+	"\x0feliminated_week\x18\x04 \x01(\x05R\x0eeliminatedWeek\x12%\n" +
+	"\x0eweeks_survived\x18\x05 \x01(\x05R\rweeksSurvived\x12\"\n" +
+	"\rused_team_ids\x18\x06 \x03(\tR\vusedTeamIds\x12'\n" +
+	"\x05picks\x18\a \x03(\v2\x11.proto.PredictionR\x05picks\"J\n" +
+	"\x17GetSurvivorEntryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\"F\n" +
+	"\x18GetSurvivorEntryResponse\x12*\n" +
+	"\x05entry\x18\x01 \x01(\v2\x14.proto.SurvivorEntryR\x05entry\"\x85\x01\n" +
+	"\vBracketPick\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\tR\x05round\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12/\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.proto.PredictionStatusR\x06status\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\"\xd9\x02\n" +
+	"\aBracket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x03 \x01(\x05R\x06season\x12(\n" +
+	"\x05picks\x18\x04 \x03(\v2\x12.proto.BracketPickR\x05picks\x12\x16\n" +
+	"\x06points\x18\x05 \x01(\x05R\x06points\x12\x18\n" +
+	"\acorrect\x18\x06 \x01(\x05R\acorrect\x12\x1c\n" +
+	"\tincorrect\x18\a \x01(\x05R\tincorrect\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\b \x01(\x05R\tmaxPoints\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x02\n" +
+	"\x14SubmitBracketRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\x12*\n" +
+	"\x11wild_card_winners\x18\x03 \x03(\tR\x0fwildCardWinners\x12-\n" +
+	"\x12divisional_winners\x18\x04 \x03(\tR\x11divisionalWinners\x121\n" +
+	"\x14conference_champions\x18\x05 \x03(\tR\x13conferenceChampions\x12.\n" +
+	"\x13super_bowl_champion\x18\x06 \x01(\tR\x11superBowlChampion\"[\n" +
+	"\x15SubmitBracketResponse\x12(\n" +
+	"\abracket\x18\x01 \x01(\v2\x0e.proto.BracketR\abracket\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"D\n" +
+	"\x11GetBracketRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06season\x18\x02 \x01(\x05R\x06season\">\n" +
+	"\x12GetBracketResponse\x12(\n" +
+	"\abracket\x18\x01 \x01(\v2\x0e.proto.BracketR\abracket\"P\n" +
+	"\x1bGradeGamePredictionsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x18\n" +
+	"\aregrade\x18\x02 \x01(\bR\aregrade\"\xd4\x01\n" +
+	"\x1cGradeGamePredictionsResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x123\n" +
+	"\vpredictions\x18\x02 \x03(\v2\x11.proto.PredictionR\vpredictions\x12\x16\n" +
+	"\x06graded\x18\x03 \x01(\x05R\x06graded\x12\x18\n" +
+	"\acorrect\x18\x04 \x01(\x05R\acorrect\x12\x1c\n" +
+	"\tincorrect\x18\x05 \x01(\x05R\tincorrect\x12\x16\n" +
+	"\x06voided\x18\x06 \x01(\x05R\x06voided*\xb0\x01\n" +
+	"\x10PredictionStatus\x12!\n" +
+	"\x1dPREDICTION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19PREDICTION_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19PREDICTION_STATUS_CORRECT\x10\x02\x12\x1f\n" +
+	"\x1bPREDICTION_STATUS_INCORRECT\x10\x03\x12\x1a\n" +
+	"\x16PREDICTION_STATUS_VOID\x10\x04*\x86\x01\n" +
+	"\x0ePredictionType\x12\x1f\n" +
+	"\x1bPREDICTION_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PREDICTION_TYPE_WINNER\x10\x01\x12\x19\n" +
+	"\x15PREDICTION_TYPE_TOTAL\x10\x02\x12\x1c\n" +
+	"\x18PREDICTION_TYPE_SURVIVOR\x10\x032\x91\r\n" +
+	"\x11PredictionService\x12p\n" +
+	"\x10CreatePrediction\x12\x1e.proto.CreatePredictionRequest\x1a\x1f.proto.CreatePredictionResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/predictions\x12\x80\x01\n" +
+	"\x11GetPredictionByID\x12\x1f.proto.GetPredictionByIDRequest\x1a .proto.GetPredictionByIDResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/predictions/{prediction_id}\x12\xa6\x01\n" +
+	"\x12GetUserPredictions\x12 .proto.GetUserPredictionsRequest\x1a!.proto.GetUserPredictionsResponse\"K\x82\xd3\xe4\x93\x02EZ!\x12\x1f/api/predictions/user/{user_id}\x12 /api/users/{user_id}/predictions\x12\x83\x01\n" +
+	"\x12GetGamePredictions\x12 .proto.GetGamePredictionsRequest\x1a!.proto.GetGamePredictionsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/games/{game_id}/predictions\x12\x82\x01\n" +
+	"\x12GetWeekPredictions\x12 .proto.GetWeekPredictionsRequest\x1a!.proto.GetWeekPredictionsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/predictions/by-week/{week}\x12p\n" +
+	"\x11GetAllPredictions\x12\x1f.proto.GetAllPredictionsRequest\x1a .proto.GetAllPredictionsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/predictions\x12}\n" +
+	"\x10DeletePrediction\x12\x1e.proto.DeletePredictionRequest\x1a\x1f.proto.DeletePredictionResponse\"(\x82\xd3\xe4\x93\x02\"* /api/predictions/{prediction_id}\x12\x99\x01\n" +
+	"\x16UpdatePredictionStatus\x12$.proto.UpdatePredictionStatusRequest\x1a%.proto.UpdatePredictionStatusResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/api/predictions/{prediction_id}/status\x12\x8a\x01\n" +
+	"\x15SubmitConfidencePicks\x12#.proto.SubmitConfidencePicksRequest\x1a$.proto.SubmitConfidencePicksResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/predictions/confidence\x12m\n" +
+	"\x10GetSurvivorEntry\x12\x1e.proto.GetSurvivorEntryRequest\x1a\x1f.proto.GetSurvivorEntryResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/survivor/me\x12d\n" +
+	"\rSubmitBracket\x12\x1b.proto.SubmitBracketRequest\x1a\x1c.proto.SubmitBracketResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/brackets\x12[\n" +
+	"\n" +
+	"GetBracket\x12\x18.proto.GetBracketRequest\x1a\x19.proto.GetBracketResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/brackets/me\x12\x86\x01\n" +
+	"\x14GradeGamePredictions\x12\".proto.GradeGamePredictionsRequest\x1a#.proto.GradeGamePredictionsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/games/{game_id}/gradeB\x19Z\x17kickoff.com/proto;protob\x06proto3"
 
 var (
 	file_proto_prediction_service_proto_rawDescOnce sync.Once
@@ -1138,62 +2507,104 @@ func file_proto_prediction_service_proto_rawDescGZIP() []byte {
 	return file_proto_prediction_service_proto_rawDescData
 }
 
-var file_proto_prediction_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_prediction_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_prediction_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_prediction_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_proto_prediction_service_proto_goTypes = []any{
 	(PredictionStatus)(0),                  // 0: proto.PredictionStatus
-	(*Prediction)(nil),                     // 1: proto.Prediction
-	(*CreatePredictionRequest)(nil),        // 2: proto.CreatePredictionRequest
-	(*CreatePredictionResponse)(nil),       // 3: proto.CreatePredictionResponse
-	(*GetPredictionByIDRequest)(nil),       // 4: proto.GetPredictionByIDRequest
-	(*GetPredictionByIDResponse)(nil),      // 5: proto.GetPredictionByIDResponse
-	(*GetUserPredictionsRequest)(nil),      // 6: proto.GetUserPredictionsRequest
-	(*GetUserPredictionsResponse)(nil),     // 7: proto.GetUserPredictionsResponse
-	(*GetGamePredictionsRequest)(nil),      // 8: proto.GetGamePredictionsRequest
-	(*GetGamePredictionsResponse)(nil),     // 9: proto.GetGamePredictionsResponse
-	(*GetWeekPredictionsRequest)(nil),      // 10: proto.GetWeekPredictionsRequest
-	(*GetWeekPredictionsResponse)(nil),     // 11: proto.GetWeekPredictionsResponse
-	(*GetAllPredictionsRequest)(nil),       // 12: proto.GetAllPredictionsRequest
-	(*GetAllPredictionsResponse)(nil),      // 13: proto.GetAllPredictionsResponse
-	(*DeletePredictionRequest)(nil),        // 14: proto.DeletePredictionRequest
-	(*DeletePredictionResponse)(nil),       // 15: proto.DeletePredictionResponse
-	(*UpdatePredictionStatusRequest)(nil),  // 16: proto.UpdatePredictionStatusRequest
-	(*UpdatePredictionStatusResponse)(nil), // 17: proto.UpdatePredictionStatusResponse
-	(*timestamppb.Timestamp)(nil),          // 18: google.protobuf.Timestamp
+	(PredictionType)(0),                    // 1: proto.PredictionType
+	(*Prediction)(nil),                     // 2: proto.Prediction
+	(*ConfidencePick)(nil),                 // 3: proto.ConfidencePick
+	(*CreatePredictionRequest)(nil),        // 4: proto.CreatePredictionRequest
+	(*CreatePredictionResponse)(nil),       // 5: proto.CreatePredictionResponse
+	(*GetPredictionByIDRequest)(nil),       // 6: proto.GetPredictionByIDRequest
+	(*GetPredictionByIDResponse)(nil),      // 7: proto.GetPredictionByIDResponse
+	(*GetUserPredictionsRequest)(nil),      // 8: proto.GetUserPredictionsRequest
+	(*GetUserPredictionsResponse)(nil),     // 9: proto.GetUserPredictionsResponse
+	(*GetGamePredictionsRequest)(nil),      // 10: proto.GetGamePredictionsRequest
+	(*GetGamePredictionsResponse)(nil),     // 11: proto.GetGamePredictionsResponse
+	(*GetWeekPredictionsRequest)(nil),      // 12: proto.GetWeekPredictionsRequest
+	(*GetWeekPredictionsResponse)(nil),     // 13: proto.GetWeekPredictionsResponse
+	(*GetAllPredictionsRequest)(nil),       // 14: proto.GetAllPredictionsRequest
+	(*GetAllPredictionsResponse)(nil),      // 15: proto.GetAllPredictionsResponse
+	(*DeletePredictionRequest)(nil),        // 16: proto.DeletePredictionRequest
+	(*DeletePredictionResponse)(nil),       // 17: proto.DeletePredictionResponse
+	(*UpdatePredictionStatusRequest)(nil),  // 18: proto.UpdatePredictionStatusRequest
+	(*UpdatePredictionStatusResponse)(nil), // 19: proto.UpdatePredictionStatusResponse
+	(*SubmitConfidencePicksRequest)(nil),   // 20: proto.SubmitConfidencePicksRequest
+	(*SubmitConfidencePicksResponse)(nil),  // 21: proto.SubmitConfidencePicksResponse
+	(*SurvivorEntry)(nil),                  // 22: proto.SurvivorEntry
+	(*GetSurvivorEntryRequest)(nil),        // 23: proto.GetSurvivorEntryRequest
+	(*GetSurvivorEntryResponse)(nil),       // 24: proto.GetSurvivorEntryResponse
+	(*BracketPick)(nil),                    // 25: proto.BracketPick
+	(*Bracket)(nil),                        // 26: proto.Bracket
+	(*SubmitBracketRequest)(nil),           // 27: proto.SubmitBracketRequest
+	(*SubmitBracketResponse)(nil),          // 28: proto.SubmitBracketResponse
+	(*GetBracketRequest)(nil),              // 29: proto.GetBracketRequest
+	(*GetBracketResponse)(nil),             // 30: proto.GetBracketResponse
+	(*GradeGamePredictionsRequest)(nil),    // 31: proto.GradeGamePredictionsRequest
+	(*GradeGamePredictionsResponse)(nil),   // 32: proto.GradeGamePredictionsResponse
+	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
 }
 var file_proto_prediction_service_proto_depIdxs = []int32{
 	0,  // 0: proto.Prediction.status:type_name -> proto.PredictionStatus
-	18, // 1: proto.Prediction.created_at:type_name -> google.protobuf.Timestamp
-	18, // 2: proto.Prediction.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: proto.CreatePredictionResponse.prediction:type_name -> proto.Prediction
-	1,  // 4: proto.GetPredictionByIDResponse.prediction:type_name -> proto.Prediction
-	1,  // 5: proto.GetUserPredictionsResponse.predictions:type_name -> proto.Prediction
-	1,  // 6: proto.GetGamePredictionsResponse.predictions:type_name -> proto.Prediction
-	1,  // 7: proto.GetWeekPredictionsResponse.predictions:type_name -> proto.Prediction
-	1,  // 8: proto.GetAllPredictionsResponse.predictions:type_name -> proto.Prediction
-	0,  // 9: proto.UpdatePredictionStatusRequest.status:type_name -> proto.PredictionStatus
-	1,  // 10: proto.UpdatePredictionStatusResponse.prediction:type_name -> proto.Prediction
-	2,  // 11: proto.PredictionService.CreatePrediction:input_type -> proto.CreatePredictionRequest
-	4,  // 12: proto.PredictionService.GetPredictionByID:input_type -> proto.GetPredictionByIDRequest
-	6,  // 13: proto.PredictionService.GetUserPredictions:input_type -> proto.GetUserPredictionsRequest
-	8,  // 14: proto.PredictionService.GetGamePredictions:input_type -> proto.GetGamePredictionsRequest
-	10, // 15: proto.PredictionService.GetWeekPredictions:input_type -> proto.GetWeekPredictionsRequest
-	12, // 16: proto.PredictionService.GetAllPredictions:input_type -> proto.GetAllPredictionsRequest
-	14, // 17: proto.PredictionService.DeletePrediction:input_type -> proto.DeletePredictionRequest
-	16, // 18: proto.PredictionService.UpdatePredictionStatus:input_type -> proto.UpdatePredictionStatusRequest
-	3,  // 19: proto.PredictionService.CreatePrediction:output_type -> proto.CreatePredictionResponse
-	5,  // 20: proto.PredictionService.GetPredictionByID:output_type -> proto.GetPredictionByIDResponse
-	7,  // 21: proto.PredictionService.GetUserPredictions:output_type -> proto.GetUserPredictionsResponse
-	9,  // 22: proto.PredictionService.GetGamePredictions:output_type -> proto.GetGamePredictionsResponse
-	11, // 23: proto.PredictionService.GetWeekPredictions:output_type -> proto.GetWeekPredictionsResponse
-	13, // 24: proto.PredictionService.GetAllPredictions:output_type -> proto.GetAllPredictionsResponse
-	15, // 25: proto.PredictionService.DeletePrediction:output_type -> proto.DeletePredictionResponse
-	17, // 26: proto.PredictionService.UpdatePredictionStatus:output_type -> proto.UpdatePredictionStatusResponse
-	19, // [19:27] is the sub-list for method output_type
-	11, // [11:19] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	33, // 1: proto.Prediction.created_at:type_name -> google.protobuf.Timestamp
+	33, // 2: proto.Prediction.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: proto.Prediction.type:type_name -> proto.PredictionType
+	1,  // 4: proto.CreatePredictionRequest.type:type_name -> proto.PredictionType
+	2,  // 5: proto.CreatePredictionResponse.prediction:type_name -> proto.Prediction
+	2,  // 6: proto.GetPredictionByIDResponse.prediction:type_name -> proto.Prediction
+	2,  // 7: proto.GetUserPredictionsResponse.predictions:type_name -> proto.Prediction
+	2,  // 8: proto.GetGamePredictionsResponse.predictions:type_name -> proto.Prediction
+	2,  // 9: proto.GetWeekPredictionsResponse.predictions:type_name -> proto.Prediction
+	0,  // 10: proto.GetAllPredictionsRequest.status:type_name -> proto.PredictionStatus
+	1,  // 11: proto.GetAllPredictionsRequest.type:type_name -> proto.PredictionType
+	33, // 12: proto.GetAllPredictionsRequest.created_after:type_name -> google.protobuf.Timestamp
+	33, // 13: proto.GetAllPredictionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	2,  // 14: proto.GetAllPredictionsResponse.predictions:type_name -> proto.Prediction
+	0,  // 15: proto.UpdatePredictionStatusRequest.status:type_name -> proto.PredictionStatus
+	2,  // 16: proto.UpdatePredictionStatusResponse.prediction:type_name -> proto.Prediction
+	3,  // 17: proto.SubmitConfidencePicksRequest.picks:type_name -> proto.ConfidencePick
+	2,  // 18: proto.SubmitConfidencePicksResponse.predictions:type_name -> proto.Prediction
+	2,  // 19: proto.SurvivorEntry.picks:type_name -> proto.Prediction
+	22, // 20: proto.GetSurvivorEntryResponse.entry:type_name -> proto.SurvivorEntry
+	0,  // 21: proto.BracketPick.status:type_name -> proto.PredictionStatus
+	25, // 22: proto.Bracket.picks:type_name -> proto.BracketPick
+	33, // 23: proto.Bracket.created_at:type_name -> google.protobuf.Timestamp
+	33, // 24: proto.Bracket.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 25: proto.SubmitBracketResponse.bracket:type_name -> proto.Bracket
+	26, // 26: proto.GetBracketResponse.bracket:type_name -> proto.Bracket
+	2,  // 27: proto.GradeGamePredictionsResponse.predictions:type_name -> proto.Prediction
+	4,  // 28: proto.PredictionService.CreatePrediction:input_type -> proto.CreatePredictionRequest
+	6,  // 29: proto.PredictionService.GetPredictionByID:input_type -> proto.GetPredictionByIDRequest
+	8,  // 30: proto.PredictionService.GetUserPredictions:input_type -> proto.GetUserPredictionsRequest
+	10, // 31: proto.PredictionService.GetGamePredictions:input_type -> proto.GetGamePredictionsRequest
+	12, // 32: proto.PredictionService.GetWeekPredictions:input_type -> proto.GetWeekPredictionsRequest
+	14, // 33: proto.PredictionService.GetAllPredictions:input_type -> proto.GetAllPredictionsRequest
+	16, // 34: proto.PredictionService.DeletePrediction:input_type -> proto.DeletePredictionRequest
+	18, // 35: proto.PredictionService.UpdatePredictionStatus:input_type -> proto.UpdatePredictionStatusRequest
+	20, // 36: proto.PredictionService.SubmitConfidencePicks:input_type -> proto.SubmitConfidencePicksRequest
+	23, // 37: proto.PredictionService.GetSurvivorEntry:input_type -> proto.GetSurvivorEntryRequest
+	27, // 38: proto.PredictionService.SubmitBracket:input_type -> proto.SubmitBracketRequest
+	29, // 39: proto.PredictionService.GetBracket:input_type -> proto.GetBracketRequest
+	31, // 40: proto.PredictionService.GradeGamePredictions:input_type -> proto.GradeGamePredictionsRequest
+	5,  // 41: proto.PredictionService.CreatePrediction:output_type -> proto.CreatePredictionResponse
+	7,  // 42: proto.PredictionService.GetPredictionByID:output_type -> proto.GetPredictionByIDResponse
+	9,  // 43: proto.PredictionService.GetUserPredictions:output_type -> proto.GetUserPredictionsResponse
+	11, // 44: proto.PredictionService.GetGamePredictions:output_type -> proto.GetGamePredictionsResponse
+	13, // 45: proto.PredictionService.GetWeekPredictions:output_type -> proto.GetWeekPredictionsResponse
+	15, // 46: proto.PredictionService.GetAllPredictions:output_type -> proto.GetAllPredictionsResponse
+	17, // 47: proto.PredictionService.DeletePrediction:output_type -> proto.DeletePredictionResponse
+	19, // 48: proto.PredictionService.UpdatePredictionStatus:output_type -> proto.UpdatePredictionStatusResponse
+	21, // 49: proto.PredictionService.SubmitConfidencePicks:output_type -> proto.SubmitConfidencePicksResponse
+	24, // 50: proto.PredictionService.GetSurvivorEntry:output_type -> proto.GetSurvivorEntryResponse
+	28, // 51: proto.PredictionService.SubmitBracket:output_type -> proto.SubmitBracketResponse
+	30, // 52: proto.PredictionService.GetBracket:output_type -> proto.GetBracketResponse
+	32, // 53: proto.PredictionService.GradeGamePredictions:output_type -> proto.GradeGamePredictionsResponse
+	41, // [41:54] is the sub-list for method output_type
+	28, // [28:41] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_proto_prediction_service_proto_init() }
@@ -1206,8 +2617,8 @@ func file_proto_prediction_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_prediction_service_proto_rawDesc), len(file_proto_prediction_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      2,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,6 +27,11 @@ const (
 	PredictionService_GetAllPredictions_FullMethodName      = "/proto.PredictionService/GetAllPredictions"
 	PredictionService_DeletePrediction_FullMethodName       = "/proto.PredictionService/DeletePrediction"
 	PredictionService_UpdatePredictionStatus_FullMethodName = "/proto.PredictionService/UpdatePredictionStatus"
+	PredictionService_SubmitConfidencePicks_FullMethodName  = "/proto.PredictionService/SubmitConfidencePicks"
+	PredictionService_GetSurvivorEntry_FullMethodName       = "/proto.PredictionService/GetSurvivorEntry"
+	PredictionService_SubmitBracket_FullMethodName          = "/proto.PredictionService/SubmitBracket"
+	PredictionService_GetBracket_FullMethodName             = "/proto.PredictionService/GetBracket"
+	PredictionService_GradeGamePredictions_FullMethodName   = "/proto.PredictionService/GradeGamePredictions"
 )
 
 // PredictionServiceClient is the client API for PredictionService service.
@@ -49,6 +54,16 @@ type PredictionServiceClient interface {
 	DeletePrediction(ctx context.Context, in *DeletePredictionRequest, opts ...grpc.CallOption) (*DeletePredictionResponse, error)
 	// Update prediction status (internal use - called when game finishes)
 	UpdatePredictionStatus(ctx context.Context, in *UpdatePredictionStatusRequest, opts ...grpc.CallOption) (*UpdatePredictionStatusResponse, error)
+	// Create or re-rank all of a user's picks for a week as a confidence pool
+	SubmitConfidencePicks(ctx context.Context, in *SubmitConfidencePicksRequest, opts ...grpc.CallOption) (*SubmitConfidencePicksResponse, error)
+	// Get a user's survivor pool entry for a season (survivor picks are created with CreatePrediction)
+	GetSurvivorEntry(ctx context.Context, in *GetSurvivorEntryRequest, opts ...grpc.CallOption) (*GetSurvivorEntryResponse, error)
+	// Create or replace a user's playoff bracket (until the first Wild Card kickoff)
+	SubmitBracket(ctx context.Context, in *SubmitBracketRequest, opts ...grpc.CallOption) (*SubmitBracketResponse, error)
+	// Get a user's playoff bracket with the picks graded so far
+	GetBracket(ctx context.Context, in *GetBracketRequest, opts ...grpc.CallOption) (*GetBracketResponse, error)
+	// Grade every prediction for a finished or canceled game (admin - settlement normally runs on GameStatusChanged events)
+	GradeGamePredictions(ctx context.Context, in *GradeGamePredictionsRequest, opts ...grpc.CallOption) (*GradeGamePredictionsResponse, error)
 }
 
 type predictionServiceClient struct {
@@ -139,6 +154,56 @@ func (c *predictionServiceClient) UpdatePredictionStatus(ctx context.Context, in
 	return out, nil
 }
 
+func (c *predictionServiceClient) SubmitConfidencePicks(ctx context.Context, in *SubmitConfidencePicksRequest, opts ...grpc.CallOption) (*SubmitConfidencePicksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitConfidencePicksResponse)
+	err := c.cc.Invoke(ctx, PredictionService_SubmitConfidencePicks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *predictionServiceClient) GetSurvivorEntry(ctx context.Context, in *GetSurvivorEntryRequest, opts ...grpc.CallOption) (*GetSurvivorEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSurvivorEntryResponse)
+	err := c.cc.Invoke(ctx, PredictionService_GetSurvivorEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *predictionServiceClient) SubmitBracket(ctx context.Context, in *SubmitBracketRequest, opts ...grpc.CallOption) (*SubmitBracketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitBracketResponse)
+	err := c.cc.Invoke(ctx, PredictionService_SubmitBracket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *predictionServiceClient) GetBracket(ctx context.Context, in *GetBracketRequest, opts ...grpc.CallOption) (*GetBracketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBracketResponse)
+	err := c.cc.Invoke(ctx, PredictionService_GetBracket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *predictionServiceClient) GradeGamePredictions(ctx context.Context, in *GradeGamePredictionsRequest, opts ...grpc.CallOption) (*GradeGamePredictionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeGamePredictionsResponse)
+	err := c.cc.Invoke(ctx, PredictionService_GradeGamePredictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PredictionServiceServer is the server API for PredictionService service.
 // All implementations must embed UnimplementedPredictionServiceServer
 // for forward compatibility.
@@ -159,6 +224,16 @@ type PredictionServiceServer interface {
 	DeletePrediction(context.Context, *DeletePredictionRequest) (*DeletePredictionResponse, error)
 	// Update prediction status (internal use - called when game finishes)
 	UpdatePredictionStatus(context.Context, *UpdatePredictionStatusRequest) (*UpdatePredictionStatusResponse, error)
+	// Create or re-rank all of a user's picks for a week as a confidence pool
+	SubmitConfidencePicks(context.Context, *SubmitConfidencePicksRequest) (*SubmitConfidencePicksResponse, error)
+	// Get a user's survivor pool entry for a season (survivor picks are created with CreatePrediction)
+	GetSurvivorEntry(context.Context, *GetSurvivorEntryRequest) (*GetSurvivorEntryResponse, error)
+	// Create or replace a user's playoff bracket (until the first Wild Card kickoff)
+	SubmitBracket(context.Context, *SubmitBracketRequest) (*SubmitBracketResponse, error)
+	// Get a user's playoff bracket with the picks graded so far
+	GetBracket(context.Context, *GetBracketRequest) (*GetBracketResponse, error)
+	// Grade every prediction for a finished or canceled game (admin - settlement normally runs on GameStatusChanged events)
+	GradeGamePredictions(context.Context, *GradeGamePredictionsRequest) (*GradeGamePredictionsResponse, error)
 	mustEmbedUnimplementedPredictionServiceServer()
 }
 
@@ -193,6 +268,21 @@ func (UnimplementedPredictionServiceServer) DeletePrediction(context.Context, *D
 func (UnimplementedPredictionServiceServer) UpdatePredictionStatus(context.Context, *UpdatePredictionStatusRequest) (*UpdatePredictionStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePredictionStatus not implemented")
 }
+func (UnimplementedPredictionServiceServer) SubmitConfidencePicks(context.Context, *SubmitConfidencePicksRequest) (*SubmitConfidencePicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitConfidencePicks not implemented")
+}
+func (UnimplementedPredictionServiceServer) GetSurvivorEntry(context.Context, *GetSurvivorEntryRequest) (*GetSurvivorEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSurvivorEntry not implemented")
+}
+func (UnimplementedPredictionServiceServer) SubmitBracket(context.Context, *SubmitBracketRequest) (*SubmitBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBracket not implemented")
+}
+func (UnimplementedPredictionServiceServer) GetBracket(context.Context, *GetBracketRequest) (*GetBracketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBracket not implemented")
+}
+func (UnimplementedPredictionServiceServer) GradeGamePredictions(context.Context, *GradeGamePredictionsRequest) (*GradeGamePredictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeGamePredictions not implemented")
+}
 func (UnimplementedPredictionServiceServer) mustEmbedUnimplementedPredictionServiceServer() {}
 func (UnimplementedPredictionServiceServer) testEmbeddedByValue()                           {}
 
@@ -358,6 +448,96 @@ func _PredictionService_UpdatePredictionStatus_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PredictionService_SubmitConfidencePicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitConfidencePicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PredictionServiceServer).SubmitConfidencePicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PredictionService_SubmitConfidencePicks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PredictionServiceServer).SubmitConfidencePicks(ctx, req.(*SubmitConfidencePicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PredictionService_GetSurvivorEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSurvivorEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PredictionServiceServer).GetSurvivorEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PredictionService_GetSurvivorEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PredictionServiceServer).GetSurvivorEntry(ctx, req.(*GetSurvivorEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PredictionService_SubmitBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PredictionServiceServer).SubmitBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PredictionService_SubmitBracket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PredictionServiceServer).SubmitBracket(ctx, req.(*SubmitBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PredictionService_GetBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBracketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PredictionServiceServer).GetBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PredictionService_GetBracket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PredictionServiceServer).GetBracket(ctx, req.(*GetBracketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PredictionService_GradeGamePredictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeGamePredictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PredictionServiceServer).GradeGamePredictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PredictionService_GradeGamePredictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PredictionServiceServer).GradeGamePredictions(ctx, req.(*GradeGamePredictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PredictionService_ServiceDesc is the grpc.ServiceDesc for PredictionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +577,26 @@ var PredictionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePredictionStatus",
 			Handler:    _PredictionService_UpdatePredictionStatus_Handler,
 		},
+		{
+			MethodName: "SubmitConfidencePicks",
+			Handler:    _PredictionService_SubmitConfidencePicks_Handler,
+		},
+		{
+			MethodName: "GetSurvivorEntry",
+			Handler:    _PredictionService_GetSurvivorEntry_Handler,
+		},
+		{
+			MethodName: "SubmitBracket",
+			Handler:    _PredictionService_SubmitBracket_Handler,
+		},
+		{
+			MethodName: "GetBracket",
+			Handler:    _PredictionService_GetBracket_Handler,
+		},
+		{
+			MethodName: "GradeGamePredictions",
+			Handler:    _PredictionService_GradeGamePredictions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/prediction_service.proto",
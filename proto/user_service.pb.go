@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +23,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Site-wide role; privileged RPCs of every service check it
+type UserRole int32
+
+const (
+	UserRole_USER_ROLE_UNSPECIFIED  UserRole = 0
+	UserRole_USER_ROLE_PLAYER       UserRole = 1
+	UserRole_USER_ROLE_LEAGUE_ADMIN UserRole = 2 // Manages any private league
+	UserRole_USER_ROLE_SCORE_KEEPER UserRole = 3 // Records scores, plays, clock and game status
+	UserRole_USER_ROLE_SITE_ADMIN   UserRole = 4 // Can call every privileged RPC
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_PLAYER",
+		2: "USER_ROLE_LEAGUE_ADMIN",
+		3: "USER_ROLE_SCORE_KEEPER",
+		4: "USER_ROLE_SITE_ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED":  0,
+		"USER_ROLE_PLAYER":       1,
+		"USER_ROLE_LEAGUE_ADMIN": 2,
+		"USER_ROLE_SCORE_KEEPER": 3,
+		"USER_ROLE_SITE_ADMIN":   4,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_user_service_proto_enumTypes[0].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_proto_user_service_proto_enumTypes[0]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -30,6 +87,7 @@ type User struct {
 	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	Role          UserRole               `protobuf:"varint,7,opt,name=role,proto3,enum=proto.UserRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +164,13 @@ func (x *User) GetActive() bool {
 	return false
 }
 
+func (x *User) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
 // CreateUser
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -310,10 +375,16 @@ func (x *GetUserByIDResponse) GetUser() *User {
 
 // GetAllUsers
 type GetAllUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in proto/user_service.proto.
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // Only 0 or 1 (the first page): use page_token
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Default 50, at most 200
 	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	OrderBy       string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // "username" (default) or "created_at"; "-" prefix for descending
+	Role          UserRole               `protobuf:"varint,6,opt,name=role,proto3,enum=proto.UserRole" json:"role,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // Inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // Exclusive
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -348,6 +419,7 @@ func (*GetAllUsersRequest) Descriptor() ([]byte, []int) {
 	return file_proto_user_service_proto_rawDescGZIP(), []int{5}
 }
 
+// Deprecated: Marked as deprecated in proto/user_service.proto.
 func (x *GetAllUsersRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
@@ -369,10 +441,46 @@ func (x *GetAllUsersRequest) GetActiveOnly() bool {
 	return false
 }
 
+func (x *GetAllUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllUsersRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *GetAllUsersRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *GetAllUsersRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
 type GetAllUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                       // Users matching the filters, across all pages
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -421,14 +529,21 @@ func (x *GetAllUsersResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *GetAllUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // UpdateUser
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The caller, unless the caller is a site_admin
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Active        *bool                  `protobuf:"varint,5,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Active        *bool                  `protobuf:"varint,5,opt,name=active,proto3,oneof" json:"active,omitempty"` // Only a site_admin can change it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -647,17 +762,126 @@ func (x *DeleteUserResponse) GetMessage() string {
 	return ""
 }
 
+// UpdateUserRole (site admins only)
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=role,proto3,enum=proto.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_proto_user_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_proto_user_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UpdateUserRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // SearchUsers
 type SearchUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SearchTerm    string                 `protobuf:"bytes,1,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Default 50, at most 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, with the same filters
+	OrderBy       string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`       // "username" (default) or "created_at"; "-" prefix for descending
+	ActiveOnly    bool                   `protobuf:"varint,5,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_proto_user_service_proto_msgTypes[11]
+	mi := &file_proto_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +893,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_proto_msgTypes[11]
+	mi := &file_proto_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +906,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_proto_rawDescGZIP(), []int{11}
+	return file_proto_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SearchUsersRequest) GetSearchTerm() string {
@@ -692,18 +916,47 @@ func (x *SearchUsersRequest) GetSearchTerm() string {
 	return ""
 }
 
+func (x *SearchUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
 type SearchUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Users matching the search, across all pages
 	SearchTerm    string                 `protobuf:"bytes,3,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_proto_user_service_proto_msgTypes[12]
+	mi := &file_proto_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +968,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_proto_msgTypes[12]
+	mi := &file_proto_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +981,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_proto_rawDescGZIP(), []int{12}
+	return file_proto_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*User {
@@ -752,6 +1005,13 @@ func (x *SearchUsersResponse) GetSearchTerm() string {
 	return ""
 }
 
+func (x *SearchUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // GetUserByUsername
 type GetUserByUsernameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -762,7 +1022,7 @@ type GetUserByUsernameRequest struct {
 
 func (x *GetUserByUsernameRequest) Reset() {
 	*x = GetUserByUsernameRequest{}
-	mi := &file_proto_user_service_proto_msgTypes[13]
+	mi := &file_proto_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -774,7 +1034,7 @@ func (x *GetUserByUsernameRequest) String() string {
 func (*GetUserByUsernameRequest) ProtoMessage() {}
 
 func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_proto_msgTypes[13]
+	mi := &file_proto_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +1047,7 @@ func (x *GetUserByUsernameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByUsernameRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_proto_rawDescGZIP(), []int{13}
+	return file_proto_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserByUsernameRequest) GetUsername() string {
@@ -806,7 +1066,7 @@ type GetUserByUsernameResponse struct {
 
 func (x *GetUserByUsernameResponse) Reset() {
 	*x = GetUserByUsernameResponse{}
-	mi := &file_proto_user_service_proto_msgTypes[14]
+	mi := &file_proto_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -818,7 +1078,7 @@ func (x *GetUserByUsernameResponse) String() string {
 func (*GetUserByUsernameResponse) ProtoMessage() {}
 
 func (x *GetUserByUsernameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_proto_msgTypes[14]
+	mi := &file_proto_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1091,7 @@ func (x *GetUserByUsernameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByUsernameResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByUsernameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_proto_rawDescGZIP(), []int{14}
+	return file_proto_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUserByUsernameResponse) GetUser() *User {
@@ -851,7 +1111,7 @@ type GetUserByEmailRequest struct {
 
 func (x *GetUserByEmailRequest) Reset() {
 	*x = GetUserByEmailRequest{}
-	mi := &file_proto_user_service_proto_msgTypes[15]
+	mi := &file_proto_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +1123,7 @@ func (x *GetUserByEmailRequest) String() string {
 func (*GetUserByEmailRequest) ProtoMessage() {}
 
 func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_proto_msgTypes[15]
+	mi := &file_proto_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1136,7 @@ func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_proto_rawDescGZIP(), []int{15}
+	return file_proto_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserByEmailRequest) GetEmail() string {
@@ -895,7 +1155,7 @@ type GetUserByEmailResponse struct {
 
 func (x *GetUserByEmailResponse) Reset() {
 	*x = GetUserByEmailResponse{}
-	mi := &file_proto_user_service_proto_msgTypes[16]
+	mi := &file_proto_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -907,7 +1167,7 @@ func (x *GetUserByEmailResponse) String() string {
 func (*GetUserByEmailResponse) ProtoMessage() {}
 
 func (x *GetUserByEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_proto_msgTypes[16]
+	mi := &file_proto_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1180,7 @@ func (x *GetUserByEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByEmailResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByEmailResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_proto_rawDescGZIP(), []int{16}
+	return file_proto_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserByEmailResponse) GetUser() *User {
@@ -930,11 +1190,457 @@ func (x *GetUserByEmailResponse) GetUser() *User {
 	return nil
 }
 
+// Returned by Register, Login and RefreshSession
+type AuthResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	User                  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	AccessToken           string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"` // Signed token for the Authorization: Bearer header
+	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // Opaque, single use: exchange it for a new pair
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=refresh_token_expires_at,json=refreshTokenExpiresAt,proto3" json:"refresh_token_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_proto_user_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuthResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+// Register
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_proto_user_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RegisterRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Login
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"` // Username or email
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_proto_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LoginRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// RefreshSession
+type RefreshSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_proto_user_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionRequest) ProtoMessage() {}
+
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Logout
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_user_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_proto_user_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LogoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ValidateToken
+type ValidateTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenRequest) Reset() {
+	*x = ValidateTokenRequest{}
+	mi := &file_proto_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenRequest) ProtoMessage() {}
+
+func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ValidateTokenRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type ValidateTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=proto.UserRole" json:"role,omitempty"` // Current role, which may differ from the one in the token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTokenResponse) Reset() {
+	*x = ValidateTokenResponse{}
+	mi := &file_proto_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTokenResponse) ProtoMessage() {}
+
+func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ValidateTokenResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ValidateTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ValidateTokenResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
 var File_proto_user_service_proto protoreflect.FileDescriptor
 
 const file_proto_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/user_service.proto\x12\x05proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb8\x01\n" +
+	"\x18proto/user_service.proto\x12\x05proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -942,7 +1648,8 @@ const file_proto_user_service_proto_rawDesc = "" +
 	"\tfull_name\x18\x04 \x01(\tR\bfullName\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
-	"\x06active\x18\x06 \x01(\bR\x06active\"b\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12#\n" +
+	"\x04role\x18\a \x01(\x0e2\x0f.proto.UserRoleR\x04role\"b\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
@@ -953,15 +1660,22 @@ const file_proto_user_service_proto_rawDesc = "" +
 	"\x12GetUserByIDRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"6\n" +
 	"\x13GetUserByIDResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.proto.UserR\x04user\"f\n" +
-	"\x12GetAllUsersRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\x04user\x18\x01 \x01(\v2\v.proto.UserR\x04user\"\xcd\x02\n" +
+	"\x12GetAllUsersRequest\x12\x16\n" +
+	"\x04page\x18\x01 \x01(\x05B\x02\x18\x01R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vactive_only\x18\x03 \x01(\bR\n" +
-	"activeOnly\"N\n" +
+	"activeOnly\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x12#\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x0f.proto.UserRoleR\x04role\x12?\n" +
+	"\rcreated_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"v\n" +
 	"\x13GetAllUsersResponse\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.proto.UserR\x05users\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa3\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa3\x01\n" +
 	"\x11UpdateUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -976,15 +1690,28 @@ const file_proto_user_service_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\x15UpdateUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x0f.proto.UserRoleR\x04role\"S\n" +
+	"\x16UpdateUserRoleResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.proto.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xad\x01\n" +
 	"\x12SearchUsersRequest\x12\x1f\n" +
 	"\vsearch_term\x18\x01 \x01(\tR\n" +
-	"searchTerm\"o\n" +
+	"searchTerm\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12\x1f\n" +
+	"\vactive_only\x18\x05 \x01(\bR\n" +
+	"activeOnly\"\x97\x01\n" +
 	"\x13SearchUsersResponse\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.proto.UserR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
 	"\vsearch_term\x18\x03 \x01(\tR\n" +
-	"searchTerm\"6\n" +
+	"searchTerm\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"6\n" +
 	"\x18GetUserByUsernameRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"<\n" +
 	"\x19GetUserByUsernameResponse\x12\x1f\n" +
@@ -992,19 +1719,61 @@ const file_proto_user_service_proto_rawDesc = "" +
 	"\x15GetUserByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"9\n" +
 	"\x16GetUserByEmailResponse\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.proto.UserR\x04user2\xcf\x04\n" +
-	"\vUserService\x12A\n" +
+	"\x04user\x18\x01 \x01(\v2\v.proto.UserR\x04user\"\x9f\x02\n" +
+	"\fAuthResponse\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.proto.UserR\x04user\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12Q\n" +
+	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12S\n" +
+	"\x18refresh_token_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x15refreshTokenExpiresAt\"|\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"<\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"D\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"9\n" +
+	"\x14ValidateTokenRequest\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"\x90\x01\n" +
+	"\x15ValidateTokenResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12#\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0f.proto.UserRoleR\x04role*\x8d\x01\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10USER_ROLE_PLAYER\x10\x01\x12\x1a\n" +
+	"\x16USER_ROLE_LEAGUE_ADMIN\x10\x02\x12\x1a\n" +
+	"\x16USER_ROLE_SCORE_KEEPER\x10\x03\x12\x18\n" +
+	"\x14USER_ROLE_SITE_ADMIN\x10\x042\x81\v\n" +
+	"\vUserService\x12X\n" +
 	"\n" +
-	"CreateUser\x12\x18.proto.CreateUserRequest\x1a\x19.proto.CreateUserResponse\x12D\n" +
-	"\vGetUserByID\x12\x19.proto.GetUserByIDRequest\x1a\x1a.proto.GetUserByIDResponse\x12D\n" +
-	"\vGetAllUsers\x12\x19.proto.GetAllUsersRequest\x1a\x1a.proto.GetAllUsersResponse\x12A\n" +
+	"CreateUser\x12\x18.proto.CreateUserRequest\x1a\x19.proto.CreateUserResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/api/users\x12b\n" +
+	"\vGetUserByID\x12\x19.proto.GetUserByIDRequest\x1a\x1a.proto.GetUserByIDResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/users/{user_id}\x12X\n" +
+	"\vGetAllUsers\x12\x19.proto.GetAllUsersRequest\x1a\x1a.proto.GetAllUsersResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/users\x12b\n" +
 	"\n" +
-	"UpdateUser\x12\x18.proto.UpdateUserRequest\x1a\x19.proto.UpdateUserResponse\x12A\n" +
+	"UpdateUser\x12\x18.proto.UpdateUserRequest\x1a\x19.proto.UpdateUserResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/api/users/{user_id}\x12_\n" +
 	"\n" +
-	"DeleteUser\x12\x18.proto.DeleteUserRequest\x1a\x19.proto.DeleteUserResponse\x12D\n" +
-	"\vSearchUsers\x12\x19.proto.SearchUsersRequest\x1a\x1a.proto.SearchUsersResponse\x12V\n" +
-	"\x11GetUserByUsername\x12\x1f.proto.GetUserByUsernameRequest\x1a .proto.GetUserByUsernameResponse\x12M\n" +
-	"\x0eGetUserByEmail\x12\x1c.proto.GetUserByEmailRequest\x1a\x1d.proto.GetUserByEmailResponseB\x19Z\x17kickoff.com/proto;protob\x06proto3"
+	"DeleteUser\x12\x18.proto.DeleteUserRequest\x1a\x19.proto.DeleteUserResponse\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/api/users/{user_id}\x12s\n" +
+	"\x0eUpdateUserRole\x12\x1c.proto.UpdateUserRoleRequest\x1a\x1d.proto.UpdateUserRoleResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/users/{user_id}/role\x12_\n" +
+	"\vSearchUsers\x12\x19.proto.SearchUsersRequest\x1a\x1a.proto.SearchUsersResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/users/search\x12\x81\x01\n" +
+	"\x11GetUserByUsername\x12\x1f.proto.GetUserByUsernameRequest\x1a .proto.GetUserByUsernameResponse\")\x82\xd3\xe4\x93\x02#\x12!/api/users/by-username/{username}\x12r\n" +
+	"\x0eGetUserByEmail\x12\x1c.proto.GetUserByEmailRequest\x1a\x1d.proto.GetUserByEmailResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/users/by-email/{email}\x12V\n" +
+	"\bRegister\x12\x16.proto.RegisterRequest\x1a\x13.proto.AuthResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/auth/register\x12M\n" +
+	"\x05Login\x12\x13.proto.LoginRequest\x1a\x13.proto.AuthResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/auth/login\x12a\n" +
+	"\x0eRefreshSession\x12\x1c.proto.RefreshSessionRequest\x1a\x13.proto.AuthResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/auth/refresh\x12R\n" +
+	"\x06Logout\x12\x14.proto.LogoutRequest\x1a\x15.proto.LogoutResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/auth/logout\x12i\n" +
+	"\rValidateToken\x12\x1b.proto.ValidateTokenRequest\x1a\x1c.proto.ValidateTokenResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/auth/validateB\x19Z\x17kickoff.com/proto;protob\x06proto3"
 
 var (
 	file_proto_user_service_proto_rawDescOnce sync.Once
@@ -1018,57 +1787,92 @@ func file_proto_user_service_proto_rawDescGZIP() []byte {
 	return file_proto_user_service_proto_rawDescData
 }
 
-var file_proto_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_proto_user_service_proto_goTypes = []any{
-	(*User)(nil),                      // 0: proto.User
-	(*CreateUserRequest)(nil),         // 1: proto.CreateUserRequest
-	(*CreateUserResponse)(nil),        // 2: proto.CreateUserResponse
-	(*GetUserByIDRequest)(nil),        // 3: proto.GetUserByIDRequest
-	(*GetUserByIDResponse)(nil),       // 4: proto.GetUserByIDResponse
-	(*GetAllUsersRequest)(nil),        // 5: proto.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),       // 6: proto.GetAllUsersResponse
-	(*UpdateUserRequest)(nil),         // 7: proto.UpdateUserRequest
-	(*UpdateUserResponse)(nil),        // 8: proto.UpdateUserResponse
-	(*DeleteUserRequest)(nil),         // 9: proto.DeleteUserRequest
-	(*DeleteUserResponse)(nil),        // 10: proto.DeleteUserResponse
-	(*SearchUsersRequest)(nil),        // 11: proto.SearchUsersRequest
-	(*SearchUsersResponse)(nil),       // 12: proto.SearchUsersResponse
-	(*GetUserByUsernameRequest)(nil),  // 13: proto.GetUserByUsernameRequest
-	(*GetUserByUsernameResponse)(nil), // 14: proto.GetUserByUsernameResponse
-	(*GetUserByEmailRequest)(nil),     // 15: proto.GetUserByEmailRequest
-	(*GetUserByEmailResponse)(nil),    // 16: proto.GetUserByEmailResponse
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+	(UserRole)(0),                     // 0: proto.UserRole
+	(*User)(nil),                      // 1: proto.User
+	(*CreateUserRequest)(nil),         // 2: proto.CreateUserRequest
+	(*CreateUserResponse)(nil),        // 3: proto.CreateUserResponse
+	(*GetUserByIDRequest)(nil),        // 4: proto.GetUserByIDRequest
+	(*GetUserByIDResponse)(nil),       // 5: proto.GetUserByIDResponse
+	(*GetAllUsersRequest)(nil),        // 6: proto.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),       // 7: proto.GetAllUsersResponse
+	(*UpdateUserRequest)(nil),         // 8: proto.UpdateUserRequest
+	(*UpdateUserResponse)(nil),        // 9: proto.UpdateUserResponse
+	(*DeleteUserRequest)(nil),         // 10: proto.DeleteUserRequest
+	(*DeleteUserResponse)(nil),        // 11: proto.DeleteUserResponse
+	(*UpdateUserRoleRequest)(nil),     // 12: proto.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),    // 13: proto.UpdateUserRoleResponse
+	(*SearchUsersRequest)(nil),        // 14: proto.SearchUsersRequest
+	(*SearchUsersResponse)(nil),       // 15: proto.SearchUsersResponse
+	(*GetUserByUsernameRequest)(nil),  // 16: proto.GetUserByUsernameRequest
+	(*GetUserByUsernameResponse)(nil), // 17: proto.GetUserByUsernameResponse
+	(*GetUserByEmailRequest)(nil),     // 18: proto.GetUserByEmailRequest
+	(*GetUserByEmailResponse)(nil),    // 19: proto.GetUserByEmailResponse
+	(*AuthResponse)(nil),              // 20: proto.AuthResponse
+	(*RegisterRequest)(nil),           // 21: proto.RegisterRequest
+	(*LoginRequest)(nil),              // 22: proto.LoginRequest
+	(*RefreshSessionRequest)(nil),     // 23: proto.RefreshSessionRequest
+	(*LogoutRequest)(nil),             // 24: proto.LogoutRequest
+	(*LogoutResponse)(nil),            // 25: proto.LogoutResponse
+	(*ValidateTokenRequest)(nil),      // 26: proto.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),     // 27: proto.ValidateTokenResponse
+	(*timestamppb.Timestamp)(nil),     // 28: google.protobuf.Timestamp
 }
 var file_proto_user_service_proto_depIdxs = []int32{
-	17, // 0: proto.User.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: proto.CreateUserResponse.user:type_name -> proto.User
-	0,  // 2: proto.GetUserByIDResponse.user:type_name -> proto.User
-	0,  // 3: proto.GetAllUsersResponse.users:type_name -> proto.User
-	0,  // 4: proto.UpdateUserResponse.user:type_name -> proto.User
-	0,  // 5: proto.SearchUsersResponse.users:type_name -> proto.User
-	0,  // 6: proto.GetUserByUsernameResponse.user:type_name -> proto.User
-	0,  // 7: proto.GetUserByEmailResponse.user:type_name -> proto.User
-	1,  // 8: proto.UserService.CreateUser:input_type -> proto.CreateUserRequest
-	3,  // 9: proto.UserService.GetUserByID:input_type -> proto.GetUserByIDRequest
-	5,  // 10: proto.UserService.GetAllUsers:input_type -> proto.GetAllUsersRequest
-	7,  // 11: proto.UserService.UpdateUser:input_type -> proto.UpdateUserRequest
-	9,  // 12: proto.UserService.DeleteUser:input_type -> proto.DeleteUserRequest
-	11, // 13: proto.UserService.SearchUsers:input_type -> proto.SearchUsersRequest
-	13, // 14: proto.UserService.GetUserByUsername:input_type -> proto.GetUserByUsernameRequest
-	15, // 15: proto.UserService.GetUserByEmail:input_type -> proto.GetUserByEmailRequest
-	2,  // 16: proto.UserService.CreateUser:output_type -> proto.CreateUserResponse
-	4,  // 17: proto.UserService.GetUserByID:output_type -> proto.GetUserByIDResponse
-	6,  // 18: proto.UserService.GetAllUsers:output_type -> proto.GetAllUsersResponse
-	8,  // 19: proto.UserService.UpdateUser:output_type -> proto.UpdateUserResponse
-	10, // 20: proto.UserService.DeleteUser:output_type -> proto.DeleteUserResponse
-	12, // 21: proto.UserService.SearchUsers:output_type -> proto.SearchUsersResponse
-	14, // 22: proto.UserService.GetUserByUsername:output_type -> proto.GetUserByUsernameResponse
-	16, // 23: proto.UserService.GetUserByEmail:output_type -> proto.GetUserByEmailResponse
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	28, // 0: proto.User.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: proto.User.role:type_name -> proto.UserRole
+	1,  // 2: proto.CreateUserResponse.user:type_name -> proto.User
+	1,  // 3: proto.GetUserByIDResponse.user:type_name -> proto.User
+	0,  // 4: proto.GetAllUsersRequest.role:type_name -> proto.UserRole
+	28, // 5: proto.GetAllUsersRequest.created_after:type_name -> google.protobuf.Timestamp
+	28, // 6: proto.GetAllUsersRequest.created_before:type_name -> google.protobuf.Timestamp
+	1,  // 7: proto.GetAllUsersResponse.users:type_name -> proto.User
+	1,  // 8: proto.UpdateUserResponse.user:type_name -> proto.User
+	0,  // 9: proto.UpdateUserRoleRequest.role:type_name -> proto.UserRole
+	1,  // 10: proto.UpdateUserRoleResponse.user:type_name -> proto.User
+	1,  // 11: proto.SearchUsersResponse.users:type_name -> proto.User
+	1,  // 12: proto.GetUserByUsernameResponse.user:type_name -> proto.User
+	1,  // 13: proto.GetUserByEmailResponse.user:type_name -> proto.User
+	1,  // 14: proto.AuthResponse.user:type_name -> proto.User
+	28, // 15: proto.AuthResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	28, // 16: proto.AuthResponse.refresh_token_expires_at:type_name -> google.protobuf.Timestamp
+	28, // 17: proto.ValidateTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 18: proto.ValidateTokenResponse.role:type_name -> proto.UserRole
+	2,  // 19: proto.UserService.CreateUser:input_type -> proto.CreateUserRequest
+	4,  // 20: proto.UserService.GetUserByID:input_type -> proto.GetUserByIDRequest
+	6,  // 21: proto.UserService.GetAllUsers:input_type -> proto.GetAllUsersRequest
+	8,  // 22: proto.UserService.UpdateUser:input_type -> proto.UpdateUserRequest
+	10, // 23: proto.UserService.DeleteUser:input_type -> proto.DeleteUserRequest
+	12, // 24: proto.UserService.UpdateUserRole:input_type -> proto.UpdateUserRoleRequest
+	14, // 25: proto.UserService.SearchUsers:input_type -> proto.SearchUsersRequest
+	16, // 26: proto.UserService.GetUserByUsername:input_type -> proto.GetUserByUsernameRequest
+	18, // 27: proto.UserService.GetUserByEmail:input_type -> proto.GetUserByEmailRequest
+	21, // 28: proto.UserService.Register:input_type -> proto.RegisterRequest
+	22, // 29: proto.UserService.Login:input_type -> proto.LoginRequest
+	23, // 30: proto.UserService.RefreshSession:input_type -> proto.RefreshSessionRequest
+	24, // 31: proto.UserService.Logout:input_type -> proto.LogoutRequest
+	26, // 32: proto.UserService.ValidateToken:input_type -> proto.ValidateTokenRequest
+	3,  // 33: proto.UserService.CreateUser:output_type -> proto.CreateUserResponse
+	5,  // 34: proto.UserService.GetUserByID:output_type -> proto.GetUserByIDResponse
+	7,  // 35: proto.UserService.GetAllUsers:output_type -> proto.GetAllUsersResponse
+	9,  // 36: proto.UserService.UpdateUser:output_type -> proto.UpdateUserResponse
+	11, // 37: proto.UserService.DeleteUser:output_type -> proto.DeleteUserResponse
+	13, // 38: proto.UserService.UpdateUserRole:output_type -> proto.UpdateUserRoleResponse
+	15, // 39: proto.UserService.SearchUsers:output_type -> proto.SearchUsersResponse
+	17, // 40: proto.UserService.GetUserByUsername:output_type -> proto.GetUserByUsernameResponse
+	19, // 41: proto.UserService.GetUserByEmail:output_type -> proto.GetUserByEmailResponse
+	20, // 42: proto.UserService.Register:output_type -> proto.AuthResponse
+	20, // 43: proto.UserService.Login:output_type -> proto.AuthResponse
+	20, // 44: proto.UserService.RefreshSession:output_type -> proto.AuthResponse
+	25, // 45: proto.UserService.Logout:output_type -> proto.LogoutResponse
+	27, // 46: proto.UserService.ValidateToken:output_type -> proto.ValidateTokenResponse
+	33, // [33:47] is the sub-list for method output_type
+	19, // [19:33] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_service_proto_init() }
@@ -1082,13 +1886,14 @@ func file_proto_user_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_service_proto_rawDesc), len(file_proto_user_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_user_service_proto_goTypes,
 		DependencyIndexes: file_proto_user_service_proto_depIdxs,
+		EnumInfos:         file_proto_user_service_proto_enumTypes,
 		MessageInfos:      file_proto_user_service_proto_msgTypes,
 	}.Build()
 	File_proto_user_service_proto = out.File
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+	"kickoff.com/user/internal/database"
+	"kickoff.com/user/internal/models"
+)
+
+const (
+	// refreshTokenTTL es la duración de una sesión sin actividad
+	refreshTokenTTL = 30 * 24 * time.Hour
+
+	minPasswordLength = 8
+	maxPasswordLength = 72 // Límite de bcrypt
+)
+
+var (
+	errInvalidCredentials  = status.Error(codes.Unauthenticated, "invalid username/email or password")
+	errInvalidRefreshToken = status.Error(codes.Unauthenticated, "invalid or expired refresh token")
+	errUserDeactivated     = status.Error(codes.PermissionDenied, "user is deactivated")
+)
+
+// ========================================
+// gRPC Handlers - Authentication
+// ========================================
+
+func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.AuthResponse, error) {
+	username := strings.TrimSpace(req.Username)
+	email := strings.TrimSpace(req.Email)
+	if username == "" || email == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "username, email and password are required")
+	}
+	if len(req.Password) < minPasswordLength || len(req.Password) > maxPasswordLength {
+		return nil, shared.InvalidField("password", "password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
+
+	if err := checkUserUnique(username, email); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("Error hashing password: %v", err)
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+
+	user := models.User{
+		ID:           idgen.New(idgen.PrefixUser),
+		Username:     username,
+		Email:        email,
+		FullName:     strings.TrimSpace(req.FullName),
+		PasswordHash: string(hash),
+		Active:       true,
+		Role:         auth.RolePlayer,
+	}
+
+	var resp *pb.AuthResponse
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		resp, err = s.startSession(tx, user)
+		return err
+	})
+	if err != nil {
+		log.Printf("Error registering user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to register user: %v", err)
+	}
+
+	log.Printf("Registered user: %s (%s)", user.Username, user.ID)
+
+	return resp, nil
+}
+
+func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.AuthResponse, error) {
+	login := strings.TrimSpace(req.Login)
+	if login == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "login and password are required")
+	}
+
+	var user models.User
+	if err := database.DB.Where("username = ? OR email = ?", login, login).First(&user).Error; err != nil {
+		return nil, errInvalidCredentials
+	}
+
+	// Los usuarios creados con CreateUser no tienen contraseña y no pueden iniciar sesión
+	if user.PasswordHash == "" || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)) != nil {
+		return nil, errInvalidCredentials
+	}
+	if !user.Active {
+		return nil, errUserDeactivated
+	}
+
+	resp, err := s.startSession(database.DB, user)
+	if err != nil {
+		log.Printf("Error starting session for user %s: %v", user.ID, err)
+		return nil, status.Errorf(codes.Internal, "failed to start session: %v", err)
+	}
+
+	log.Printf("User logged in: %s", user.ID)
+
+	return resp, nil
+}
+
+func (s *UserService) RefreshSession(ctx context.Context, req *pb.RefreshSessionRequest) (*pb.AuthResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, shared.InvalidField("refresh_token", "refresh_token is required")
+	}
+
+	var resp *pb.AuthResponse
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		// El lock evita que dos requests concurrentes roten el mismo token
+		var session models.RefreshToken
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", hashRefreshToken(req.RefreshToken)).
+			First(&session).Error; err != nil {
+			return errInvalidRefreshToken
+		}
+		if session.RevokedAt != nil || !time.Now().Before(session.ExpiresAt) {
+			return errInvalidRefreshToken
+		}
+
+		var user models.User
+		if err := tx.Where("id = ?", session.UserID).First(&user).Error; err != nil {
+			return errInvalidRefreshToken
+		}
+		if !user.Active {
+			return errUserDeactivated
+		}
+
+		if err := tx.Model(&session).Update("revoked_at", time.Now().UTC()).Error; err != nil {
+			return err
+		}
+
+		var err error
+		resp, err = s.startSession(tx, user)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Error refreshing session: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to refresh session: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (s *UserService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, shared.InvalidField("refresh_token", "refresh_token is required")
+	}
+
+	// Revocar un token desconocido o ya revocado no es un error
+	if err := database.DB.Model(&models.RefreshToken{}).
+		Where("token_hash = ? AND revoked_at IS NULL", hashRefreshToken(req.RefreshToken)).
+		Update("revoked_at", time.Now().UTC()).Error; err != nil {
+		log.Printf("Error revoking refresh token: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to logout: %v", err)
+	}
+
+	return &pb.LogoutResponse{
+		Success: true,
+		Message: "Logged out successfully",
+	}, nil
+}
+
+func (s *UserService) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
+	if req.AccessToken == "" {
+		return nil, shared.InvalidField("access_token", "access_token is required")
+	}
+
+	claims, err := s.tokens.Verify(req.AccessToken, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	var user models.User
+	if err := database.DB.Where("id = ?", claims.Subject).First(&user).Error; err != nil {
+		return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+	}
+	if !user.Active {
+		return nil, errUserDeactivated
+	}
+
+	return &pb.ValidateTokenResponse{
+		UserId:    user.ID,
+		ExpiresAt: timestamppb.New(time.Unix(claims.ExpiresAt, 0)),
+		Role:      roleToProto(user.Role),
+	}, nil
+}
+
+// ========================================
+// Helper Functions - Authentication
+// ========================================
+
+// checkUserUnique verifica que username y email no estén en uso
+func checkUserUnique(username, email string) error {
+	var existingUser models.User
+	if err := database.DB.Where("username = ?", username).First(&existingUser).Error; err == nil {
+		return status.Errorf(codes.AlreadyExists, "username already exists: %s", username)
+	}
+	if err := database.DB.Where("email = ?", email).First(&existingUser).Error; err == nil {
+		return status.Errorf(codes.AlreadyExists, "email already exists: %s", email)
+	}
+	return nil
+}
+
+// startSession emite un access token y guarda un refresh token nuevo para el usuario
+func (s *UserService) startSession(tx *gorm.DB, user models.User) (*pb.AuthResponse, error) {
+	now := time.Now().UTC()
+	accessToken, accessExpiresAt := s.tokens.Issue(user.ID, user.Role, now)
+
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	session := models.RefreshToken{
+		ID:        idgen.New(idgen.PrefixSession),
+		UserID:    user.ID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: now.Add(refreshTokenTTL),
+	}
+	if err := tx.Create(&session).Error; err != nil {
+		return nil, err
+	}
+
+	return &pb.AuthResponse{
+		User: &pb.User{
+			Id:        user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			FullName:  user.FullName,
+			CreatedAt: timestamppb.New(user.CreatedAt),
+			Active:    user.Active,
+			Role:      roleToProto(user.Role),
+		},
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessExpiresAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(session.ExpiresAt),
+	}, nil
+}
+
+// revokeSessions revoca todas las sesiones abiertas del usuario
+func revokeSessions(tx *gorm.DB, userID string) error {
+	return tx.Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now().UTC()).Error
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashRefreshToken es lo que se guarda en la base: el token en claro solo lo tiene el cliente
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	pb "kickoff.com/proto"
+	"kickoff.com/user/internal/database"
+	"kickoff.com/user/internal/models"
+)
+
+// newTestService apunta database.DB a una base SQLite en memoria y devuelve
+// un UserService con un firmador de prueba
+func newTestService(t *testing.T) *UserService {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&models.User{}, &models.RefreshToken{}, &events.OutboxEvent{}); err != nil {
+		t.Fatal(err)
+	}
+
+	previous := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = previous
+		sqlDB.Close()
+	})
+
+	tokens, err := auth.NewSigner("0123456789abcdef0123456789abcdef", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &UserService{tokens: tokens}
+}
+
+func wantCode(t *testing.T, name string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Errorf("%s: code = %v (%v), want %v", name, got, err, want)
+	}
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	registered, err := s.Register(ctx, &pb.RegisterRequest{
+		Username: " ana ", Email: "ana@example.com", FullName: "Ana", Password: "correct-horse",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registered.User.Username != "ana" || registered.AccessToken == "" || registered.RefreshToken == "" {
+		t.Fatalf("Register() = %+v", registered)
+	}
+	claims, err := s.tokens.Verify(registered.AccessToken, time.Now())
+	if err != nil || claims.Subject != registered.User.Id {
+		t.Errorf("access token claims = %+v, %v, want subject %s", claims, err, registered.User.Id)
+	}
+
+	// La contraseña solo se guarda hasheada
+	var user models.User
+	database.DB.First(&user, "id = ?", registered.User.Id)
+	if user.PasswordHash == "" || user.PasswordHash == "correct-horse" {
+		t.Errorf("password hash = %q", user.PasswordHash)
+	}
+
+	registerTests := []struct {
+		name string
+		req  *pb.RegisterRequest
+		want codes.Code
+	}{
+		{"username repetido", &pb.RegisterRequest{Username: "ana", Email: "otra@example.com", Password: "correct-horse"}, codes.AlreadyExists},
+		{"email repetido", &pb.RegisterRequest{Username: "otra", Email: "ana@example.com", Password: "correct-horse"}, codes.AlreadyExists},
+		{"contraseña corta", &pb.RegisterRequest{Username: "otra", Email: "otra@example.com", Password: "short"}, codes.InvalidArgument},
+		{"sin email", &pb.RegisterRequest{Username: "otra", Password: "correct-horse"}, codes.InvalidArgument},
+	}
+	for _, tt := range registerTests {
+		_, err := s.Register(ctx, tt.req)
+		wantCode(t, tt.name, err, tt.want)
+	}
+
+	loginTests := []struct {
+		name  string
+		login string
+		pass  string
+		want  codes.Code
+	}{
+		{"por username", "ana", "correct-horse", codes.OK},
+		{"por email", "ana@example.com", "correct-horse", codes.OK},
+		{"contraseña incorrecta", "ana", "wrong-horse", codes.Unauthenticated},
+		{"usuario inexistente", "nadie", "correct-horse", codes.Unauthenticated},
+		{"sin contraseña", "ana", "", codes.InvalidArgument},
+	}
+	for _, tt := range loginTests {
+		resp, err := s.Login(ctx, &pb.LoginRequest{Login: tt.login, Password: tt.pass})
+		wantCode(t, tt.name, err, tt.want)
+		if err == nil && resp.User.Id != registered.User.Id {
+			t.Errorf("%s: logged in as %s, want %s", tt.name, resp.User.Id, registered.User.Id)
+		}
+	}
+
+	// Un usuario desactivado no puede iniciar sesión aunque la contraseña sea correcta
+	if _, err := s.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: registered.User.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Login(ctx, &pb.LoginRequest{Login: "ana", Password: "correct-horse"})
+	wantCode(t, "desactivado", err, codes.PermissionDenied)
+}
+
+func TestRefreshSessionRotation(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	first, err := s.Register(ctx, &pb.RegisterRequest{Username: "ana", Email: "ana@example.com", Password: "correct-horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := s.RefreshSession(ctx, &pb.RefreshSessionRequest{RefreshToken: first.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("RefreshSession() returned the same refresh token")
+	}
+
+	// El token rotado ya no sirve; el nuevo sí
+	_, err = s.RefreshSession(ctx, &pb.RefreshSessionRequest{RefreshToken: first.RefreshToken})
+	wantCode(t, "token rotado", err, codes.Unauthenticated)
+	third, err := s.RefreshSession(ctx, &pb.RefreshSessionRequest{RefreshToken: second.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Logout revoca el token y es idempotente
+	for i := 0; i < 2; i++ {
+		if _, err := s.Logout(ctx, &pb.LogoutRequest{RefreshToken: third.RefreshToken}); err != nil {
+			t.Fatalf("Logout() #%d error = %v", i+1, err)
+		}
+	}
+	_, err = s.RefreshSession(ctx, &pb.RefreshSessionRequest{RefreshToken: third.RefreshToken})
+	wantCode(t, "después de logout", err, codes.Unauthenticated)
+
+	// Un token vencido no se acepta
+	login, err := s.Login(ctx, &pb.LoginRequest{Login: "ana", Password: "correct-horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	database.DB.Model(&models.RefreshToken{}).
+		Where("token_hash = ?", hashRefreshToken(login.RefreshToken)).
+		Update("expires_at", time.Now().Add(-time.Minute))
+	_, err = s.RefreshSession(ctx, &pb.RefreshSessionRequest{RefreshToken: login.RefreshToken})
+	wantCode(t, "vencido", err, codes.Unauthenticated)
+
+	// Desactivar al usuario revoca todas sus sesiones abiertas
+	open, err := s.Login(ctx, &pb.LoginRequest{Login: "ana", Password: "correct-horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: first.User.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.RefreshSession(ctx, &pb.RefreshSessionRequest{RefreshToken: open.RefreshToken})
+	wantCode(t, "usuario desactivado", err, codes.Unauthenticated)
+}
+
+func TestValidateToken(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+
+	resp, err := s.Register(ctx, &pb.RegisterRequest{Username: "ana", Email: "ana@example.com", Password: "correct-horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	validated, err := s.ValidateToken(ctx, &pb.ValidateTokenRequest{AccessToken: resp.AccessToken})
+	if err != nil || validated.UserId != resp.User.Id {
+		t.Fatalf("ValidateToken() = %v, %v, want user %s", validated, err, resp.User.Id)
+	}
+
+	_, err = s.ValidateToken(ctx, &pb.ValidateTokenRequest{AccessToken: resp.AccessToken + "x"})
+	wantCode(t, "firma inválida", err, codes.Unauthenticated)
+
+	if _, err := s.DeleteUser(ctx, &pb.DeleteUserRequest{UserId: resp.User.Id}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ValidateToken(ctx, &pb.ValidateTokenRequest{AccessToken: resp.AccessToken})
+	wantCode(t, "usuario desactivado", err, codes.PermissionDenied)
+}
@@ -16,16 +16,23 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 
+	"kickoff.com/pkg/auth"
+	"kickoff.com/pkg/events"
+	"kickoff.com/pkg/idgen"
+	shared "kickoff.com/pkg/models"
+	"kickoff.com/pkg/pagination"
+	pb "kickoff.com/proto"
 	"kickoff.com/user/internal/database"
 	"kickoff.com/user/internal/models"
-	pb "kickoff.com/proto"
 )
 
 const serviceName = "user"
 
 type UserService struct {
 	pb.UnimplementedUserServiceServer
+	tokens *auth.Signer
 }
 
 func main() {
@@ -42,8 +49,29 @@ func main() {
 	}
 	log.Println("✅ Connected to PostgreSQL database")
 
+	// Publicar los eventos del outbox (UserDeactivated)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transport, err := events.NewTransportFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize event transport: %v", err)
+	}
+	go events.NewRelay(database.DB, transport).Run(ctx)
+
+	// Firmador de access tokens (mismo secreto que el Gateway)
+	tokens, err := auth.NewSignerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize token signer: %v", err)
+	}
+
+	// Administradores iniciales del sitio
+	if err := promoteSiteAdmins(); err != nil {
+		log.Fatalf("Failed to promote site admins: %v", err)
+	}
+
 	// Inicializar servicio
-	userService := &UserService{}
+	userService := &UserService{tokens: tokens}
 
 	// Crear listener para gRPC
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -51,8 +79,8 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	// Crear servidor gRPC
-	grpcServer := grpc.NewServer()
+	// Crear servidor gRPC; el interceptor controla el rol de los métodos privilegiados
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor(tokens, privilegedMethods, userActive)))
 	pb.RegisterUserServiceServer(grpcServer, userService)
 
 	// Registrar health check
@@ -74,8 +102,9 @@ func main() {
 	// Wait for termination signal
 	<-sigChan
 	log.Println("Shutting down gracefully...")
-	database.Close()
+	cancel()
 	grpcServer.GracefulStop()
+	database.Close()
 }
 
 // ========================================
@@ -83,24 +112,19 @@ func main() {
 // ========================================
 
 func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	// Verificar que username sea único
-	var existingUser models.User
-	if err := database.DB.Where("username = ?", req.Username).First(&existingUser).Error; err == nil {
-		return nil, status.Errorf(codes.AlreadyExists, "username already exists: %s", req.Username)
-	}
-
-	// Verificar que email sea único
-	if err := database.DB.Where("email = ?", req.Email).First(&existingUser).Error; err == nil {
-		return nil, status.Errorf(codes.AlreadyExists, "email already exists: %s", req.Email)
+	// Verificar que username y email sean únicos
+	if err := checkUserUnique(req.Username, req.Email); err != nil {
+		return nil, err
 	}
 
 	// Crear nuevo usuario
 	user := models.User{
-		ID:       generateUserID(),
+		ID:       idgen.New(idgen.PrefixUser),
 		Username: req.Username,
 		Email:    req.Email,
 		FullName: req.FullName,
 		Active:   true,
+		Role:     auth.RolePlayer,
 	}
 
 	if err := database.DB.Create(&user).Error; err != nil {
@@ -118,6 +142,7 @@ func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		},
 		Message: "User created successfully",
 	}, nil
@@ -137,19 +162,46 @@ func (s *UserService) GetUserByID(ctx context.Context, req *pb.GetUserByIDReques
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		},
 	}, nil
 }
 
+// userOrders son los órdenes de GetAllUsers y SearchUsers
+var userOrders = pagination.Orders[models.User]{
+	{Name: "username", Column: "username", Kind: pagination.String, Value: func(u models.User) interface{} { return u.Username }},
+	{Name: "created_at", Column: "created_at", Kind: pagination.Time, Value: func(u models.User) interface{} { return u.CreatedAt }},
+}
+
 func (s *UserService) GetAllUsers(ctx context.Context, req *pb.GetAllUsersRequest) (*pb.GetAllUsersResponse, error) {
-	var users []models.User
-	query := database.DB
+	if err := pagination.LegacyPage(req.Page); err != nil {
+		return nil, err
+	}
+	page, err := pagination.New(req.PageSize, req.PageToken, req.OrderBy, userOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
 
+	query := database.DB.Model(&models.User{})
 	if req.ActiveOnly {
 		query = query.Where("active = ?", true)
 	}
+	if req.Role != pb.UserRole_USER_ROLE_UNSPECIFIED {
+		role, ok := roleFromProto(req.Role)
+		if !ok {
+			return nil, shared.InvalidField("role", "invalid role")
+		}
+		query = query.Where("role = ?", role)
+	}
+	if req.CreatedAfter != nil {
+		query = query.Where("created_at >= ?", req.CreatedAfter.AsTime())
+	}
+	if req.CreatedBefore != nil {
+		query = query.Where("created_at < ?", req.CreatedBefore.AsTime())
+	}
 
-	if err := query.Find(&users).Error; err != nil {
+	users, total, next, err := pagination.Find(query, page, func(u models.User) string { return u.ID })
+	if err != nil {
 		log.Printf("Error fetching users: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to fetch users: %v", err)
 	}
@@ -163,19 +215,30 @@ func (s *UserService) GetAllUsers(ctx context.Context, req *pb.GetAllUsersReques
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		})
 	}
 
 	return &pb.GetAllUsersResponse{
-		Users: pbUsers,
-		Total: int32(len(pbUsers)),
+		Users:         pbUsers,
+		Total:         int32(total),
+		NextPageToken: next,
 	}, nil
 }
 
 func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	userID, err := auth.ActingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// Un jugador no puede reactivarse (ni desactivarse) a sí mismo
+	if req.Active != nil && !auth.CallerHasRole(ctx, auth.RoleSiteAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "only a site_admin can change active")
+	}
+
 	var user models.User
-	if err := database.DB.Where("id = ?", req.UserId).First(&user).Error; err != nil {
-		return nil, status.Errorf(codes.NotFound, "user not found: %s", req.UserId)
+	if err := database.DB.Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %s", userID)
 	}
 
 	// Actualizar campos
@@ -193,15 +256,24 @@ func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		updates["active"] = *req.Active
 	}
 
-	if err := database.DB.Model(&user).Updates(updates).Error; err != nil {
+	err = database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Updates(updates).Error; err != nil {
+			return err
+		}
+		if req.Active != nil && !*req.Active && user.Active {
+			return publishDeactivated(tx, user.ID)
+		}
+		return nil
+	})
+	if err != nil {
 		log.Printf("Error updating user: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
 
 	// Recargar usuario actualizado
-	database.DB.Where("id = ?", req.UserId).First(&user)
+	database.DB.Where("id = ?", userID).First(&user)
 
-	log.Printf("Updated user: %s", req.UserId)
+	log.Printf("Updated user: %s", userID)
 
 	return &pb.UpdateUserResponse{
 		User: &pb.User{
@@ -211,6 +283,7 @@ func (s *UserService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		},
 		Message: "User updated successfully",
 	}, nil
@@ -223,7 +296,13 @@ func (s *UserService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest)
 	}
 
 	// Soft delete - marcar como inactivo
-	if err := database.DB.Model(&user).Update("active", false).Error; err != nil {
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&user).Update("active", false).Error; err != nil {
+			return err
+		}
+		return publishDeactivated(tx, user.ID)
+	})
+	if err != nil {
 		log.Printf("Error deleting user: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
@@ -237,11 +316,20 @@ func (s *UserService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest)
 }
 
 func (s *UserService) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
-	var users []models.User
+	page, err := pagination.New(req.PageSize, req.PageToken, req.OrderBy, userOrders, pagination.Filters(req))
+	if err != nil {
+		return nil, err
+	}
+
 	searchTerm := "%" + req.SearchTerm + "%"
+	query := database.DB.Model(&models.User{}).Where("(username LIKE ? OR email LIKE ? OR full_name LIKE ?)",
+		searchTerm, searchTerm, searchTerm)
+	if req.ActiveOnly {
+		query = query.Where("active = ?", true)
+	}
 
-	if err := database.DB.Where("username LIKE ? OR email LIKE ? OR full_name LIKE ?",
-		searchTerm, searchTerm, searchTerm).Find(&users).Error; err != nil {
+	users, total, next, err := pagination.Find(query, page, func(u models.User) string { return u.ID })
+	if err != nil {
 		log.Printf("Error searching users: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to search users: %v", err)
 	}
@@ -255,13 +343,15 @@ func (s *UserService) SearchUsers(ctx context.Context, req *pb.SearchUsersReques
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		})
 	}
 
 	return &pb.SearchUsersResponse{
-		Users:      pbUsers,
-		Total:      int32(len(pbUsers)),
-		SearchTerm: req.SearchTerm,
+		Users:         pbUsers,
+		Total:         int32(total),
+		SearchTerm:    req.SearchTerm,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -279,6 +369,7 @@ func (s *UserService) GetUserByUsername(ctx context.Context, req *pb.GetUserByUs
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		},
 	}, nil
 }
@@ -297,6 +388,7 @@ func (s *UserService) GetUserByEmail(ctx context.Context, req *pb.GetUserByEmail
 			FullName:  user.FullName,
 			CreatedAt: timestamppb.New(user.CreatedAt),
 			Active:    user.Active,
+			Role:      roleToProto(user.Role),
 		},
 	}, nil
 }
@@ -305,9 +397,23 @@ func (s *UserService) GetUserByEmail(ctx context.Context, req *pb.GetUserByEmail
 // Helper Functions
 // ========================================
 
-func generateUserID() string {
-	// Generar ID único basado en timestamp
-	var count int64
-	database.DB.Model(&models.User{}).Count(&count)
-	return fmt.Sprintf("user_%d", count+1)
+// userActive indica al interceptor si el usuario de un token sigue activo; un
+// usuario inexistente cuenta como inactivo
+func userActive(ctx context.Context, userID string) (bool, error) {
+	var users []models.User
+	if err := database.DB.WithContext(ctx).Select("active").Where("id = ?", userID).Limit(1).Find(&users).Error; err != nil {
+		return false, err
+	}
+	return len(users) > 0 && users[0].Active, nil
+}
+
+// publishDeactivated revoca las sesiones del usuario y registra en el outbox
+// que fue desactivado
+func publishDeactivated(tx *gorm.DB, userID string) error {
+	if err := revokeSessions(tx, userID); err != nil {
+		return err
+	}
+	return events.Publish(tx, serviceName, events.TypeUserDeactivated, userID, events.UserDeactivated{
+		UserID: userID,
+	})
 }
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"kickoff.com/pkg/auth"
+	shared "kickoff.com/pkg/models"
+	pb "kickoff.com/proto"
+	"kickoff.com/user/internal/database"
+	"kickoff.com/user/internal/models"
+)
+
+// privilegedMethods son los métodos del User Service que exigen un rol
+var privilegedMethods = auth.Methods{
+	pb.UserService_UpdateUserRole_FullMethodName: {auth.RoleSiteAdmin},
+	pb.UserService_DeleteUser_FullMethodName:     {auth.RoleSiteAdmin},
+}
+
+// ========================================
+// gRPC Handlers - Roles
+// ========================================
+
+// UpdateUserRole cambia el rol de un usuario. El cambio se refleja en el
+// próximo access token, es decir, al renovar la sesión.
+func (s *UserService) UpdateUserRole(ctx context.Context, req *pb.UpdateUserRoleRequest) (*pb.UpdateUserRoleResponse, error) {
+	if req.UserId == "" {
+		return nil, shared.InvalidField("user_id", "user_id is required")
+	}
+	role, ok := roleFromProto(req.Role)
+	if !ok {
+		return nil, shared.InvalidField("role", "invalid role")
+	}
+
+	var user models.User
+	if err := database.DB.Where("id = ?", req.UserId).First(&user).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found: %s", req.UserId)
+	}
+
+	if err := database.DB.Model(&user).Update("role", role).Error; err != nil {
+		log.Printf("Error updating user role: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update user role: %v", err)
+	}
+
+	log.Printf("Updated role of user %s to %s", user.ID, role)
+
+	return &pb.UpdateUserRoleResponse{
+		User: &pb.User{
+			Id:        user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			FullName:  user.FullName,
+			CreatedAt: timestamppb.New(user.CreatedAt),
+			Active:    user.Active,
+			Role:      roleToProto(user.Role),
+		},
+		Message: "User role updated successfully",
+	}, nil
+}
+
+// ========================================
+// Helper Functions - Roles
+// ========================================
+
+// promoteSiteAdmins da el rol site_admin a los usernames de SITE_ADMIN_USERNAMES
+// (separados por comas). Es la única forma de tener el primer administrador,
+// porque UpdateUserRole ya exige serlo.
+func promoteSiteAdmins() error {
+	var usernames []string
+	for _, username := range strings.Split(os.Getenv("SITE_ADMIN_USERNAMES"), ",") {
+		if username = strings.TrimSpace(username); username != "" {
+			usernames = append(usernames, username)
+		}
+	}
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	result := database.DB.Model(&models.User{}).
+		Where("username IN ? AND role <> ?", usernames, auth.RoleSiteAdmin).
+		Update("role", auth.RoleSiteAdmin)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("Promoted %d user(s) to %s from SITE_ADMIN_USERNAMES", result.RowsAffected, auth.RoleSiteAdmin)
+	}
+	return nil
+}
+
+func roleToProto(role auth.Role) pb.UserRole {
+	switch role {
+	case auth.RoleLeagueAdmin:
+		return pb.UserRole_USER_ROLE_LEAGUE_ADMIN
+	case auth.RoleScoreKeeper:
+		return pb.UserRole_USER_ROLE_SCORE_KEEPER
+	case auth.RoleSiteAdmin:
+		return pb.UserRole_USER_ROLE_SITE_ADMIN
+	default:
+		return pb.UserRole_USER_ROLE_PLAYER
+	}
+}
+
+func roleFromProto(role pb.UserRole) (auth.Role, bool) {
+	switch role {
+	case pb.UserRole_USER_ROLE_PLAYER:
+		return auth.RolePlayer, true
+	case pb.UserRole_USER_ROLE_LEAGUE_ADMIN:
+		return auth.RoleLeagueAdmin, true
+	case pb.UserRole_USER_ROLE_SCORE_KEEPER:
+		return auth.RoleScoreKeeper, true
+	case pb.UserRole_USER_ROLE_SITE_ADMIN:
+		return auth.RoleSiteAdmin, true
+	default:
+		return "", false
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"kickoff.com/pkg/events"
 	"kickoff.com/user/internal/models"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -63,6 +64,8 @@ func AutoMigrate() error {
 	log.Println("Running auto-migration for User service...")
 	return DB.AutoMigrate(
 		&models.User{},
+		&models.RefreshToken{},
+		&events.OutboxEvent{},
 	)
 }
 
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// RefreshToken es una sesión iniciada con Register o Login. Solo se guarda el
+// hash SHA-256 del token; cada uso lo revoca y emite uno nuevo (rotación).
+type RefreshToken struct {
+	ID        string     `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	UserID    string     `gorm:"not null;type:varchar(50);index" json:"userId"`
+	TokenHash string     `gorm:"uniqueIndex;not null;type:varchar(64)" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName especifica el nombre de la tabla
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
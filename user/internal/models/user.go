@@ -3,18 +3,22 @@ package models
 import (
 	"time"
 	"gorm.io/gorm"
+
+	"kickoff.com/pkg/auth"
 )
 
 // User representa un usuario en el sistema
 type User struct {
-	ID        string         `gorm:"primaryKey;type:varchar(50)" json:"id"`
-	Username  string         `gorm:"uniqueIndex;not null;type:varchar(100)" json:"username"`
-	Email     string         `gorm:"uniqueIndex;not null;type:varchar(255)" json:"email"`
-	FullName  string         `gorm:"type:varchar(255)" json:"fullName"`
-	Active    bool           `gorm:"default:true;not null" json:"active"`
-	CreatedAt time.Time      `gorm:"autoCreateTime" json:"createdAt"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID           string         `gorm:"primaryKey;type:varchar(50)" json:"id"`
+	Username     string         `gorm:"uniqueIndex;not null;type:varchar(100)" json:"username"`
+	Email        string         `gorm:"uniqueIndex;not null;type:varchar(255)" json:"email"`
+	FullName     string         `gorm:"type:varchar(255)" json:"fullName"`
+	PasswordHash string         `gorm:"type:varchar(255)" json:"-"` // bcrypt; vacío para usuarios creados sin contraseña
+	Active       bool           `gorm:"default:true;not null" json:"active"`
+	Role         auth.Role      `gorm:"type:varchar(20);default:'player';not null" json:"role"`
+	CreatedAt    time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName especifica el nombre de la tabla